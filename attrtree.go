@@ -0,0 +1,87 @@
+package lilgraph
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Attr keys can be dotted, e.g. `deploy.region=eu deploy.replicas=3`. They're
+// stored under their full keys, so GetAttr("deploy.region") etc work as
+// normal; but they can also be read as nested maps, with Attrs or AttrTree.
+
+// keysConflict reports whether one attr key would be nested within the other,
+// e.g. `deploy` and `deploy.region`, such that they can't both have values.
+func keysConflict(a, b string) bool {
+	return strings.HasPrefix(a, b+".") || strings.HasPrefix(b, a+".")
+}
+
+func (c *attrSet) hasConflictingAttr(key string) bool {
+	for _, attr := range c.attrs {
+		if attr.key == key || keysConflict(attr.key, key) {
+			return true
+		}
+	}
+	return false
+}
+
+// Attrs returns the attrs nested under the given dotted key prefix, as a tree
+// of maps; e.g. with `deploy.region=eu deploy.db.host=pg1`, Attrs("deploy")
+// gives {"region": "eu", "db": {"host": "pg1"}}. Returns nil if there aren't
+// any. Values are as per AttrTree.
+func (c *attrSet) Attrs(prefix string) map[string]any {
+	return c.attrTree(prefix + ".")
+}
+
+// AttrTree returns all attrs as a tree of maps, split on the dots in their
+// keys. Number, bool and list values are given as float64, bool and []string
+// respectively; others as strings.
+func (c *attrSet) AttrTree() map[string]any {
+	return c.attrTree("")
+}
+
+func (c *attrSet) attrTree(prefix string) map[string]any {
+	var tree map[string]any
+	for _, attr := range c.attrs {
+		key, ok := strings.CutPrefix(attr.key, prefix)
+		if !ok {
+			continue
+		}
+		if tree == nil {
+			tree = map[string]any{}
+		}
+		parts := strings.Split(key, ".")
+		branch := tree
+		for _, part := range parts[:len(parts)-1] {
+			sub, ok := branch[part].(map[string]any)
+			if !ok {
+				sub = map[string]any{}
+				branch[part] = sub
+			}
+			branch = sub
+		}
+		branch[parts[len(parts)-1]] = attr.treeValue()
+	}
+	return tree
+}
+
+func (a attr) treeValue() any {
+	switch a.kind {
+	case KindNumber:
+		if f, err := strconv.ParseFloat(a.value, 64); err == nil {
+			return f
+		}
+	case KindBool:
+		return a.value == "true"
+	case KindList:
+		return a.listValues()
+	}
+	return a.value
+}
+
+func (a attr) listValues() []string {
+	values := make([]string, 0, len(a.list))
+	for _, item := range a.list {
+		values = append(values, item.value)
+	}
+	return values
+}
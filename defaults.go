@@ -0,0 +1,102 @@
+package lilgraph
+
+import "slices"
+
+// defaults holds default attrs for all nodes, or all edges, of a given type,
+// as declared by e.g. `@defaults human [species=homo_sapiens]`.
+type defaults struct {
+	common // typ is the node or edge type these defaults apply to.
+
+	forEdges bool
+}
+
+// NodeDefaults returns the default attrs declared for nodes of the given type.
+func (g *Lilgraph) NodeDefaults(typ string) map[string]string {
+	if d := g.findDefaults(typ, false); d != nil {
+		return d.AttrsMap()
+	}
+	return nil
+}
+
+// EdgeDefaults returns the default attrs declared for edges of the given type.
+func (g *Lilgraph) EdgeDefaults(typ string) map[string]string {
+	if d := g.findDefaults(typ, true); d != nil {
+		return d.AttrsMap()
+	}
+	return nil
+}
+
+func (g *Lilgraph) findDefaults(typ string, forEdges bool) *defaults {
+	for _, d := range g.defaults {
+		if d.typ == typ && d.forEdges == forEdges {
+			return d
+		}
+	}
+	return nil
+}
+
+func (g *Lilgraph) upsertDefaults(typ string, forEdges bool) *defaults {
+	d := g.findDefaults(typ, forEdges)
+	if d == nil {
+		d = &defaults{common: common{typ: typ}, forEdges: forEdges}
+		g.defaults = append(g.defaults, d)
+	}
+	return d
+}
+
+// applyDefaults adds any default attrs to all nodes & edges of the relevant
+// types, except where they already have a value for that attr (or, for dotted
+// keys, for one that it would be nested within or contain). Nodes with several
+// types get the defaults for each of them; where those conflict, the defaults
+// for the node's earlier type win.
+func (g *Lilgraph) applyDefaults() {
+	for _, n := range g.nodes {
+		var ds []*defaults
+		for _, typ := range n.types {
+			if d := g.findDefaults(typ, false); d != nil {
+				ds = append(ds, d)
+			}
+		}
+		n.inheritDefaults(ds)
+	}
+	for _, e := range g.edges {
+		var ds []*defaults
+		if d := g.findDefaults(e.typ, true); d != nil {
+			ds = append(ds, d)
+		}
+		e.inheritDefaults(ds)
+	}
+}
+
+// inheritDefaults adds the attrs from ds, in order, except for ones that are
+// already set or that were explicitly unset. Unsets that didn't keep anything
+// from being inherited are dropped, as there's no need to write them back.
+func (c *attrSet) inheritDefaults(ds []*defaults) {
+	suppressing := map[string]bool{}
+	for _, d := range ds {
+		for _, a := range d.attrs {
+			i := slices.IndexFunc(c.unset, func(key string) bool { return key == a.key || keysConflict(key, a.key) })
+			if i >= 0 {
+				suppressing[c.unset[i]] = true
+				continue
+			}
+			if c.hasConflictingAttr(a.key) {
+				continue
+			}
+			a.inherited = true
+			c.attrs = append(c.attrs, a)
+		}
+	}
+	c.unset = slices.DeleteFunc(c.unset, func(key string) bool { return !suppressing[key] })
+}
+
+// AttrInherited reports whether an attr's value came from a type-level
+// defaults declaration, rather than being set on this item explicitly.
+func (c *attrSet) AttrInherited(key string) bool {
+	for _, attr := range c.attrs {
+		if attr.key == key {
+			return attr.inherited
+		}
+	}
+	return false
+}
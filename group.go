@@ -0,0 +1,142 @@
+package lilgraph
+
+import (
+	"fmt"
+	"iter"
+	"slices"
+
+	"github.com/orls/lilgraph/internal/gocc/token"
+)
+
+// Group is a named collection of nodes and edges, declared in source as a
+// `subgraph id [...] { ... }` block. Groups can be nested. Nodes and edges can
+// be members of any number of groups.
+type Group struct {
+	common
+
+	id     string
+	parent *Group
+	groups []*Group
+	nodes  []*Node
+	edges  []*Edge
+
+	// AST parser metadata about location of the group's first declaration.
+	declPos *token.Pos
+}
+
+func (gr *Group) Id() string               { return gr.id }
+func (gr *Group) Parent() *Group           { return gr.parent }
+func (gr *Group) Groups() iter.Seq[*Group] { return slices.Values(gr.groups) }
+func (gr *Group) Nodes() iter.Seq[*Node]   { return slices.Values(gr.nodes) }
+func (gr *Group) Edges() iter.Seq[*Edge]   { return slices.Values(gr.edges) }
+func (gr *Group) HasNode(n *Node) bool     { return slices.Contains(gr.nodes, n) }
+func (gr *Group) HasEdge(e *Edge) bool     { return slices.Contains(gr.edges, e) }
+
+// IsWithin reports whether this group is nested, at any depth, inside other.
+func (gr *Group) IsWithin(other *Group) bool {
+	for p := gr.parent; p != nil; p = p.parent {
+		if p == other {
+			return true
+		}
+	}
+	return false
+}
+
+// Contains reports whether n is a member of this group, or of any group
+// nested within it.
+func (gr *Group) Contains(n *Node) bool {
+	for _, ng := range n.groups {
+		if ng == gr || ng.IsWithin(gr) {
+			return true
+		}
+	}
+	return false
+}
+
+// AddNode makes n a member of the group. Returns false if it already was.
+func (gr *Group) AddNode(n *Node) bool {
+	if gr.HasNode(n) {
+		return false
+	}
+	gr.nodes = append(gr.nodes, n)
+	n.groups = append(n.groups, gr)
+	return true
+}
+
+// AddEdge makes e a member of the group. Returns false if it already was.
+func (gr *Group) AddEdge(e *Edge) bool {
+	if gr.HasEdge(e) {
+		return false
+	}
+	gr.edges = append(gr.edges, e)
+	e.groups = append(e.groups, gr)
+	return true
+}
+
+func (gr *Group) RemoveNode(n *Node) bool {
+	if !gr.HasNode(n) {
+		return false
+	}
+	gr.nodes = slices.DeleteFunc(gr.nodes, func(other *Node) bool { return n == other })
+	n.groups = slices.DeleteFunc(n.groups, func(other *Group) bool { return gr == other })
+	return true
+}
+
+func (gr *Group) RemoveEdge(e *Edge) bool {
+	if !gr.HasEdge(e) {
+		return false
+	}
+	gr.edges = slices.DeleteFunc(gr.edges, func(other *Edge) bool { return e == other })
+	e.groups = slices.DeleteFunc(e.groups, func(other *Group) bool { return gr == other })
+	return true
+}
+
+// describeParent says where a group with the given parent is, for errors.
+func describeParent(parent *Group) string {
+	if parent == nil {
+		return "at the top level"
+	}
+	return fmt.Sprintf("within '%s'", parent.id)
+}
+
+func (g *Lilgraph) FindGroup(id string) *Group {
+	return g.groupsById[id]
+}
+
+// Groups returns all groups in the graph, including nested ones.
+func (g *Lilgraph) Groups() iter.Seq[*Group] {
+	return slices.Values(g.groups)
+}
+
+// AddGroup upserts a group with the given id. Group ids share a single
+// graph-wide namespace, regardless of nesting; parent may be nil for a
+// top-level group. If the group already exists, it must have the same parent.
+func (g *Lilgraph) AddGroup(parent *Group, id string, typ string) (*Group, bool, error) {
+	if !validId(id) {
+		return nil, false, fmt.Errorf("%w %q", ErrInvalidId, id)
+	}
+	if gr, ok := g.groupsById[id]; ok {
+		if gr.parent != parent {
+			return nil, false, fmt.Errorf("%w: group '%s' is already %s", ErrParentChange, gr.id, describeParent(gr.parent))
+		}
+		if typ != "" {
+			if gr.typ != "" && gr.typ != typ {
+				return nil, false, fmt.Errorf(
+					"%w: group '%s' already has type '%s'",
+					ErrTypeChange,
+					gr.id,
+					gr.typ,
+				)
+			}
+			gr.typ = typ
+		}
+		return gr, true, nil
+	}
+	gr := &Group{id: id, parent: parent, common: common{typ: typ}}
+	g.groups = append(g.groups, gr)
+	g.groupsById[id] = gr
+	if parent != nil {
+		parent.groups = append(parent.groups, gr)
+	}
+	return gr, false, nil
+}
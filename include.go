@@ -0,0 +1,54 @@
+package lilgraph
+
+import (
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+
+	"github.com/orls/lilgraph/internal/gocc/token"
+)
+
+// includer loads the files named by include statements.
+type includer struct {
+	// resolve gives the path of an included file, given the path of the file
+	// that includes it.
+	resolve  func(from, include string) string
+	readFile func(path string) ([]byte, error)
+
+	// Paths of the files currently being included, outermost first; used to
+	// detect include cycles.
+	stack []string
+}
+
+func osIncluder() *includer {
+	return &includer{
+		resolve: func(from, include string) string {
+			if filepath.IsAbs(include) {
+				return filepath.Clean(include)
+			}
+			return filepath.Join(filepath.Dir(from), include)
+		},
+		readFile: os.ReadFile,
+	}
+}
+
+func fsIncluder(fsys fs.FS) *includer {
+	return &includer{
+		resolve: func(from, include string) string {
+			return path.Join(path.Dir(from), include)
+		},
+		readFile: func(name string) ([]byte, error) {
+			return fs.ReadFile(fsys, name)
+		},
+	}
+}
+
+// sourcePath gives the path of the file a token was parsed from, if known.
+func sourcePath(pos token.Pos) (string, bool) {
+	src, ok := pos.Context.(token.Sourcer)
+	if !ok || src.Source() == "" {
+		return "", false
+	}
+	return src.Source(), true
+}
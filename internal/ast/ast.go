@@ -2,8 +2,11 @@ package ast
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strings"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/orls/lilgraph/internal/gocc/token"
 )
@@ -16,6 +19,8 @@ type TopLevel interface {
 }
 
 type Graph struct {
+	// Version is the language version declared by the doc, or 0 if none.
+	Version  int        `json:"version,omitempty"`
 	AstItems []TopLevel `json:"ast_items"`
 }
 
@@ -35,23 +40,36 @@ func (g *Graph) UnmarshalJSON(bytes []byte) error {
 	// TODO: if Graph gains any other fields, have to duplicate the field definitions and struct
 	// tags here, then copy the values over. The pains of polymorphic json in go.
 	tmp := &struct {
+		Version      int               `json:"version,omitempty"`
 		RawItemJsons []json.RawMessage `json:"ast_items"`
 	}{}
 	if err := json.Unmarshal(bytes, tmp); err != nil {
 		return err
 	}
+	g.Version = tmp.Version
 	if tmp.RawItemJsons == nil {
 		g.AstItems = nil
 		return nil
 	}
+	items, err := unmarshalItems(tmp.RawItemJsons)
+	if err != nil {
+		return err
+	}
+	g.AstItems = items
+	return nil
+}
+
+// unmarshalItems decodes a list of polymorphic top-level items, dispatching on
+// their 'ast_type' field.
+func unmarshalItems(rawItemJsons []json.RawMessage) ([]TopLevel, error) {
 	type asttypecheck struct {
 		AstType string `json:"ast_type"`
 	}
 	atc := &asttypecheck{}
-	g.AstItems = make([]TopLevel, 0, len(tmp.RawItemJsons))
-	for i, rawJson := range tmp.RawItemJsons {
+	items := make([]TopLevel, 0, len(rawItemJsons))
+	for i, rawJson := range rawItemJsons {
 		if err := json.Unmarshal(rawJson, atc); err != nil {
-			return err
+			return nil, err
 		}
 		var target TopLevel
 		switch atc.AstType {
@@ -59,15 +77,33 @@ func (g *Graph) UnmarshalJSON(bytes []byte) error {
 			target = &Node{}
 		case "edge_chain":
 			target = &EdgeChain{}
+		case "group":
+			target = &Group{}
+		case "include":
+			target = &Include{}
+		case "defaults":
+			target = &Defaults{}
+		case "graph_attrs":
+			target = &GraphAttrs{}
+		case "deletion":
+			target = &Deletion{}
+		case "namespace":
+			target = &Namespace{}
+		case "template":
+			target = &Template{}
+		case "template_use":
+			target = &TemplateUse{}
+		case "let":
+			target = &Let{}
 		default:
-			return fmt.Errorf("can't unmarshal json 'ast_items' #%d: unknown ast type '%s'", i, atc.AstType)
+			return nil, fmt.Errorf("can't unmarshal json 'ast_items' #%d: unknown ast type '%s'", i, atc.AstType)
 		}
 		if err := json.Unmarshal(rawJson, target); err != nil {
-			return err
+			return nil, err
 		}
-		g.AstItems = append(g.AstItems, target)
+		items = append(items, target)
 	}
-	return nil
+	return items, nil
 }
 
 func AppendGraphItem(gPP, itemPP ParserProduct) (*Graph, error) {
@@ -84,25 +120,46 @@ func AppendGraphItem(gPP, itemPP ParserProduct) (*Graph, error) {
 }
 
 type Node struct {
-	Id    string `json:"id"`
-	Type  string `json:"_type,omitempty"`
-	Attrs Attrs  `json:"attrs,omitempty"`
-	Pos   token.Pos
+	Id string `json:"id"`
+	// The namespace the id's explicitly in, if any; see getNodeId.
+	Namespace string   `json:"namespace,omitempty"`
+	Types     []string `json:"_types,omitempty"`
+	Attrs     Attrs    `json:"attrs,omitempty"`
+	// Set for anonymous nodes, i.e. `_`, which have no Id.
+	Anonymous bool   `json:"anonymous,omitempty"`
+	Doc       string `json:"doc,omitempty"`
+	Pos       token.Pos
 }
 
-func NewNode(idPP, typePP, attrsPP ParserProduct) (*Node, error) {
-	id, pos, err := getTokVal(idPP)
+// NewNode makes a node decl. typesPP is either a list of type ids, or an
+// empty string literal if no types were given.
+func NewNode(idPP, typesPP, attrsPP ParserProduct) (*Node, error) {
+	id, ns, pos, err := getNodeId(idPP)
 	if err != nil {
 		return nil, fmt.Errorf("failed getting value for node id: %v", err)
 	}
-	typ, err := getTokOrLiteralStr(typePP)
-	if err != nil {
+	var types []string
+	if list, ok := typesPP.([]string); ok {
+		types = list
+	} else if typ, err := getTokOrLiteralStr(typesPP); err != nil {
 		return nil, fmt.Errorf("failed getting value for node type pseudoattr: %v", err)
+	} else if typ != "" {
+		types = []string{typ}
+	}
+	if len(types) > 1 {
+		if err := requireVersion(pos, 2, "multiple node types"); err != nil {
+			return nil, err
+		}
 	}
 	node := &Node{
-		Id:   id,
-		Type: typ,
-		Pos:  pos,
+		Id:        id,
+		Namespace: ns,
+		Types:     types,
+		Doc:       docBefore(pos),
+		Pos:       pos,
+	}
+	if tok, ok := idPP.(*token.Token); ok && tok.Type == token.TokMap.Type("_") {
+		node.Id, node.Anonymous = "", true
 	}
 	if attrsPP != nil {
 		attrs, ok := attrsPP.(Attrs)
@@ -114,6 +171,18 @@ func NewNode(idPP, typePP, attrsPP ParserProduct) (*Node, error) {
 	return node, nil
 }
 
+// NewAnonId checks that an anonymous node, `_`, can be used.
+func NewAnonId(tokPP ParserProduct) (*token.Token, error) {
+	tok, ok := tokPP.(*token.Token)
+	if !ok {
+		return nil, fmt.Errorf("expected *token.Token for anonymous node, but got %T", tokPP)
+	}
+	if err := requireVersion(tok.Pos, 2, "anonymous nodes"); err != nil {
+		return nil, err
+	}
+	return tok, nil
+}
+
 func (n *Node) TopLevel() {}
 
 func (n *Node) MarshalJson() ([]byte, error) {
@@ -126,20 +195,473 @@ func (n *Node) MarshalJson() ([]byte, error) {
 	})
 }
 
+// Group is a named block of other top-level items, e.g. `subgraph x { ... }`.
+type Group struct {
+	Id string `json:"id"`
+	// The namespace the id's explicitly in, if any; see getNodeId.
+	Namespace string     `json:"namespace,omitempty"`
+	Type      string     `json:"_type,omitempty"`
+	Attrs     Attrs      `json:"attrs,omitempty"`
+	Items     []TopLevel `json:"items"`
+	Pos       token.Pos
+}
+
+func NewGroup(idPP, typePP, attrsPP, bodyPP ParserProduct) (*Group, error) {
+	id, ns, pos, err := getNodeId(idPP)
+	if err != nil {
+		return nil, fmt.Errorf("failed getting value for group id: %v", err)
+	}
+	if err := requireVersion(pos, 2, "subgraphs"); err != nil {
+		return nil, err
+	}
+	typ, err := getTokOrLiteralStr(typePP)
+	if err != nil {
+		return nil, fmt.Errorf("failed getting value for group type pseudoattr: %v", err)
+	}
+	body, ok := bodyPP.(*Graph)
+	if !ok {
+		return nil, fmt.Errorf("expected *Graph for group body, but got %T", bodyPP)
+	}
+	group := &Group{
+		Id:        id,
+		Namespace: ns,
+		Type:      typ,
+		Items:     body.AstItems,
+		Pos:       pos,
+	}
+	if attrsPP != nil {
+		attrs, ok := attrsPP.(Attrs)
+		if !ok {
+			return nil, fmt.Errorf("expected Attrs instance for group attrs, but got %T", attrsPP)
+		}
+		group.Attrs = attrs
+	}
+	return group, nil
+}
+
+func (gr *Group) TopLevel() {}
+
+func (gr *Group) UnmarshalJSON(bytes []byte) error {
+	// Same polymorphic-json pains as Graph; see there.
+	tmp := &struct {
+		Id           string            `json:"id"`
+		Namespace    string            `json:"namespace,omitempty"`
+		Type         string            `json:"_type,omitempty"`
+		Attrs        Attrs             `json:"attrs,omitempty"`
+		RawItemJsons []json.RawMessage `json:"items"`
+	}{}
+	if err := json.Unmarshal(bytes, tmp); err != nil {
+		return err
+	}
+	items, err := unmarshalItems(tmp.RawItemJsons)
+	if err != nil {
+		return err
+	}
+	gr.Id, gr.Namespace, gr.Type, gr.Attrs, gr.Items = tmp.Id, tmp.Namespace, tmp.Type, tmp.Attrs, items
+	return nil
+}
+
+func (gr *Group) MarshalJson() ([]byte, error) {
+	return json.Marshal(&struct {
+		AstType string `json:"ast_type"`
+		*Group
+	}{
+		AstType: "group",
+		Group:   gr,
+	})
+}
+
+// Namespace is a block within which node ids are prefixed with its name; e.g.
+// `@namespace payments { api }` declares a node `payments.api`.
+type Namespace struct {
+	Name  string     `json:"name"`
+	Items []TopLevel `json:"items"`
+	Pos   token.Pos
+}
+
+func NewNamespace(namePP, bodyPP ParserProduct) (*Namespace, error) {
+	name, _, pos, err := getNodeId(namePP)
+	if err != nil {
+		return nil, fmt.Errorf("failed getting value for namespace name: %v", err)
+	}
+	if err := requireVersion(pos, 2, "namespaces"); err != nil {
+		return nil, err
+	}
+	body, ok := bodyPP.(*Graph)
+	if !ok {
+		return nil, fmt.Errorf("expected *Graph for namespace body, but got %T", bodyPP)
+	}
+	return &Namespace{Name: name, Items: body.AstItems, Pos: pos}, nil
+}
+
+func (ns *Namespace) TopLevel() {}
+
+func (ns *Namespace) UnmarshalJSON(bytes []byte) error {
+	// Same polymorphic-json pains as Graph; see there.
+	tmp := &struct {
+		Name         string            `json:"name"`
+		RawItemJsons []json.RawMessage `json:"items"`
+	}{}
+	if err := json.Unmarshal(bytes, tmp); err != nil {
+		return err
+	}
+	items, err := unmarshalItems(tmp.RawItemJsons)
+	if err != nil {
+		return err
+	}
+	ns.Name, ns.Items = tmp.Name, items
+	return nil
+}
+
+func (ns *Namespace) MarshalJson() ([]byte, error) {
+	return json.Marshal(&struct {
+		AstType string `json:"ast_type"`
+		*Namespace
+	}{
+		AstType:   "namespace",
+		Namespace: ns,
+	})
+}
+
+// Template is a reusable block of statements, which can have params used in
+// its attr values; e.g. `@template service(engine) { db [engine=$engine] }`.
+type Template struct {
+	Name   string     `json:"name"`
+	Params []string   `json:"params,omitempty"`
+	Items  []TopLevel `json:"items"`
+	Pos    token.Pos
+}
+
+// NewTemplate makes a template decl. paramsPP is either a list of param
+// names, or nil if there are none.
+func NewTemplate(namePP, paramsPP, bodyPP ParserProduct) (*Template, error) {
+	name, pos, err := getTokVal(namePP)
+	if err != nil {
+		return nil, fmt.Errorf("failed getting value for template name: %v", err)
+	}
+	if err := requireVersion(pos, 2, "templates"); err != nil {
+		return nil, err
+	}
+	tmpl := &Template{Name: name, Pos: pos}
+	if paramsPP != nil {
+		params, ok := paramsPP.([]string)
+		if !ok {
+			return nil, fmt.Errorf("expected []string for template params, but got %T", paramsPP)
+		}
+		tmpl.Params = params
+	}
+	body, ok := bodyPP.(*Graph)
+	if !ok {
+		return nil, fmt.Errorf("expected *Graph for template body, but got %T", bodyPP)
+	}
+	tmpl.Items = body.AstItems
+	return tmpl, nil
+}
+
+func (t *Template) TopLevel() {}
+
+func (t *Template) UnmarshalJSON(bytes []byte) error {
+	// Same polymorphic-json pains as Graph; see there.
+	tmp := &struct {
+		Name         string            `json:"name"`
+		Params       []string          `json:"params,omitempty"`
+		RawItemJsons []json.RawMessage `json:"items"`
+	}{}
+	if err := json.Unmarshal(bytes, tmp); err != nil {
+		return err
+	}
+	items, err := unmarshalItems(tmp.RawItemJsons)
+	if err != nil {
+		return err
+	}
+	t.Name, t.Params, t.Items = tmp.Name, tmp.Params, items
+	return nil
+}
+
+func (t *Template) MarshalJson() ([]byte, error) {
+	return json.Marshal(&struct {
+		AstType string `json:"ast_type"`
+		*Template
+	}{
+		AstType:  "template",
+		Template: t,
+	})
+}
+
+// TemplateUse expands a template in place, with the given args for its
+// params; e.g. `@use service(engine=postgres)`. If a namespace is given, as in
+// `@use payments = service(engine=postgres)`, it's expanded within that.
+type TemplateUse struct {
+	Template  string `json:"template"`
+	Namespace string `json:"namespace,omitempty"`
+	Args      Attrs  `json:"args,omitempty"`
+	Pos       token.Pos
+}
+
+func NewTemplateUse(kwPP, nsPP, namePP, argsPP ParserProduct) (*TemplateUse, error) {
+	_, pos, err := getTokVal(kwPP)
+	if err != nil {
+		return nil, fmt.Errorf("failed getting template use keyword: %v", err)
+	}
+	if err := requireVersion(pos, 2, "templates"); err != nil {
+		return nil, err
+	}
+	ns := ""
+	if nsPP != "" {
+		if ns, _, _, err = getNodeId(nsPP); err != nil {
+			return nil, fmt.Errorf("failed getting namespace for template use: %v", err)
+		}
+	}
+	name, _, err := getTokVal(namePP)
+	if err != nil {
+		return nil, fmt.Errorf("failed getting name of template to use: %v", err)
+	}
+	use := &TemplateUse{Template: name, Namespace: ns, Pos: pos}
+	if argsPP != nil {
+		args, ok := argsPP.(Attrs)
+		if !ok {
+			return nil, fmt.Errorf("expected Attrs instance for template args, but got %T", argsPP)
+		}
+		use.Args = args
+	}
+	return use, nil
+}
+
+func (tu *TemplateUse) TopLevel() {}
+
+func (tu *TemplateUse) MarshalJson() ([]byte, error) {
+	return json.Marshal(&struct {
+		AstType string `json:"ast_type"`
+		*TemplateUse
+	}{
+		AstType:     "template_use",
+		TemplateUse: tu,
+	})
+}
+
+// Let declares a variable, for interpolation into quoted attr values; e.g.
+// `@let region = "eu-west-1"`.
+type Let struct {
+	Name  string  `json:"name"`
+	Value AttrVal `json:"value"`
+	Pos   token.Pos
+}
+
+func NewLet(namePP, valPP ParserProduct) (*Let, error) {
+	name, pos, err := getTokVal(namePP)
+	if err != nil {
+		return nil, fmt.Errorf("failed getting variable name: %v", err)
+	}
+	if err := requireVersion(pos, 2, "variables"); err != nil {
+		return nil, err
+	}
+	val, ok := valPP.(AttrVal)
+	if !ok {
+		return nil, fmt.Errorf("failed getting value for variable '%s': expected AttrVal, but got %T", name, valPP)
+	}
+	return &Let{Name: name, Value: val, Pos: pos}, nil
+}
+
+func (l *Let) TopLevel() {}
+
+func (l *Let) MarshalJson() ([]byte, error) {
+	return json.Marshal(&struct {
+		AstType string `json:"ast_type"`
+		*Let
+	}{
+		AstType: "let",
+		Let:     l,
+	})
+}
+
+// Include is a reference to another file whose content should be treated as
+// if it appeared in place of the include statement.
+type Include struct {
+	Path string `json:"path"`
+	Pos  token.Pos
+}
+
+func NewInclude(kwPP, pathPP ParserProduct) (*Include, error) {
+	_, pos, err := getTokVal(kwPP)
+	if err != nil {
+		return nil, fmt.Errorf("failed getting include position: %v", err)
+	}
+	if err := requireVersion(pos, 2, "includes"); err != nil {
+		return nil, err
+	}
+	path, err := Unquote(pathPP)
+	if err != nil {
+		return nil, fmt.Errorf("failed getting include path: %v", err)
+	}
+	return &Include{Path: path, Pos: pos}, nil
+}
+
+func (i *Include) TopLevel() {}
+
+func (i *Include) MarshalJson() ([]byte, error) {
+	return json.Marshal(&struct {
+		AstType string `json:"ast_type"`
+		*Include
+	}{
+		AstType: "include",
+		Include: i,
+	})
+}
+
+// Defaults declares default attrs for all nodes (or edges) of a type.
+type Defaults struct {
+	ForEdges bool   `json:"edges,omitempty"`
+	Type     string `json:"_type"`
+	Attrs    Attrs  `json:"attrs,omitempty"`
+	Pos      token.Pos
+}
+
+func NewDefaults(kwPP, typePP, attrsPP ParserProduct) (*Defaults, error) {
+	kw, pos, err := getTokVal(kwPP)
+	if err != nil {
+		return nil, fmt.Errorf("failed getting defaults keyword: %v", err)
+	}
+	if err := requireVersion(pos, 2, "defaults"); err != nil {
+		return nil, err
+	}
+	typ, _, err := getTokVal(typePP)
+	if err != nil {
+		return nil, fmt.Errorf("failed getting value for defaults type: %v", err)
+	}
+	attrs, ok := attrsPP.(Attrs)
+	if !ok {
+		return nil, fmt.Errorf("expected Attrs instance for defaults attrs, but got %T", attrsPP)
+	}
+	return &Defaults{
+		ForEdges: kw == "@edge_defaults",
+		Type:     typ,
+		Attrs:    attrs,
+		Pos:      pos,
+	}, nil
+}
+
+func (d *Defaults) TopLevel() {}
+
+func (d *Defaults) MarshalJson() ([]byte, error) {
+	return json.Marshal(&struct {
+		AstType string `json:"ast_type"`
+		*Defaults
+	}{
+		AstType:  "defaults",
+		Defaults: d,
+	})
+}
+
+// GraphAttrs declares attrs of the graph as a whole, e.g. `@graph [title=x]`.
+type GraphAttrs struct {
+	Attrs Attrs `json:"attrs,omitempty"`
+	Pos   token.Pos
+}
+
+func NewGraphAttrs(kwPP, attrsPP ParserProduct) (*GraphAttrs, error) {
+	_, pos, err := getTokVal(kwPP)
+	if err != nil {
+		return nil, fmt.Errorf("failed getting graph attrs keyword: %v", err)
+	}
+	if err := requireVersion(pos, 2, "graph attrs"); err != nil {
+		return nil, err
+	}
+	attrs, ok := attrsPP.(Attrs)
+	if !ok {
+		return nil, fmt.Errorf("expected Attrs instance for graph attrs, but got %T", attrsPP)
+	}
+	return &GraphAttrs{Attrs: attrs, Pos: pos}, nil
+}
+
+func (ga *GraphAttrs) TopLevel() {}
+
+func (ga *GraphAttrs) MarshalJson() ([]byte, error) {
+	return json.Marshal(&struct {
+		AstType string `json:"ast_type"`
+		*GraphAttrs
+	}{
+		AstType:    "graph_attrs",
+		GraphAttrs: ga,
+	})
+}
+
+// Deletion removes a node, e.g. `!luke`, or the edges of an edge chain, e.g.
+// `!a -[member]-> b`. Exactly one of Node and Edge is set.
+type Deletion struct {
+	Node string `json:"node,omitempty"`
+	// The namespace Node's explicitly in, if any; see getNodeId.
+	Namespace string     `json:"namespace,omitempty"`
+	Edge      *EdgeChain `json:"edge,omitempty"`
+	Pos       token.Pos
+}
+
+func NewNodeDeletion(bangPP, idPP ParserProduct) (*Deletion, error) {
+	_, pos, err := getTokVal(bangPP)
+	if err != nil {
+		return nil, fmt.Errorf("failed getting deletion marker: %v", err)
+	}
+	if err := requireVersion(pos, 2, "deletions"); err != nil {
+		return nil, err
+	}
+	id, ns, _, err := getNodeId(idPP)
+	if err != nil {
+		return nil, fmt.Errorf("failed getting id of node to delete: %v", err)
+	}
+	return &Deletion{Node: id, Namespace: ns, Pos: pos}, nil
+}
+
+func NewEdgeDeletion(bangPP, chainPP ParserProduct) (*Deletion, error) {
+	_, pos, err := getTokVal(bangPP)
+	if err != nil {
+		return nil, fmt.Errorf("failed getting deletion marker: %v", err)
+	}
+	if err := requireVersion(pos, 2, "deletions"); err != nil {
+		return nil, err
+	}
+	chain, ok := chainPP.(*EdgeChain)
+	if !ok {
+		return nil, fmt.Errorf("expected *EdgeChain for edges to delete, but got %T", chainPP)
+	}
+	return &Deletion{Edge: chain, Pos: pos}, nil
+}
+
+func (d *Deletion) TopLevel() {}
+
+func (d *Deletion) MarshalJson() ([]byte, error) {
+	return json.Marshal(&struct {
+		AstType string `json:"ast_type"`
+		*Deletion
+	}{
+		AstType:  "deletion",
+		Deletion: d,
+	})
+}
+
 type EdgeChain struct {
-	From  string      `json:"from"`
+	From  []EdgeEnd   `json:"from"`
 	Steps []*EdgeStep `json:"steps"`
 }
 
 func NewEdgeChain(fromPP, stepPP ParserProduct) (*EdgeChain, error) {
-	from, _, err := getTokVal(fromPP)
-	if err != nil {
-		return nil, fmt.Errorf("failed getting value for edge 'from' node id: %v", err)
+	from, ok := fromPP.([]EdgeEnd)
+	if !ok {
+		return nil, fmt.Errorf("expected []EdgeEnd for edge 'from' nodes, but got %T", fromPP)
 	}
 	step, ok := stepPP.(*EdgeStep)
 	if !ok {
 		return nil, fmt.Errorf("expected *EdgeStep for edge rhs, but got %T", stepPP)
 	}
+	// A doc comment before the chain documents its first step, not the node
+	// that the chain starts with; unless the step has its own.
+	if doc := docBefore(from[0].Pos); doc != "" && step.Doc == "" {
+		step.Doc = doc
+		if decl := from[0].Decl; decl != nil && decl.Doc == doc {
+			decl.Doc = ""
+			if len(decl.Types) == 0 && len(decl.Attrs) == 0 && !decl.Anonymous {
+				from[0].Decl = nil
+			}
+		}
+	}
 	return &EdgeChain{
 		From:  from,
 		Steps: []*EdgeStep{step},
@@ -172,25 +694,64 @@ func (e *EdgeChain) MarshalJson() ([]byte, error) {
 }
 
 type EdgeStep struct {
-	To    string
+	To    []EdgeEnd
 	Type  string
+	Key   string    `json:"key,omitempty"`
+	Dir   Direction `json:"dir,omitempty"`
 	Attrs Attrs
+	Doc   string `json:"doc,omitempty"`
 	Pos   token.Pos
 }
 
-func NewEdgeStep(arrowPP, toPP, typePP, attrsPP ParserProduct) (*EdgeStep, error) {
-	to, _, err := getTokVal(toPP)
-	if err != nil {
-		return nil, fmt.Errorf("failed getting value for edge 'to'-node id: %v", err)
+// Direction of an edge step, relative to the order its ids are written in.
+type Direction string
+
+const (
+	Forward       Direction = ""
+	Undirected    Direction = "undirected"
+	Bidirectional Direction = "bidirectional"
+
+	// Backward steps are directed edges from the step's ids to the preceding
+	// ones, e.g. `a <- b`.
+	Backward Direction = "backward"
+)
+
+func NewEdgeStep(openPP, closePP, toPP, typePP, attrsPP ParserProduct) (*EdgeStep, error) {
+	to, ok := toPP.([]EdgeEnd)
+	if !ok {
+		return nil, fmt.Errorf("expected []EdgeEnd for edge 'to' nodes, but got %T", toPP)
 	}
 	typ, err := getTokOrLiteralStr(typePP)
 	if err != nil {
 		return nil, fmt.Errorf("failed getting value for edge type pseudoattr: %v", err)
 	}
+	open, pos, err := getTokVal(openPP)
+	if err != nil {
+		return nil, fmt.Errorf("failed getting value for edge arrow: %v", err)
+	}
+	closing, _, err := getTokVal(closePP)
+	if err != nil {
+		return nil, fmt.Errorf("failed getting value for edge arrow: %v", err)
+	}
+	// A key comes along with the type, if any; see NewKeyedType.
+	typ, key, _ := strings.Cut(typ, "#")
 	step := &EdgeStep{
 		To:   to,
 		Type: typ,
-		Pos:  arrowPP.(*token.Token).Pos,
+		Key:  key,
+		Dir:  arrowsDirection(open, closing),
+		Doc:  docBefore(pos),
+		Pos:  pos,
+	}
+	if step.Dir != Forward {
+		if err := requireVersion(pos, 2, "non-directed and backward edges"); err != nil {
+			return nil, err
+		}
+	}
+	if key != "" {
+		if err := requireVersion(pos, 2, "edge keys"); err != nil {
+			return nil, err
+		}
 	}
 	if attrsPP != nil {
 		if attrs, ok := attrsPP.(Attrs); ok {
@@ -202,12 +763,306 @@ func NewEdgeStep(arrowPP, toPP, typePP, attrsPP ParserProduct) (*EdgeStep, error
 	return step, nil
 }
 
+// arrowsDirection works out an edge's direction from the arrow heads (if any)
+// on its opening and closing tokens. For edges without attrs, these are the
+// same token.
+func arrowsDirection(open, closing string) Direction {
+	headAtStart := strings.HasPrefix(open, "<")
+	headAtEnd := strings.HasSuffix(closing, ">")
+	switch {
+	case headAtStart && headAtEnd:
+		return Bidirectional
+	case headAtEnd:
+		return Forward
+	case headAtStart:
+		return Backward
+	default:
+		return Undirected
+	}
+}
+
+// EdgeEnd is a node referred to at one end of an edge, optionally at a
+// specific port on it; e.g. `a:out`.
+type EdgeEnd struct {
+	Id string
+	// The namespace the id's explicitly in, if any; see getNodeId.
+	Namespace string
+	Port      string
+
+	// Set for nodes declared inline with types and/or attrs, e.g. `a [x]`.
+	Decl *Node
+
+	// Where the end was written; or for ends in a set, where the set was.
+	Pos token.Pos
+}
+
+func NewEdgeEnd(idPP, portPP ParserProduct) (EdgeEnd, error) {
+	id, ns, idPos, err := getNodeId(idPP)
+	if err != nil {
+		return EdgeEnd{}, fmt.Errorf("failed getting value for node id: %v", err)
+	}
+	end := EdgeEnd{Id: id, Namespace: ns, Pos: idPos}
+	if portPP != nil {
+		var pos token.Pos
+		if end.Port, _, pos, err = getNodeId(portPP); err != nil {
+			return EdgeEnd{}, fmt.Errorf("failed getting value for port: %v", err)
+		}
+		if err := requireVersion(pos, 2, "ports"); err != nil {
+			return EdgeEnd{}, err
+		}
+	}
+	return end, nil
+}
+
+// NewInlineEdgeEnd makes an edge end from a node decl. Only decls that have
+// types, attrs or docs are kept; bare ids are treated as plain references.
+func NewInlineEdgeEnd(nodePP ParserProduct) (EdgeEnd, error) {
+	node, ok := nodePP.(*Node)
+	if !ok {
+		return EdgeEnd{}, fmt.Errorf("expected *Node for inline edge end, but got %T", nodePP)
+	}
+	end := EdgeEnd{Id: node.Id, Namespace: node.Namespace, Pos: node.Pos}
+	if len(node.Types) > 0 || len(node.Attrs) > 0 || node.Anonymous || node.Doc != "" {
+		if err := requireVersion(node.Pos, 2, "inline node decls"); err != nil {
+			return EdgeEnd{}, err
+		}
+		end.Decl = node
+	}
+	return end, nil
+}
+
+// NewEdgeEnds starts a list of edge ends, used for sets of edge endpoints.
+func NewEdgeEnds(endPP ParserProduct) ([]EdgeEnd, error) {
+	end, ok := endPP.(EdgeEnd)
+	if !ok {
+		return nil, fmt.Errorf("expected EdgeEnd, but got %T", endPP)
+	}
+	return []EdgeEnd{end}, nil
+}
+
+func AppendEdgeEnds(listPP, endPP ParserProduct) ([]EdgeEnd, error) {
+	list, ok := listPP.([]EdgeEnd)
+	if !ok {
+		return nil, fmt.Errorf("can't extend edge end list; expected []EdgeEnd, but got %T", listPP)
+	}
+	end, ok := endPP.(EdgeEnd)
+	if !ok {
+		return nil, fmt.Errorf("expected EdgeEnd, but got %T", endPP)
+	}
+	return append(list, end), nil
+}
+
+// NewDottedId checks that a namespaced node id can be used.
+func NewDottedId(idPP ParserProduct) (*token.Token, error) {
+	tok, ok := idPP.(*token.Token)
+	if !ok {
+		return nil, fmt.Errorf("expected *token.Token for dotted id, but got %T", idPP)
+	}
+	if err := requireVersion(tok.Pos, 2, "namespaced ids"); err != nil {
+		return nil, err
+	}
+	return tok, nil
+}
+
+// NewEdgeSet checks that a set of edge ends, in braces, can be used.
+func NewEdgeSet(bracePP, endsPP ParserProduct) ([]EdgeEnd, error) {
+	_, pos, err := getTokVal(bracePP)
+	if err != nil {
+		return nil, fmt.Errorf("failed getting edge set position: %v", err)
+	}
+	if err := requireVersion(pos, 2, "edge sets"); err != nil {
+		return nil, err
+	}
+	ends, ok := endsPP.([]EdgeEnd)
+	if !ok {
+		return nil, fmt.Errorf("expected []EdgeEnd for edge set, but got %T", endsPP)
+	}
+	return ends, nil
+}
+
+// Plain edge ends are written as just the node id in json, to keep test
+// expectations terse.
+func (e EdgeEnd) MarshalJSON() ([]byte, error) {
+	if e.Namespace == "" && e.Port == "" && e.Decl == nil {
+		return json.Marshal(e.Id)
+	}
+	return json.Marshal(&struct {
+		Id        string `json:"id"`
+		Namespace string `json:"namespace,omitempty"`
+		Port      string `json:"port,omitempty"`
+		Decl      *Node  `json:"decl,omitempty"`
+	}{e.Id, e.Namespace, e.Port, e.Decl})
+}
+
+func (e *EdgeEnd) UnmarshalJSON(bytes []byte) error {
+	if err := json.Unmarshal(bytes, &e.Id); err == nil {
+		return nil
+	}
+	tmp := &struct {
+		Id        string `json:"id"`
+		Namespace string `json:"namespace"`
+		Port      string `json:"port"`
+		Decl      *Node  `json:"decl"`
+	}{}
+	if err := json.Unmarshal(bytes, tmp); err != nil {
+		return err
+	}
+	e.Id, e.Namespace, e.Port, e.Decl = tmp.Id, tmp.Namespace, tmp.Port, tmp.Decl
+	return nil
+}
+
+// NewIdList starts a list of ids, e.g. a node's types.
+func NewIdList(idPP ParserProduct) ([]string, error) {
+	id, _, err := getTokVal(idPP)
+	if err != nil {
+		return nil, fmt.Errorf("failed getting value for node id: %v", err)
+	}
+	return []string{id}, nil
+}
+
+func AppendIdList(listPP, idPP ParserProduct) ([]string, error) {
+	list, ok := listPP.([]string)
+	if !ok {
+		return nil, fmt.Errorf("can't extend id list; expected []string, but got %T", listPP)
+	}
+	id, _, err := getTokVal(idPP)
+	if err != nil {
+		return nil, fmt.Errorf("failed getting value for node id: %v", err)
+	}
+	return append(list, id), nil
+}
+
 type Attr struct {
-	Key   string `json:"k"`
-	Value string `json:"v"`
+	Key   string    `json:"k"`
+	Value string    `json:"v"`
+	Kind  ValueKind `json:"kind,omitempty"`
+	List  []AttrVal `json:"list,omitempty"`
+	Unset bool      `json:"unset,omitempty"`
 	Pos   token.Pos
 }
 
+// ValueKind records which literal form an attr value was written in.
+type ValueKind string
+
+const (
+	IdentValue  ValueKind = ""
+	StringValue ValueKind = "string"
+	NumberValue ValueKind = "number"
+	BoolValue   ValueKind = "bool"
+	ListValue   ValueKind = "list"
+	// ParamValue is a reference to a template param, e.g. `$engine`; Value
+	// holds the param's name.
+	ParamValue ValueKind = "param"
+	// InterpolatedValue is a quoted string containing `${name}` references
+	// (or escaped `$${`s), which have yet to be interpolated; see Interpolate.
+	InterpolatedValue ValueKind = "interpolated"
+)
+
+// AttrVal is the parser product for an attr value, before it's paired up
+// with its key. Also used for the items of list values.
+type AttrVal struct {
+	Value string    `json:"v"`
+	Kind  ValueKind `json:"kind,omitempty"`
+	List  []AttrVal `json:"list,omitempty"`
+}
+
+func NewIdentVal(vPP ParserProduct) (AttrVal, error) {
+	v, _, err := getTokVal(vPP)
+	if err != nil {
+		return AttrVal{}, err
+	}
+	// Bools aren't distinct tokens (so as not to reserve them as ids); they're
+	// just special ids.
+	if v == "true" || v == "false" {
+		return AttrVal{Value: v, Kind: BoolValue}, nil
+	}
+	return AttrVal{Value: v, Kind: IdentValue}, nil
+}
+
+func NewNumberVal(vPP ParserProduct) (AttrVal, error) {
+	v, _, err := getTokVal(vPP)
+	if err != nil {
+		return AttrVal{}, err
+	}
+	return AttrVal{Value: v, Kind: NumberValue}, nil
+}
+
+func NewStringVal(vPP ParserProduct) (AttrVal, error) {
+	v, err := Unquote(vPP)
+	if err != nil {
+		return AttrVal{}, err
+	}
+	// Interpolation was added in version 2; before that, `${` was nothing
+	// special.
+	_, pos, _ := getTokVal(vPP)
+	if !strings.Contains(v, "${") || !supports(pos, 2) {
+		return AttrVal{Value: v, Kind: StringValue}, nil
+	}
+	// References can only be resolved later on, but check they're well-formed.
+	_, err = Interpolate(v, func(string) (string, error) { return "", nil })
+	if err != nil {
+		return AttrVal{}, err
+	}
+	return AttrVal{Value: v, Kind: InterpolatedValue}, nil
+}
+
+func NewRawStringVal(vPP ParserProduct) (AttrVal, error) {
+	raw, pos, err := getTokVal(vPP)
+	if err != nil {
+		return AttrVal{}, err
+	}
+	if err := requireVersion(pos, 2, "raw strings"); err != nil {
+		return AttrVal{}, err
+	}
+	return AttrVal{Value: StripIndent(raw[1 : len(raw)-1]), Kind: StringValue}, nil
+}
+
+func NewParamVal(vPP ParserProduct) (AttrVal, error) {
+	v, _, err := getTokVal(vPP)
+	if err != nil {
+		return AttrVal{}, err
+	}
+	return AttrVal{Value: strings.TrimPrefix(v, "$"), Kind: ParamValue}, nil
+}
+
+func NewListVal(bracketPP, itemsPP ParserProduct) (AttrVal, error) {
+	_, pos, err := getTokVal(bracketPP)
+	if err != nil {
+		return AttrVal{}, fmt.Errorf("failed getting list position: %v", err)
+	}
+	if err := requireVersion(pos, 2, "list values"); err != nil {
+		return AttrVal{}, err
+	}
+	if itemsPP == nil {
+		return AttrVal{Kind: ListValue, List: []AttrVal{}}, nil
+	}
+	items, ok := itemsPP.([]AttrVal)
+	if !ok {
+		return AttrVal{}, fmt.Errorf("expected []AttrVal for list items, but got %T", itemsPP)
+	}
+	return AttrVal{Kind: ListValue, List: items}, nil
+}
+
+func NewListItems(itemPP ParserProduct) ([]AttrVal, error) {
+	item, ok := itemPP.(AttrVal)
+	if !ok {
+		return nil, fmt.Errorf("expected first list item to be AttrVal, but got %T", itemPP)
+	}
+	return []AttrVal{item}, nil
+}
+
+func AddListItem(itemsPP, itemPP ParserProduct) ([]AttrVal, error) {
+	items, ok := itemsPP.([]AttrVal)
+	if !ok {
+		return nil, fmt.Errorf("can't extend list; expected []AttrVal, but got %T", itemsPP)
+	}
+	item, ok := itemPP.(AttrVal)
+	if !ok {
+		return nil, fmt.Errorf("can't extend list; expected AttrVal item, but got %T", itemPP)
+	}
+	return append(items, item), nil
+}
+
 type Attrs []Attr
 
 func NewAttrs(attrPP ParserProduct) (Attrs, error) {
@@ -235,27 +1090,129 @@ func NewAttr(kPP, vPP ParserProduct) (Attr, error) {
 	if err != nil {
 		return Attr{}, fmt.Errorf("failed getting attr key name: %v", err)
 	}
-	v, err := getTokOrLiteralStr(vPP)
-	if err != nil {
-		return Attr{}, fmt.Errorf("failed getting value for attr '%s': %v", k, err)
+	v, ok := vPP.(AttrVal)
+	if !ok {
+		return Attr{}, fmt.Errorf("failed getting value for attr '%s': expected AttrVal, but got %T", k, vPP)
+	}
+	if err := requireDottedKeyVersion(k, pos); err != nil {
+		return Attr{}, err
 	}
 	// Always use the position metadata of the key, not value.
 	return Attr{
 		Key:   k,
-		Value: v,
+		Value: v.Value,
+		Kind:  v.Kind,
+		List:  v.List,
 		Pos:   pos,
 	}, nil
 }
 
+// NewAttrUnset makes an attr item that removes the attr with the given key,
+// e.g. `!rank`.
+func NewAttrUnset(kPP ParserProduct) (Attr, error) {
+	k, pos, err := getTokVal(kPP)
+	if err != nil {
+		return Attr{}, fmt.Errorf("failed getting name of attr to unset: %v", err)
+	}
+	if err := requireVersion(pos, 2, "unsetting attrs"); err != nil {
+		return Attr{}, err
+	}
+	if err := requireDottedKeyVersion(k, pos); err != nil {
+		return Attr{}, err
+	}
+	return Attr{Key: k, Unset: true, Pos: pos}, nil
+}
+
+func requireDottedKeyVersion(key string, pos token.Pos) error {
+	if !strings.Contains(key, ".") {
+		return nil
+	}
+	return requireVersion(pos, 2, "dotted attr keys")
+}
+
+// Unquote gives the value of a quoted-string token, processing any escape
+// sequences according to the settings in the token's context.
 func Unquote(quotedValPP ParserProduct) (string, error) {
-	quotedVal, _, err := getTokVal(quotedValPP)
+	quotedVal, pos, err := getTokVal(quotedValPP)
 	if err != nil {
 		return "", err
 	}
-	val := quotedVal[1 : len(quotedVal)-1]
-	// un-escape any quotes.
-	// (No other escape sequences are supported, to keep things simple)
-	return strings.ReplaceAll(val, `\"`, `"`), nil
+	return Unescape(quotedVal[1:len(quotedVal)-1], settingsFor(pos).LegacyEscapes)
+}
+
+// ErrInvalidId is for ids that can't be used, even quoted; see ValidId.
+var ErrInvalidId = errors.New("invalid node id")
+
+// ValidId reports whether a node or group id is usable. Ids that aren't valid
+// bare ids can be written as quoted strings, so almost anything goes; but
+// empty ids, and ones with control chars (incl. newlines), are too confusing.
+func ValidId(id string) bool {
+	if id == "" || !utf8.ValidString(id) {
+		return false
+	}
+	return !strings.ContainsFunc(id, unicode.IsControl)
+}
+
+// UnquoteId converts a quoted-string token that's being used as an id into an
+// equivalent token holding the plain id, so that it can be handled the same as
+// a bare id.
+func UnquoteId(quotedPP ParserProduct) (*token.Token, error) {
+	tok, ok := quotedPP.(*token.Token)
+	if !ok {
+		return nil, fmt.Errorf("expected *token.Token for quoted id, but got %T", quotedPP)
+	}
+	if err := requireVersion(tok.Pos, 2, "quoted ids"); err != nil {
+		return nil, err
+	}
+	id, err := Unquote(tok)
+	if err != nil {
+		return nil, err
+	}
+	if !ValidId(id) {
+		return nil, fmt.Errorf("%w %q (at %s)", ErrInvalidId, id, tok.Pos)
+	}
+	return &token.Token{Type: tok.Type, Lit: []byte(id), Pos: tok.Pos}, nil
+}
+
+// NamespacedId is a quoted id with its namespace written before it, e.g.
+// `payments."eu-west-1"`.
+type NamespacedId struct {
+	Namespace string
+	Local     string
+	Pos       token.Pos
+}
+
+func NewNamespacedId(prefixPP, quotedPP ParserProduct) (*NamespacedId, error) {
+	prefix, pos, err := getTokVal(prefixPP)
+	if err != nil {
+		return nil, fmt.Errorf("failed getting namespace of quoted id: %v", err)
+	}
+	if err := requireVersion(pos, 2, "namespaced ids"); err != nil {
+		return nil, err
+	}
+	local, err := UnquoteId(quotedPP)
+	if err != nil {
+		return nil, err
+	}
+	return &NamespacedId{Namespace: strings.TrimSuffix(prefix, "."), Local: string(local.Lit), Pos: pos}, nil
+}
+
+// getNodeId gives the id from a NodeId parser product, along with the
+// namespace it's explicitly in, if any. Only bare dotted ids, e.g.
+// `payments.api`, and quoted ids with a namespace before them are in one; dots
+// within other quoted ids are just part of the id.
+func getNodeId(arg ParserProduct) (id, ns string, pos token.Pos, err error) {
+	switch coerced := arg.(type) {
+	case *NamespacedId:
+		return coerced.Namespace + "." + coerced.Local, coerced.Namespace, coerced.Pos, nil
+	case *token.Token:
+		id = string(coerced.Lit)
+		if coerced.Type == token.TokMap.Type("dotted_id") {
+			ns = id[:strings.LastIndexByte(id, '.')]
+		}
+		return id, ns, coerced.Pos, nil
+	}
+	return "", "", token.Pos{}, fmt.Errorf("expected *token.Token or *NamespacedId, but got %T", arg)
 }
 
 // getTokVal is a util for getting string values from parser tokens. Mostly a noise-saver for type
@@ -0,0 +1,125 @@
+package ast
+
+import (
+	"strings"
+
+	"github.com/orls/lilgraph/internal/gocc/token"
+)
+
+// Doc comments, i.e. `/// ...` lines or a `/** ... */` block, directly before
+// a node or edge decl document it. The lexer discards comments, so DocScanner
+// picks them out of the gaps between tokens, and records them in the lexer's
+// context for the parser's actions to find; see docBefore.
+
+// DocScanner passes on tokens from a lexer of src, recording any doc comments
+// that directly precede them.
+type DocScanner struct {
+	lex     Scanner
+	src     []byte
+	prevEnd int
+}
+
+func NewDocScanner(lex Scanner, src []byte) *DocScanner {
+	return &DocScanner{lex: lex, src: src}
+}
+
+func (s *DocScanner) Scan() *token.Token {
+	tok := s.lex.Scan()
+	if tok.Pos.Offset > s.prevEnd && tok.Pos.Offset <= len(s.src) {
+		if doc := docInGap(string(s.src[s.prevEnd:tok.Pos.Offset])); doc != "" {
+			if c, ok := tok.Pos.Context.(interface{ setDoc(int, string) }); ok {
+				c.setDoc(tok.Pos.Offset, doc)
+			}
+		}
+	}
+	s.prevEnd = tok.Pos.Offset + len(tok.Lit)
+	return tok
+}
+
+// docBefore gives the doc comment directly before the token at pos, if any.
+// In version 1, doc comments were just comments.
+func docBefore(pos token.Pos) string {
+	c, ok := pos.Context.(interface{ docAt(int) string })
+	if !ok || !supports(pos, 2) {
+		return ""
+	}
+	return c.docAt(pos.Offset)
+}
+
+// docInGap finds the doc comment, if any, at the end of a gap between tokens,
+// which can only contain whitespace & comments. A doc comment has to be on the
+// line before the next token, or the same line; a blank line or another
+// comment in between detaches it.
+func docInGap(gap string) string {
+	var lines []string
+	// Consecutive `///` lines make up a single doc comment.
+	lineDoc := false
+	newlines := 0
+	for len(gap) > 0 {
+		switch {
+		case gap[0] == '\n':
+			newlines++
+			if newlines > 1 || lineDoc {
+				lines, lineDoc = nil, false
+			}
+			gap = gap[1:]
+		case strings.HasPrefix(gap, "/*"):
+			end := strings.Index(gap[2:], "*/")
+			if end < 0 {
+				return ""
+			}
+			comment := gap[:end+4]
+			lines, lineDoc, newlines = nil, false, 0
+			if isDocComment(comment, "/**") && comment != "/**/" {
+				lines = blockDocLines(comment[3 : len(comment)-2])
+			}
+			gap = gap[len(comment):]
+		case strings.HasPrefix(gap, "//") || gap[0] == '#':
+			comment, rest, _ := strings.Cut(gap, "\n")
+			if isDocComment(comment, "///") {
+				if !lineDoc {
+					lines = nil
+				}
+				text := strings.TrimPrefix(comment[3:], " ")
+				lines, lineDoc = append(lines, strings.TrimRight(text, " \t\r")), true
+			} else {
+				lines, lineDoc = nil, false
+			}
+			// The comment's own newline ends its line, rather than being a
+			// blank one.
+			newlines = 0
+			gap = rest
+		default:
+			gap = gap[1:]
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// isDocComment reports whether a comment starts with the given doc marker,
+// e.g. `///`; but not with a longer run, like `////`, which is more likely to
+// be decoration.
+func isDocComment(comment, marker string) bool {
+	return strings.HasPrefix(comment, marker) && !strings.HasPrefix(comment, marker+marker[len(marker)-1:])
+}
+
+// blockDocLines gives the lines of a `/** ... */` doc comment's content,
+// without indentation, any leading `*` decoration, or blank first or last
+// lines.
+func blockDocLines(content string) []string {
+	lines := strings.Split(content, "\n")
+	for i, line := range lines {
+		line = strings.TrimLeft(line, " \t")
+		if trimmed, ok := strings.CutPrefix(line, "*"); ok {
+			line = strings.TrimPrefix(trimmed, " ")
+		}
+		lines[i] = strings.TrimRight(line, " \t\r")
+	}
+	if len(lines) > 0 && lines[0] == "" {
+		lines = lines[1:]
+	}
+	if len(lines) > 0 && lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
@@ -0,0 +1,121 @@
+package ast
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/orls/lilgraph/internal/gocc/lexer"
+	"github.com/orls/lilgraph/internal/gocc/token"
+)
+
+// Edge keys, e.g. the `#primary` of `a -[link#primary]-> b`, start with `#`,
+// as do comments. The lexer always takes the longer match, so it sees a key
+// and the rest of its line as a comment. KeyScanner picks keys out where they
+// can appear -- directly after an edge's `-[`, or the type within it -- and
+// re-lexes the rest of the line. Anywhere else, `#` starts a comment, as ever.
+
+// KeyScanner passes on tokens from a lexer of src, along with any edge keys.
+type KeyScanner struct {
+	lex Scanner
+	src []byte
+
+	// The last two tokens passed on, most recent first.
+	prev [2]*token.Token
+}
+
+func NewKeyScanner(lex Scanner, src []byte) *KeyScanner {
+	return &KeyScanner{lex: lex, src: src}
+}
+
+var (
+	edgeKeyType   = token.TokMap.Type("edge_key")
+	idType        = token.TokMap.Type("id")
+	edgeOpenTypes = []token.Type{
+		token.TokMap.Type("edge_attr_open"),
+		token.TokMap.Type("edge_attr_open_head"),
+	}
+)
+
+func (s *KeyScanner) Scan() *token.Token {
+	tok := s.keyAfterPrev()
+	if tok == nil {
+		tok = s.lex.Scan()
+	}
+	s.prev = [2]*token.Token{tok, s.prev[0]}
+	return tok
+}
+
+// keyAfterPrev gives the key directly after the previous token, if it can be
+// followed by one, and then carries on lexing after the key.
+func (s *KeyScanner) keyAfterPrev() *token.Token {
+	prev := s.prev[0]
+	if prev == nil || !s.keyCanFollow() || !supports(prev.Pos, 2) {
+		return nil
+	}
+	start := prev.Pos.Offset + len(prev.Lit)
+	end := start + 1
+	for end < len(s.src) && isKeyChar(s.src[end]) {
+		end++
+	}
+	if start >= len(s.src) || s.src[start] != '#' || end == start+1 {
+		return nil
+	}
+	// Tokens that can be followed by a key never span lines, nor contain
+	// anything but ASCII.
+	pos := token.Pos{
+		Offset:  start,
+		Line:    prev.Pos.Line,
+		Column:  prev.Pos.Column + len(prev.Lit),
+		Context: prev.Pos.Context,
+	}
+	lex := lexer.NewLexer(s.src[end:])
+	lex.Context = prev.Pos.Context
+	s.lex = &shiftedScanner{lex: lex, start: pos, shift: end - start}
+	return &token.Token{Type: edgeKeyType, Lit: s.src[start:end], Pos: pos}
+}
+
+func (s *KeyScanner) keyCanFollow() bool {
+	prev, prevPrev := s.prev[0], s.prev[1]
+	if slices.Contains(edgeOpenTypes, prev.Type) {
+		return true
+	}
+	isWord := prev.Type == idType || slices.Contains(laterKeywords, prev.Type)
+	return isWord && prevPrev != nil && slices.Contains(edgeOpenTypes, prevPrev.Type)
+}
+
+func isKeyChar(c byte) bool {
+	return c == '_' || 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9'
+}
+
+// shiftedScanner passes on tokens from a lexer of the source after an edge
+// key, with their positions adjusted to be within the whole source.
+type shiftedScanner struct {
+	lex Scanner
+	// Where the key started, and its length.
+	start token.Pos
+	shift int
+}
+
+func (s *shiftedScanner) Scan() *token.Token {
+	tok := s.lex.Scan()
+	if tok.Pos.Line == 1 {
+		tok.Pos.Column += s.start.Column + s.shift - 1
+	}
+	tok.Pos.Line += s.start.Line - 1
+	tok.Pos.Offset += s.start.Offset + s.shift
+	return tok
+}
+
+// NewKeyedType joins an edge's type with its key, e.g. `link#primary`, for
+// NewEdgeStep to split apart again.
+func NewKeyedType(typPP, keyPP ParserProduct) (*token.Token, error) {
+	typ, ok := typPP.(*token.Token)
+	if !ok {
+		return nil, fmt.Errorf("expected *token.Token for edge type, but got %T", typPP)
+	}
+	key, ok := keyPP.(*token.Token)
+	if !ok {
+		return nil, fmt.Errorf("expected *token.Token for edge key, but got %T", keyPP)
+	}
+	return &token.Token{Type: typ.Type, Lit: slices.Concat(typ.Lit, key.Lit), Pos: typ.Pos}, nil
+}
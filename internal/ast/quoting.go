@@ -0,0 +1,247 @@
+package ast
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf16"
+	"unicode/utf8"
+
+	"github.com/orls/lilgraph/internal/gocc/token"
+)
+
+// Settings affect how the parser's actions interpret tokens. They reach the
+// actions via the lexer's token.Context; see NewContext.
+type Settings struct {
+	// LegacyEscapes restricts quoted strings to the original, DOT-like rules,
+	// where \" is the only escape sequence.
+	LegacyEscapes bool
+	// Version is the language version the file declares, or 0 if it doesn't
+	// declare one.
+	Version int
+}
+
+// NewContext makes a token.Context for the lexer that carries the given
+// settings and, if known, the path of the file being parsed.
+func NewContext(path string, settings Settings) token.Context {
+	c := settingsContext{settings: settings, docs: map[int]string{}}
+	if path == "" {
+		return &c
+	}
+	return &fileContext{settingsContext: c, path: path}
+}
+
+type settingsContext struct {
+	settings Settings
+
+	// Doc comments, by the offset of the token that they precede; see
+	// DocScanner.
+	docs map[int]string
+}
+
+func (c *settingsContext) Settings() Settings { return c.settings }
+
+func (c *settingsContext) setDoc(offset int, doc string) { c.docs[offset] = doc }
+func (c *settingsContext) docAt(offset int) string       { return c.docs[offset] }
+
+// fileContext is a token.Sourcer, so positions & parse errors name the file.
+type fileContext struct {
+	settingsContext
+	path string
+}
+
+func (c *fileContext) Source() string { return c.path }
+
+func settingsFor(pos token.Pos) Settings {
+	if c, ok := pos.Context.(interface{ Settings() Settings }); ok {
+		return c.Settings()
+	}
+	return Settings{}
+}
+
+// Unescape processes the escape sequences in the content of a quoted string:
+//
+//	\"  double quote
+//	\\  backslash
+//	\n  newline
+//	\t  tab
+//	\r  carriage return
+//	\uXXXX  the unicode code point with the given 4 hex digits
+//
+// A backslash followed by anything else is left as-is, so e.g. "¯\_(ツ)_/¯"
+// needs no escaping.
+//
+// In legacy mode, only \" is un-escaped, and all other text is left unchanged.
+func Unescape(s string, legacy bool) (string, error) {
+	if legacy {
+		return strings.ReplaceAll(s, `\"`, `"`), nil
+	}
+	if !strings.Contains(s, `\`) {
+		return s, nil
+	}
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] != '\\' || i == len(s)-1 {
+			b.WriteByte(s[i])
+			continue
+		}
+		i++
+		switch s[i] {
+		case '"', '\\':
+			b.WriteByte(s[i])
+		case 'n':
+			b.WriteByte('\n')
+		case 't':
+			b.WriteByte('\t')
+		case 'r':
+			b.WriteByte('\r')
+		case 'u':
+			if i+4 >= len(s) {
+				return "", fmt.Errorf("invalid escape sequence %q: expected 4 hex digits", s[i-1:])
+			}
+			code, err := strconv.ParseUint(s[i+1:i+5], 16, 16)
+			if err != nil || utf16.IsSurrogate(rune(code)) {
+				return "", fmt.Errorf("invalid escape sequence %q", s[i-1:i+5])
+			}
+			b.WriteRune(rune(code))
+			i += 4
+		default:
+			b.WriteByte('\\')
+			b.WriteByte(s[i])
+		}
+	}
+	return b.String(), nil
+}
+
+// Escape is the inverse of Unescape, giving the content of a quoted string
+// that holds s. Newlines and tabs are left as they are, as quoted strings can
+// contain them literally; other control chars are escaped.
+//
+// In legacy mode, only double quotes are escaped. Note that not every value
+// can be written in legacy mode; ones ending in a backslash, or with one
+// before a double quote, can't.
+func Escape(s string, legacy bool) string {
+	if legacy {
+		return strings.ReplaceAll(s, `"`, `\"`)
+	}
+	var b strings.Builder
+	for i, r := range s {
+		switch {
+		case r == utf8.RuneError:
+			// Keep any invalid bytes as they were; but an actual U+FFFD isn't
+			// allowed in source, so must be escaped.
+			if _, size := utf8.DecodeRuneInString(s[i:]); size == 1 {
+				b.WriteByte(s[i])
+			} else {
+				b.WriteString(`\ufffd`)
+			}
+		case r == '"' || r == '\\':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case r == '\r':
+			b.WriteString(`\r`)
+		case r == '\n' || r == '\t':
+			b.WriteRune(r)
+		case unicode.IsControl(r):
+			fmt.Fprintf(&b, `\u%04x`, r)
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// Interpolate replaces each `${name}` reference in s with the value given by
+// lookup, and each `$${` with a literal `${`. A `$` that isn't part of either
+// is left as-is. Names must be valid ids.
+func Interpolate(s string, lookup func(name string) (string, error)) (string, error) {
+	var b strings.Builder
+	for {
+		i := strings.IndexByte(s, '$')
+		if i < 0 {
+			b.WriteString(s)
+			return b.String(), nil
+		}
+		b.WriteString(s[:i])
+		s = s[i:]
+		switch {
+		case strings.HasPrefix(s, "$${"):
+			b.WriteString("${")
+			s = s[3:]
+		case strings.HasPrefix(s, "${"):
+			end := strings.IndexByte(s, '}')
+			if end < 0 {
+				return "", fmt.Errorf("unterminated reference %q", s)
+			}
+			name := s[2:end]
+			if !isIdent(name) {
+				return "", fmt.Errorf("invalid reference %q", s[:end+1])
+			}
+			val, err := lookup(name)
+			if err != nil {
+				return "", err
+			}
+			b.WriteString(val)
+			s = s[end+1:]
+		default:
+			b.WriteByte('$')
+			s = s[1:]
+		}
+	}
+}
+
+// isIdent reports whether s is a bare id, as per the grammar's `id` token.
+func isIdent(s string) bool {
+	for i, r := range s {
+		if !(r == '_' || 'a' <= r && r <= 'z' || 'A' <= r && r <= 'Z' || i > 0 && '0' <= r && r <= '9') {
+			return false
+		}
+	}
+	return s != ""
+}
+
+// StripIndent gives the value of a raw string from its content. Single-line
+// content is used as-is. For multi-line content, a blank first or last line is
+// dropped, and then any leading whitespace common to all non-blank lines is
+// removed from every line.
+func StripIndent(s string) string {
+	if !strings.Contains(s, "\n") {
+		return s
+	}
+	lines := strings.Split(s, "\n")
+	if isBlank(lines[0]) {
+		lines = lines[1:]
+	}
+	if len(lines) > 0 && isBlank(lines[len(lines)-1]) {
+		lines = lines[:len(lines)-1]
+	}
+	margin, found := "", false
+	for _, line := range lines {
+		if isBlank(line) {
+			continue
+		}
+		lead := line[:len(line)-len(strings.TrimLeft(line, " \t"))]
+		if !found {
+			margin, found = lead, true
+		} else {
+			margin = margin[:commonPrefixLen(margin, lead)]
+		}
+	}
+	for i, line := range lines {
+		lines[i] = line[commonPrefixLen(line, margin):]
+	}
+	return strings.Join(lines, "\n")
+}
+
+func isBlank(line string) bool {
+	return strings.TrimLeft(line, " \t") == ""
+}
+
+func commonPrefixLen(a, b string) int {
+	n := 0
+	for n < len(a) && n < len(b) && a[n] == b[n] {
+		n++
+	}
+	return n
+}
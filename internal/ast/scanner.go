@@ -0,0 +1,40 @@
+package ast
+
+import (
+	"slices"
+
+	"github.com/orls/lilgraph/internal/gocc/token"
+)
+
+// Scanner is a source of tokens for the parser, e.g. the lexer.
+type Scanner interface {
+	Scan() *token.Token
+}
+
+// NewScanner wraps a lexer of src with everything the parser needs that the
+// lexer can't do itself: keywords as per the declared version, edge keys, and
+// doc comments.
+func NewScanner(lex Scanner, src []byte) Scanner {
+	return NewDocScanner(&versionScanner{NewKeyScanner(lex, src)}, src)
+}
+
+// Keywords that were added after version 1, so were plain ids before.
+var laterKeywords = []token.Type{
+	token.TokMap.Type("subgraph"),
+	token.TokMap.Type("include"),
+	token.TokMap.Type("_"),
+}
+
+// versionScanner passes on tokens from a lexer, but in files that declare
+// version 1, turns keywords that were added later back into plain ids.
+type versionScanner struct {
+	lex Scanner
+}
+
+func (s *versionScanner) Scan() *token.Token {
+	tok := s.lex.Scan()
+	if slices.Contains(laterKeywords, tok.Type) && !supports(tok.Pos, 2) {
+		tok.Type = idType
+	}
+	return tok
+}
@@ -0,0 +1,52 @@
+package ast
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/orls/lilgraph/internal/gocc/token"
+)
+
+// LatestVersion is the latest version of the language. Version 1 is the
+// original language; version 2 adds everything since, e.g. subgraphs,
+// includes, non-directed edges, list values and escape sequences.
+const LatestVersion = 2
+
+// ErrVersion is for syntax that isn't supported by the version of the
+// language that a file declares.
+var ErrVersion = errors.New("not supported by declared language version")
+
+// ParseVersion gives the version number from a version pragma, e.g. the "2"
+// of `lilgraph 2`.
+func ParseVersion(num string) (int, error) {
+	v, err := strconv.Atoi(num)
+	if err != nil || v < 1 || v > LatestVersion {
+		return 0, fmt.Errorf("unsupported language version '%s'; the latest is %d", num, LatestVersion)
+	}
+	return v, nil
+}
+
+// supports reports whether the file a token is from can use features that
+// were added in the given version of the language. Files that don't declare a
+// version can use everything.
+func supports(pos token.Pos, version int) bool {
+	declared := settingsFor(pos).Version
+	return declared == 0 || declared >= version
+}
+
+// requireVersion checks that the file a token is from can use a feature that
+// was added in the given version of the language.
+func requireVersion(pos token.Pos, version int, feature string) error {
+	if declared := settingsFor(pos).Version; !supports(pos, version) {
+		return fmt.Errorf(
+			"%w: %s need lilgraph %d, but the file declares lilgraph %d (at %s)",
+			ErrVersion,
+			feature,
+			version,
+			declared,
+			pos,
+		)
+	}
+	return nil
+}
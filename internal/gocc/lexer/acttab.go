@@ -25,19 +25,19 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S1
-		Accept: -1,
-		Ignore: "!whitespace",
-	},
-	ActionRow{ // S2
 		Accept: 0,
 		Ignore: "",
 	},
+	ActionRow{ // S2
+		Accept: -1,
+		Ignore: "!whitespace",
+	},
 	ActionRow{ // S3
-		Accept: 0,
+		Accept: 33,
 		Ignore: "",
 	},
 	ActionRow{ // S4
-		Accept: 10,
+		Accept: 0,
 		Ignore: "",
 	},
 	ActionRow{ // S5
@@ -49,47 +49,47 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S7
-		Accept: 0,
+		Accept: 29,
 		Ignore: "",
 	},
 	ActionRow{ // S8
-		Accept: 12,
+		Accept: 30,
 		Ignore: "",
 	},
 	ActionRow{ // S9
-		Accept: 3,
+		Accept: 11,
 		Ignore: "",
 	},
 	ActionRow{ // S10
-		Accept: 11,
+		Accept: 0,
 		Ignore: "",
 	},
 	ActionRow{ // S11
-		Accept: 4,
+		Accept: 0,
 		Ignore: "",
 	},
 	ActionRow{ // S12
-		Accept: 5,
+		Accept: 0,
 		Ignore: "",
 	},
 	ActionRow{ // S13
-		Accept: 6,
+		Accept: 37,
 		Ignore: "",
 	},
 	ActionRow{ // S14
-		Accept: 4,
+		Accept: 25,
 		Ignore: "",
 	},
 	ActionRow{ // S15
-		Accept: 0,
+		Accept: 3,
 		Ignore: "",
 	},
 	ActionRow{ // S16
-		Accept: 13,
+		Accept: 0,
 		Ignore: "",
 	},
 	ActionRow{ // S17
-		Accept: 0,
+		Accept: 27,
 		Ignore: "",
 	},
 	ActionRow{ // S18
@@ -97,43 +97,43 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S19
-		Accept: -1,
-		Ignore: "!comment",
+		Accept: 4,
+		Ignore: "",
 	},
 	ActionRow{ // S20
-		Accept: 0,
+		Accept: 8,
 		Ignore: "",
 	},
 	ActionRow{ // S21
-		Accept: 7,
+		Accept: 9,
 		Ignore: "",
 	},
 	ActionRow{ // S22
-		Accept: 8,
+		Accept: 10,
 		Ignore: "",
 	},
 	ActionRow{ // S23
-		Accept: 12,
+		Accept: 0,
 		Ignore: "",
 	},
 	ActionRow{ // S24
-		Accept: 0,
+		Accept: 4,
 		Ignore: "",
 	},
 	ActionRow{ // S25
-		Accept: 0,
+		Accept: 4,
 		Ignore: "",
 	},
 	ActionRow{ // S26
-		Accept: 0,
+		Accept: 23,
 		Ignore: "",
 	},
 	ActionRow{ // S27
-		Accept: 4,
+		Accept: 24,
 		Ignore: "",
 	},
 	ActionRow{ // S28
-		Accept: 0,
+		Accept: 22,
 		Ignore: "",
 	},
 	ActionRow{ // S29
@@ -141,7 +141,7 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S30
-		Accept: 0,
+		Accept: 6,
 		Ignore: "",
 	},
 	ActionRow{ // S31
@@ -153,15 +153,387 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S33
-		Accept: 12,
-		Ignore: "",
+		Accept: -1,
+		Ignore: "!comment",
 	},
 	ActionRow{ // S34
-		Accept: 9,
+		Accept: 39,
 		Ignore: "",
 	},
 	ActionRow{ // S35
+		Accept: 39,
+		Ignore: "",
+	},
+	ActionRow{ // S36
+		Accept: 15,
+		Ignore: "",
+	},
+	ActionRow{ // S37
+		Accept: 14,
+		Ignore: "",
+	},
+	ActionRow{ // S38
+		Accept: 18,
+		Ignore: "",
+	},
+	ActionRow{ // S39
+		Accept: 37,
+		Ignore: "",
+	},
+	ActionRow{ // S40
+		Accept: 0,
+		Ignore: "",
+	},
+	ActionRow{ // S41
+		Accept: 0,
+		Ignore: "",
+	},
+	ActionRow{ // S42
+		Accept: 0,
+		Ignore: "",
+	},
+	ActionRow{ // S43
+		Accept: 17,
+		Ignore: "",
+	},
+	ActionRow{ // S44
+		Accept: 0,
+		Ignore: "",
+	},
+	ActionRow{ // S45
+		Accept: 0,
+		Ignore: "",
+	},
+	ActionRow{ // S46
+		Accept: 0,
+		Ignore: "",
+	},
+	ActionRow{ // S47
+		Accept: 0,
+		Ignore: "",
+	},
+	ActionRow{ // S48
+		Accept: 0,
+		Ignore: "",
+	},
+	ActionRow{ // S49
+		Accept: 0,
+		Ignore: "",
+	},
+	ActionRow{ // S50
+		Accept: 0,
+		Ignore: "",
+	},
+	ActionRow{ // S51
+		Accept: 7,
+		Ignore: "",
+	},
+	ActionRow{ // S52
+		Accept: 4,
+		Ignore: "",
+	},
+	ActionRow{ // S53
+		Accept: 4,
+		Ignore: "",
+	},
+	ActionRow{ // S54
+		Accept: 21,
+		Ignore: "",
+	},
+	ActionRow{ // S55
+		Accept: 0,
+		Ignore: "",
+	},
+	ActionRow{ // S56
+		Accept: 38,
+		Ignore: "",
+	},
+	ActionRow{ // S57
+		Accept: 4,
+		Ignore: "",
+	},
+	ActionRow{ // S58
+		Accept: 4,
+		Ignore: "",
+	},
+	ActionRow{ // S59
+		Accept: 0,
+		Ignore: "",
+	},
+	ActionRow{ // S60
+		Accept: 0,
+		Ignore: "",
+	},
+	ActionRow{ // S61
+		Accept: 0,
+		Ignore: "",
+	},
+	ActionRow{ // S62
+		Accept: 39,
+		Ignore: "",
+	},
+	ActionRow{ // S63
+		Accept: 0,
+		Ignore: "",
+	},
+	ActionRow{ // S64
+		Accept: 37,
+		Ignore: "",
+	},
+	ActionRow{ // S65
+		Accept: 16,
+		Ignore: "",
+	},
+	ActionRow{ // S66
+		Accept: 19,
+		Ignore: "",
+	},
+	ActionRow{ // S67
+		Accept: 0,
+		Ignore: "",
+	},
+	ActionRow{ // S68
+		Accept: 0,
+		Ignore: "",
+	},
+	ActionRow{ // S69
+		Accept: 0,
+		Ignore: "",
+	},
+	ActionRow{ // S70
+		Accept: 0,
+		Ignore: "",
+	},
+	ActionRow{ // S71
+		Accept: 0,
+		Ignore: "",
+	},
+	ActionRow{ // S72
+		Accept: 0,
+		Ignore: "",
+	},
+	ActionRow{ // S73
+		Accept: 0,
+		Ignore: "",
+	},
+	ActionRow{ // S74
+		Accept: 5,
+		Ignore: "",
+	},
+	ActionRow{ // S75
+		Accept: 5,
+		Ignore: "",
+	},
+	ActionRow{ // S76
+		Accept: 20,
+		Ignore: "",
+	},
+	ActionRow{ // S77
+		Accept: 4,
+		Ignore: "",
+	},
+	ActionRow{ // S78
+		Accept: 4,
+		Ignore: "",
+	},
+	ActionRow{ // S79
 		Accept: -1,
 		Ignore: "!comment",
 	},
+	ActionRow{ // S80
+		Accept: 0,
+		Ignore: "",
+	},
+	ActionRow{ // S81
+		Accept: 0,
+		Ignore: "",
+	},
+	ActionRow{ // S82
+		Accept: 0,
+		Ignore: "",
+	},
+	ActionRow{ // S83
+		Accept: 26,
+		Ignore: "",
+	},
+	ActionRow{ // S84
+		Accept: 0,
+		Ignore: "",
+	},
+	ActionRow{ // S85
+		Accept: 0,
+		Ignore: "",
+	},
+	ActionRow{ // S86
+		Accept: 31,
+		Ignore: "",
+	},
+	ActionRow{ // S87
+		Accept: 7,
+		Ignore: "",
+	},
+	ActionRow{ // S88
+		Accept: 5,
+		Ignore: "",
+	},
+	ActionRow{ // S89
+		Accept: 4,
+		Ignore: "",
+	},
+	ActionRow{ // S90
+		Accept: 4,
+		Ignore: "",
+	},
+	ActionRow{ // S91
+		Accept: 0,
+		Ignore: "",
+	},
+	ActionRow{ // S92
+		Accept: 0,
+		Ignore: "",
+	},
+	ActionRow{ // S93
+		Accept: 0,
+		Ignore: "",
+	},
+	ActionRow{ // S94
+		Accept: 0,
+		Ignore: "",
+	},
+	ActionRow{ // S95
+		Accept: 0,
+		Ignore: "",
+	},
+	ActionRow{ // S96
+		Accept: 5,
+		Ignore: "",
+	},
+	ActionRow{ // S97
+		Accept: 5,
+		Ignore: "",
+	},
+	ActionRow{ // S98
+		Accept: 4,
+		Ignore: "",
+	},
+	ActionRow{ // S99
+		Accept: 4,
+		Ignore: "",
+	},
+	ActionRow{ // S100
+		Accept: 0,
+		Ignore: "",
+	},
+	ActionRow{ // S101
+		Accept: 0,
+		Ignore: "",
+	},
+	ActionRow{ // S102
+		Accept: 34,
+		Ignore: "",
+	},
+	ActionRow{ // S103
+		Accept: 0,
+		Ignore: "",
+	},
+	ActionRow{ // S104
+		Accept: 0,
+		Ignore: "",
+	},
+	ActionRow{ // S105
+		Accept: 5,
+		Ignore: "",
+	},
+	ActionRow{ // S106
+		Accept: 4,
+		Ignore: "",
+	},
+	ActionRow{ // S107
+		Accept: 4,
+		Ignore: "",
+	},
+	ActionRow{ // S108
+		Accept: 0,
+		Ignore: "",
+	},
+	ActionRow{ // S109
+		Accept: 0,
+		Ignore: "",
+	},
+	ActionRow{ // S110
+		Accept: 0,
+		Ignore: "",
+	},
+	ActionRow{ // S111
+		Accept: 0,
+		Ignore: "",
+	},
+	ActionRow{ // S112
+		Accept: 13,
+		Ignore: "",
+	},
+	ActionRow{ // S113
+		Accept: 4,
+		Ignore: "",
+	},
+	ActionRow{ // S114
+		Accept: 0,
+		Ignore: "",
+	},
+	ActionRow{ // S115
+		Accept: 0,
+		Ignore: "",
+	},
+	ActionRow{ // S116
+		Accept: 0,
+		Ignore: "",
+	},
+	ActionRow{ // S117
+		Accept: 0,
+		Ignore: "",
+	},
+	ActionRow{ // S118
+		Accept: 12,
+		Ignore: "",
+	},
+	ActionRow{ // S119
+		Accept: 35,
+		Ignore: "",
+	},
+	ActionRow{ // S120
+		Accept: 0,
+		Ignore: "",
+	},
+	ActionRow{ // S121
+		Accept: 0,
+		Ignore: "",
+	},
+	ActionRow{ // S122
+		Accept: 28,
+		Ignore: "",
+	},
+	ActionRow{ // S123
+		Accept: 0,
+		Ignore: "",
+	},
+	ActionRow{ // S124
+		Accept: 32,
+		Ignore: "",
+	},
+	ActionRow{ // S125
+		Accept: 0,
+		Ignore: "",
+	},
+	ActionRow{ // S126
+		Accept: 0,
+		Ignore: "",
+	},
+	ActionRow{ // S127
+		Accept: 0,
+		Ignore: "",
+	},
+	ActionRow{ // S128
+		Accept: 36,
+		Ignore: "",
+	},
 }
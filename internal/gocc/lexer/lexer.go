@@ -11,8 +11,8 @@ import (
 
 const (
 	NoState    = -1
-	NumStates  = 36
-	NumSymbols = 48
+	NumStates  = 129
+	NumSymbols = 155
 )
 
 type Lexer struct {
@@ -129,52 +129,159 @@ func (l *Lexer) Reset() {
 
 /*
 Lexer symbols:
-0: '-'
+0: '.'
 1: '.'
-2: '-'
+2: '.'
 3: '.'
-4: '"'
-5: '"'
+4: '-'
+5: '.'
 6: '-'
-7: '-'
-8: '>'
-9: '-'
-10: '-'
-11: '['
-12: ']'
+7: '.'
+8: '"'
+9: '"'
+10: '`'
+11: '`'
+12: '$'
 13: '-'
 14: '-'
 15: '>'
-16: ';'
-17: '['
-18: ']'
-19: ','
-20: '='
-21: '_'
-22: '\'
-23: '"'
-24: '\'
-25: '/'
-26: '/'
-27: '\n'
-28: '#'
-29: '\n'
-30: '/'
-31: '*'
-32: '*'
-33: '*'
-34: '/'
-35: ' '
-36: '\t'
-37: '\r'
-38: '\n'
-39: 'a'-'z'
-40: 'A'-'Z'
-41: '0'-'9'
-42: \u0001-'!'
-43: '#'-'['
-44: ']'-\u007f
-45: \u0080-\ufffc
-46: \ufffe-\U0010ffff
-47: .
+16: '-'
+17: '-'
+18: '['
+19: ']'
+20: '-'
+21: '-'
+22: '>'
+23: '-'
+24: '-'
+25: '-'
+26: '<'
+27: '-'
+28: '-'
+29: '>'
+30: '<'
+31: '-'
+32: '-'
+33: '<'
+34: '-'
+35: '-'
+36: '['
+37: ']'
+38: '-'
+39: '-'
+40: \u0000
+41: '#'
+42: ';'
+43: '['
+44: ']'
+45: '_'
+46: ','
+47: 's'
+48: 'u'
+49: 'b'
+50: 'g'
+51: 'r'
+52: 'a'
+53: 'p'
+54: 'h'
+55: 'i'
+56: 'n'
+57: 'c'
+58: 'l'
+59: 'u'
+60: 'd'
+61: 'e'
+62: '{'
+63: '}'
+64: ':'
+65: '@'
+66: 'l'
+67: 'e'
+68: 't'
+69: '='
+70: '@'
+71: 't'
+72: 'e'
+73: 'm'
+74: 'p'
+75: 'l'
+76: 'a'
+77: 't'
+78: 'e'
+79: '('
+80: ')'
+81: '@'
+82: 'u'
+83: 's'
+84: 'e'
+85: '@'
+86: 'n'
+87: 'a'
+88: 'm'
+89: 'e'
+90: 's'
+91: 'p'
+92: 'a'
+93: 'c'
+94: 'e'
+95: '!'
+96: '@'
+97: 'g'
+98: 'r'
+99: 'a'
+100: 'p'
+101: 'h'
+102: '@'
+103: 'd'
+104: 'e'
+105: 'f'
+106: 'a'
+107: 'u'
+108: 'l'
+109: 't'
+110: 's'
+111: '@'
+112: 'e'
+113: 'd'
+114: 'g'
+115: 'e'
+116: '_'
+117: 'd'
+118: 'e'
+119: 'f'
+120: 'a'
+121: 'u'
+122: 'l'
+123: 't'
+124: 's'
+125: '_'
+126: '\'
+127: '"'
+128: '\'
+129: '/'
+130: '/'
+131: '\n'
+132: '#'
+133: '\n'
+134: '/'
+135: '*'
+136: '*'
+137: '*'
+138: '/'
+139: ' '
+140: '\t'
+141: '\r'
+142: '\n'
+143: 'a'-'z'
+144: 'A'-'Z'
+145: '0'-'9'
+146: \u0001-'!'
+147: '#'-'['
+148: ']'-\u007f
+149: \u0080-\ufffc
+150: \ufffe-\U0010ffff
+151: \u0001-'_'
+152: 'a'-\ufffc
+153: \ufffe-\U0010ffff
+154: .
 */
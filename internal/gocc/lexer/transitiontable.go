@@ -13,127 +13,145 @@ var TransTab = TransitionTable{
 	// S0
 	func(r rune) int {
 		switch {
-		case r == 9: // ['\t','\t']
+		case r == 0: // [\u0000,\u0000]
 			return 1
+		case r == 9: // ['\t','\t']
+			return 2
 		case r == 10: // ['\n','\n']
-			return 1
+			return 2
 		case r == 13: // ['\r','\r']
-			return 1
+			return 2
 		case r == 32: // [' ',' ']
-			return 1
-		case r == 34: // ['"','"']
 			return 2
-		case r == 35: // ['#','#']
+		case r == 33: // ['!','!']
 			return 3
-		case r == 44: // [',',',']
+		case r == 34: // ['"','"']
 			return 4
-		case r == 45: // ['-','-']
+		case r == 35: // ['#','#']
 			return 5
-		case r == 46: // ['.','.']
+		case r == 36: // ['$','$']
 			return 6
-		case r == 47: // ['/','/']
+		case r == 40: // ['(','(']
 			return 7
-		case 48 <= r && r <= 57: // ['0','9']
+		case r == 41: // [')',')']
 			return 8
-		case r == 59: // [';',';']
+		case r == 44: // [',',',']
 			return 9
-		case r == 61: // ['=','=']
+		case r == 45: // ['-','-']
 			return 10
-		case 65 <= r && r <= 90: // ['A','Z']
+		case r == 46: // ['.','.']
 			return 11
-		case r == 91: // ['[','[']
+		case r == 47: // ['/','/']
 			return 12
-		case r == 93: // [']',']']
+		case 48 <= r && r <= 57: // ['0','9']
 			return 13
-		case r == 95: // ['_','_']
+		case r == 58: // [':',':']
 			return 14
-		case 97 <= r && r <= 122: // ['a','z']
-			return 11
+		case r == 59: // [';',';']
+			return 15
+		case r == 60: // ['<','<']
+			return 16
+		case r == 61: // ['=','=']
+			return 17
+		case r == 64: // ['@','@']
+			return 18
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 19
+		case r == 91: // ['[','[']
+			return 20
+		case r == 93: // [']',']']
+			return 21
+		case r == 95: // ['_','_']
+			return 22
+		case r == 96: // ['`','`']
+			return 23
+		case 97 <= r && r <= 104: // ['a','h']
+			return 19
+		case r == 105: // ['i','i']
+			return 24
+		case 106 <= r && r <= 114: // ['j','r']
+			return 19
+		case r == 115: // ['s','s']
+			return 25
+		case 116 <= r && r <= 122: // ['t','z']
+			return 19
+		case r == 123: // ['{','{']
+			return 26
+		case r == 125: // ['}','}']
+			return 27
 		}
 		return NoState
 	},
 	// S1
 	func(r rune) int {
 		switch {
+		case r == 35: // ['#','#']
+			return 28
 		}
 		return NoState
 	},
 	// S2
 	func(r rune) int {
 		switch {
-		case 1 <= r && r <= 33: // [\u0001,'!']
-			return 15
-		case r == 34: // ['"','"']
-			return 16
-		case 35 <= r && r <= 91: // ['#','[']
-			return 15
-		case r == 92: // ['\','\']
-			return 17
-		case 93 <= r && r <= 127: // [']',\u007f]
-			return 15
-		case 128 <= r && r <= 65532: // [\u0080,\ufffc]
-			return 18
-		case 65534 <= r && r <= 1114111: // [\ufffe,\U0010ffff]
-			return 18
 		}
 		return NoState
 	},
 	// S3
 	func(r rune) int {
 		switch {
-		case r == 10: // ['\n','\n']
-			return 19
-		default:
-			return 3
 		}
+		return NoState
 	},
 	// S4
 	func(r rune) int {
 		switch {
+		case 1 <= r && r <= 33: // [\u0001,'!']
+			return 29
+		case r == 34: // ['"','"']
+			return 30
+		case 35 <= r && r <= 91: // ['#','[']
+			return 29
+		case r == 92: // ['\','\']
+			return 31
+		case 93 <= r && r <= 127: // [']',\u007f]
+			return 29
+		case 128 <= r && r <= 65532: // [\u0080,\ufffc]
+			return 32
+		case 65534 <= r && r <= 1114111: // [\ufffe,\U0010ffff]
+			return 32
 		}
 		return NoState
 	},
 	// S5
 	func(r rune) int {
 		switch {
-		case r == 45: // ['-','-']
-			return 20
-		case r == 46: // ['.','.']
-			return 6
-		case 48 <= r && r <= 57: // ['0','9']
-			return 8
-		case r == 62: // ['>','>']
-			return 21
-		case r == 91: // ['[','[']
-			return 22
+		case r == 10: // ['\n','\n']
+			return 33
+		default:
+			return 5
 		}
-		return NoState
 	},
 	// S6
 	func(r rune) int {
 		switch {
-		case 48 <= r && r <= 57: // ['0','9']
-			return 23
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 34
+		case r == 95: // ['_','_']
+			return 35
+		case 97 <= r && r <= 122: // ['a','z']
+			return 34
 		}
 		return NoState
 	},
 	// S7
 	func(r rune) int {
 		switch {
-		case r == 42: // ['*','*']
-			return 24
-		case r == 47: // ['/','/']
-			return 25
 		}
 		return NoState
 	},
 	// S8
 	func(r rune) int {
 		switch {
-		case r == 46: // ['.','.']
-			return 26
-		case 48 <= r && r <= 57: // ['0','9']
-			return 8
 		}
 		return NoState
 	},
@@ -146,6 +164,16 @@ var TransTab = TransitionTable{
 	// S10
 	func(r rune) int {
 		switch {
+		case r == 45: // ['-','-']
+			return 36
+		case r == 46: // ['.','.']
+			return 11
+		case 48 <= r && r <= 57: // ['0','9']
+			return 13
+		case r == 62: // ['>','>']
+			return 37
+		case r == 91: // ['[','[']
+			return 38
 		}
 		return NoState
 	},
@@ -153,195 +181,191 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 27
-		case 65 <= r && r <= 90: // ['A','Z']
-			return 11
-		case r == 95: // ['_','_']
-			return 14
-		case 97 <= r && r <= 122: // ['a','z']
-			return 11
+			return 39
 		}
 		return NoState
 	},
 	// S12
 	func(r rune) int {
 		switch {
+		case r == 42: // ['*','*']
+			return 40
+		case r == 47: // ['/','/']
+			return 41
 		}
 		return NoState
 	},
 	// S13
 	func(r rune) int {
 		switch {
-		case r == 45: // ['-','-']
-			return 28
+		case r == 46: // ['.','.']
+			return 42
+		case 48 <= r && r <= 57: // ['0','9']
+			return 13
 		}
 		return NoState
 	},
 	// S14
 	func(r rune) int {
 		switch {
-		case 48 <= r && r <= 57: // ['0','9']
-			return 27
-		case 65 <= r && r <= 90: // ['A','Z']
-			return 11
-		case r == 95: // ['_','_']
-			return 14
-		case 97 <= r && r <= 122: // ['a','z']
-			return 11
 		}
 		return NoState
 	},
 	// S15
 	func(r rune) int {
 		switch {
-		case 1 <= r && r <= 33: // [\u0001,'!']
-			return 15
-		case r == 34: // ['"','"']
-			return 16
-		case 35 <= r && r <= 91: // ['#','[']
-			return 15
-		case r == 92: // ['\','\']
-			return 17
-		case 93 <= r && r <= 127: // [']',\u007f]
-			return 15
-		case 128 <= r && r <= 65532: // [\u0080,\ufffc]
-			return 18
-		case 65534 <= r && r <= 1114111: // [\ufffe,\U0010ffff]
-			return 18
 		}
 		return NoState
 	},
 	// S16
 	func(r rune) int {
 		switch {
+		case r == 45: // ['-','-']
+			return 43
 		}
 		return NoState
 	},
 	// S17
 	func(r rune) int {
 		switch {
-		case 1 <= r && r <= 33: // [\u0001,'!']
-			return 29
-		case r == 34: // ['"','"']
-			return 30
-		case 35 <= r && r <= 91: // ['#','[']
-			return 29
-		case r == 92: // ['\','\']
-			return 30
-		case 93 <= r && r <= 127: // [']',\u007f]
-			return 29
-		case 128 <= r && r <= 65532: // [\u0080,\ufffc]
-			return 31
-		case 65534 <= r && r <= 1114111: // [\ufffe,\U0010ffff]
-			return 31
 		}
 		return NoState
 	},
 	// S18
 	func(r rune) int {
 		switch {
-		case 1 <= r && r <= 33: // [\u0001,'!']
-			return 15
-		case r == 34: // ['"','"']
-			return 16
-		case 35 <= r && r <= 91: // ['#','[']
-			return 15
-		case r == 92: // ['\','\']
-			return 17
-		case 93 <= r && r <= 127: // [']',\u007f]
-			return 15
-		case 128 <= r && r <= 65532: // [\u0080,\ufffc]
-			return 18
-		case 65534 <= r && r <= 1114111: // [\ufffe,\U0010ffff]
-			return 18
+		case r == 100: // ['d','d']
+			return 44
+		case r == 101: // ['e','e']
+			return 45
+		case r == 103: // ['g','g']
+			return 46
+		case r == 108: // ['l','l']
+			return 47
+		case r == 110: // ['n','n']
+			return 48
+		case r == 116: // ['t','t']
+			return 49
+		case r == 117: // ['u','u']
+			return 50
 		}
 		return NoState
 	},
 	// S19
 	func(r rune) int {
 		switch {
+		case r == 46: // ['.','.']
+			return 51
+		case 48 <= r && r <= 57: // ['0','9']
+			return 52
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 19
+		case r == 95: // ['_','_']
+			return 53
+		case 97 <= r && r <= 122: // ['a','z']
+			return 19
 		}
 		return NoState
 	},
 	// S20
 	func(r rune) int {
 		switch {
-		case r == 45: // ['-','-']
-			return 20
-		case r == 62: // ['>','>']
-			return 21
-		case r == 91: // ['[','[']
-			return 22
 		}
 		return NoState
 	},
 	// S21
 	func(r rune) int {
 		switch {
+		case r == 45: // ['-','-']
+			return 54
 		}
 		return NoState
 	},
 	// S22
 	func(r rune) int {
 		switch {
+		case r == 46: // ['.','.']
+			return 51
+		case 48 <= r && r <= 57: // ['0','9']
+			return 52
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 19
+		case r == 95: // ['_','_']
+			return 53
+		case 97 <= r && r <= 122: // ['a','z']
+			return 19
 		}
 		return NoState
 	},
 	// S23
 	func(r rune) int {
 		switch {
-		case 48 <= r && r <= 57: // ['0','9']
-			return 23
+		case 1 <= r && r <= 95: // [\u0001,'_']
+			return 55
+		case r == 96: // ['`','`']
+			return 56
+		case 97 <= r && r <= 65532: // ['a',\ufffc]
+			return 55
+		case 65534 <= r && r <= 1114111: // [\ufffe,\U0010ffff]
+			return 55
 		}
 		return NoState
 	},
 	// S24
 	func(r rune) int {
 		switch {
-		case r == 42: // ['*','*']
-			return 32
-		default:
-			return 24
+		case r == 46: // ['.','.']
+			return 51
+		case 48 <= r && r <= 57: // ['0','9']
+			return 52
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 19
+		case r == 95: // ['_','_']
+			return 53
+		case 97 <= r && r <= 109: // ['a','m']
+			return 19
+		case r == 110: // ['n','n']
+			return 57
+		case 111 <= r && r <= 122: // ['o','z']
+			return 19
 		}
+		return NoState
 	},
 	// S25
 	func(r rune) int {
 		switch {
-		case r == 10: // ['\n','\n']
+		case r == 46: // ['.','.']
+			return 51
+		case 48 <= r && r <= 57: // ['0','9']
+			return 52
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 19
+		case r == 95: // ['_','_']
+			return 53
+		case 97 <= r && r <= 116: // ['a','t']
+			return 19
+		case r == 117: // ['u','u']
+			return 58
+		case 118 <= r && r <= 122: // ['v','z']
 			return 19
-		default:
-			return 25
 		}
+		return NoState
 	},
 	// S26
 	func(r rune) int {
 		switch {
-		case 48 <= r && r <= 57: // ['0','9']
-			return 33
 		}
 		return NoState
 	},
 	// S27
 	func(r rune) int {
 		switch {
-		case 48 <= r && r <= 57: // ['0','9']
-			return 27
-		case 65 <= r && r <= 90: // ['A','Z']
-			return 11
-		case r == 95: // ['_','_']
-			return 14
-		case 97 <= r && r <= 122: // ['a','z']
-			return 11
 		}
 		return NoState
 	},
 	// S28
 	func(r rune) int {
 		switch {
-		case r == 45: // ['-','-']
-			return 28
-		case r == 62: // ['>','>']
-			return 34
 		}
 		return NoState
 	},
@@ -349,88 +373,1094 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case 1 <= r && r <= 33: // [\u0001,'!']
-			return 15
+			return 29
 		case r == 34: // ['"','"']
-			return 16
+			return 30
 		case 35 <= r && r <= 91: // ['#','[']
-			return 15
+			return 29
 		case r == 92: // ['\','\']
-			return 17
+			return 31
 		case 93 <= r && r <= 127: // [']',\u007f]
-			return 15
+			return 29
 		case 128 <= r && r <= 65532: // [\u0080,\ufffc]
-			return 18
+			return 32
 		case 65534 <= r && r <= 1114111: // [\ufffe,\U0010ffff]
-			return 18
+			return 32
 		}
 		return NoState
 	},
 	// S30
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S31
 	func(r rune) int {
 		switch {
 		case 1 <= r && r <= 33: // [\u0001,'!']
-			return 15
+			return 59
 		case r == 34: // ['"','"']
-			return 16
+			return 60
 		case 35 <= r && r <= 91: // ['#','[']
-			return 15
+			return 59
 		case r == 92: // ['\','\']
-			return 17
+			return 60
 		case 93 <= r && r <= 127: // [']',\u007f]
-			return 15
+			return 59
 		case 128 <= r && r <= 65532: // [\u0080,\ufffc]
-			return 18
+			return 61
 		case 65534 <= r && r <= 1114111: // [\ufffe,\U0010ffff]
-			return 18
+			return 61
 		}
 		return NoState
 	},
-	// S31
+	// S32
 	func(r rune) int {
 		switch {
 		case 1 <= r && r <= 33: // [\u0001,'!']
-			return 15
+			return 29
 		case r == 34: // ['"','"']
-			return 16
+			return 30
 		case 35 <= r && r <= 91: // ['#','[']
-			return 15
+			return 29
 		case r == 92: // ['\','\']
-			return 17
+			return 31
 		case 93 <= r && r <= 127: // [']',\u007f]
-			return 15
+			return 29
 		case 128 <= r && r <= 65532: // [\u0080,\ufffc]
-			return 18
+			return 32
 		case 65534 <= r && r <= 1114111: // [\ufffe,\U0010ffff]
-			return 18
+			return 32
 		}
 		return NoState
 	},
-	// S32
+	// S33
 	func(r rune) int {
 		switch {
-		case r == 42: // ['*','*']
-			return 32
-		case r == 47: // ['/','/']
+		}
+		return NoState
+	},
+	// S34
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 62
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 34
+		case r == 95: // ['_','_']
 			return 35
-		default:
-			return 24
+		case 97 <= r && r <= 122: // ['a','z']
+			return 34
 		}
+		return NoState
 	},
-	// S33
+	// S35
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 62
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 34
+		case r == 95: // ['_','_']
+			return 35
+		case 97 <= r && r <= 122: // ['a','z']
+			return 34
+		}
+		return NoState
+	},
+	// S36
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 36
+		case r == 62: // ['>','>']
+			return 37
+		case r == 91: // ['[','[']
+			return 38
+		}
+		return NoState
+	},
+	// S37
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S38
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S39
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
+			return 39
+		}
+		return NoState
+	},
+	// S40
+	func(r rune) int {
+		switch {
+		case r == 42: // ['*','*']
+			return 63
+		default:
+			return 40
+		}
+	},
+	// S41
+	func(r rune) int {
+		switch {
+		case r == 10: // ['\n','\n']
 			return 33
+		default:
+			return 41
+		}
+	},
+	// S42
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
 		}
 		return NoState
 	},
-	// S34
+	// S43
 	func(r rune) int {
 		switch {
+		case r == 45: // ['-','-']
+			return 43
+		case r == 62: // ['>','>']
+			return 65
+		case r == 91: // ['[','[']
+			return 66
 		}
 		return NoState
 	},
-	// S35
+	// S44
+	func(r rune) int {
+		switch {
+		case r == 101: // ['e','e']
+			return 67
+		}
+		return NoState
+	},
+	// S45
+	func(r rune) int {
+		switch {
+		case r == 100: // ['d','d']
+			return 68
+		}
+		return NoState
+	},
+	// S46
+	func(r rune) int {
+		switch {
+		case r == 114: // ['r','r']
+			return 69
+		}
+		return NoState
+	},
+	// S47
+	func(r rune) int {
+		switch {
+		case r == 101: // ['e','e']
+			return 70
+		}
+		return NoState
+	},
+	// S48
+	func(r rune) int {
+		switch {
+		case r == 97: // ['a','a']
+			return 71
+		}
+		return NoState
+	},
+	// S49
+	func(r rune) int {
+		switch {
+		case r == 101: // ['e','e']
+			return 72
+		}
+		return NoState
+	},
+	// S50
+	func(r rune) int {
+		switch {
+		case r == 115: // ['s','s']
+			return 73
+		}
+		return NoState
+	},
+	// S51
+	func(r rune) int {
+		switch {
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 74
+		case r == 95: // ['_','_']
+			return 75
+		case 97 <= r && r <= 122: // ['a','z']
+			return 74
+		}
+		return NoState
+	},
+	// S52
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 51
+		case 48 <= r && r <= 57: // ['0','9']
+			return 52
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 19
+		case r == 95: // ['_','_']
+			return 53
+		case 97 <= r && r <= 122: // ['a','z']
+			return 19
+		}
+		return NoState
+	},
+	// S53
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 51
+		case 48 <= r && r <= 57: // ['0','9']
+			return 52
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 19
+		case r == 95: // ['_','_']
+			return 53
+		case 97 <= r && r <= 122: // ['a','z']
+			return 19
+		}
+		return NoState
+	},
+	// S54
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 54
+		case r == 62: // ['>','>']
+			return 76
+		}
+		return NoState
+	},
+	// S55
+	func(r rune) int {
+		switch {
+		case 1 <= r && r <= 95: // [\u0001,'_']
+			return 55
+		case r == 96: // ['`','`']
+			return 56
+		case 97 <= r && r <= 65532: // ['a',\ufffc]
+			return 55
+		case 65534 <= r && r <= 1114111: // [\ufffe,\U0010ffff]
+			return 55
+		}
+		return NoState
+	},
+	// S56
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S57
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 51
+		case 48 <= r && r <= 57: // ['0','9']
+			return 52
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 19
+		case r == 95: // ['_','_']
+			return 53
+		case 97 <= r && r <= 98: // ['a','b']
+			return 19
+		case r == 99: // ['c','c']
+			return 77
+		case 100 <= r && r <= 122: // ['d','z']
+			return 19
+		}
+		return NoState
+	},
+	// S58
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 51
+		case 48 <= r && r <= 57: // ['0','9']
+			return 52
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 19
+		case r == 95: // ['_','_']
+			return 53
+		case r == 97: // ['a','a']
+			return 19
+		case r == 98: // ['b','b']
+			return 78
+		case 99 <= r && r <= 122: // ['c','z']
+			return 19
+		}
+		return NoState
+	},
+	// S59
+	func(r rune) int {
+		switch {
+		case 1 <= r && r <= 33: // [\u0001,'!']
+			return 29
+		case r == 34: // ['"','"']
+			return 30
+		case 35 <= r && r <= 91: // ['#','[']
+			return 29
+		case r == 92: // ['\','\']
+			return 31
+		case 93 <= r && r <= 127: // [']',\u007f]
+			return 29
+		case 128 <= r && r <= 65532: // [\u0080,\ufffc]
+			return 32
+		case 65534 <= r && r <= 1114111: // [\ufffe,\U0010ffff]
+			return 32
+		}
+		return NoState
+	},
+	// S60
+	func(r rune) int {
+		switch {
+		case 1 <= r && r <= 33: // [\u0001,'!']
+			return 29
+		case r == 34: // ['"','"']
+			return 30
+		case 35 <= r && r <= 91: // ['#','[']
+			return 29
+		case r == 92: // ['\','\']
+			return 31
+		case 93 <= r && r <= 127: // [']',\u007f]
+			return 29
+		case 128 <= r && r <= 65532: // [\u0080,\ufffc]
+			return 32
+		case 65534 <= r && r <= 1114111: // [\ufffe,\U0010ffff]
+			return 32
+		}
+		return NoState
+	},
+	// S61
+	func(r rune) int {
+		switch {
+		case 1 <= r && r <= 33: // [\u0001,'!']
+			return 29
+		case r == 34: // ['"','"']
+			return 30
+		case 35 <= r && r <= 91: // ['#','[']
+			return 29
+		case r == 92: // ['\','\']
+			return 31
+		case 93 <= r && r <= 127: // [']',\u007f]
+			return 29
+		case 128 <= r && r <= 65532: // [\u0080,\ufffc]
+			return 32
+		case 65534 <= r && r <= 1114111: // [\ufffe,\U0010ffff]
+			return 32
+		}
+		return NoState
+	},
+	// S62
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 62
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 34
+		case r == 95: // ['_','_']
+			return 35
+		case 97 <= r && r <= 122: // ['a','z']
+			return 34
+		}
+		return NoState
+	},
+	// S63
+	func(r rune) int {
+		switch {
+		case r == 42: // ['*','*']
+			return 63
+		case r == 47: // ['/','/']
+			return 79
+		default:
+			return 40
+		}
+	},
+	// S64
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		}
+		return NoState
+	},
+	// S65
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S66
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S67
+	func(r rune) int {
+		switch {
+		case r == 102: // ['f','f']
+			return 80
+		}
+		return NoState
+	},
+	// S68
+	func(r rune) int {
+		switch {
+		case r == 103: // ['g','g']
+			return 81
+		}
+		return NoState
+	},
+	// S69
+	func(r rune) int {
+		switch {
+		case r == 97: // ['a','a']
+			return 82
+		}
+		return NoState
+	},
+	// S70
+	func(r rune) int {
+		switch {
+		case r == 116: // ['t','t']
+			return 83
+		}
+		return NoState
+	},
+	// S71
+	func(r rune) int {
+		switch {
+		case r == 109: // ['m','m']
+			return 84
+		}
+		return NoState
+	},
+	// S72
+	func(r rune) int {
+		switch {
+		case r == 109: // ['m','m']
+			return 85
+		}
+		return NoState
+	},
+	// S73
+	func(r rune) int {
+		switch {
+		case r == 101: // ['e','e']
+			return 86
+		}
+		return NoState
+	},
+	// S74
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 87
+		case 48 <= r && r <= 57: // ['0','9']
+			return 88
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 74
+		case r == 95: // ['_','_']
+			return 75
+		case 97 <= r && r <= 122: // ['a','z']
+			return 74
+		}
+		return NoState
+	},
+	// S75
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 87
+		case 48 <= r && r <= 57: // ['0','9']
+			return 88
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 74
+		case r == 95: // ['_','_']
+			return 75
+		case 97 <= r && r <= 122: // ['a','z']
+			return 74
+		}
+		return NoState
+	},
+	// S76
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S77
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 51
+		case 48 <= r && r <= 57: // ['0','9']
+			return 52
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 19
+		case r == 95: // ['_','_']
+			return 53
+		case 97 <= r && r <= 107: // ['a','k']
+			return 19
+		case r == 108: // ['l','l']
+			return 89
+		case 109 <= r && r <= 122: // ['m','z']
+			return 19
+		}
+		return NoState
+	},
+	// S78
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 51
+		case 48 <= r && r <= 57: // ['0','9']
+			return 52
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 19
+		case r == 95: // ['_','_']
+			return 53
+		case 97 <= r && r <= 102: // ['a','f']
+			return 19
+		case r == 103: // ['g','g']
+			return 90
+		case 104 <= r && r <= 122: // ['h','z']
+			return 19
+		}
+		return NoState
+	},
+	// S79
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S80
+	func(r rune) int {
+		switch {
+		case r == 97: // ['a','a']
+			return 91
+		}
+		return NoState
+	},
+	// S81
+	func(r rune) int {
+		switch {
+		case r == 101: // ['e','e']
+			return 92
+		}
+		return NoState
+	},
+	// S82
+	func(r rune) int {
+		switch {
+		case r == 112: // ['p','p']
+			return 93
+		}
+		return NoState
+	},
+	// S83
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S84
+	func(r rune) int {
+		switch {
+		case r == 101: // ['e','e']
+			return 94
+		}
+		return NoState
+	},
+	// S85
+	func(r rune) int {
+		switch {
+		case r == 112: // ['p','p']
+			return 95
+		}
+		return NoState
+	},
+	// S86
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S87
+	func(r rune) int {
+		switch {
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 96
+		case r == 95: // ['_','_']
+			return 97
+		case 97 <= r && r <= 122: // ['a','z']
+			return 96
+		}
+		return NoState
+	},
+	// S88
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 87
+		case 48 <= r && r <= 57: // ['0','9']
+			return 88
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 74
+		case r == 95: // ['_','_']
+			return 75
+		case 97 <= r && r <= 122: // ['a','z']
+			return 74
+		}
+		return NoState
+	},
+	// S89
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 51
+		case 48 <= r && r <= 57: // ['0','9']
+			return 52
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 19
+		case r == 95: // ['_','_']
+			return 53
+		case 97 <= r && r <= 116: // ['a','t']
+			return 19
+		case r == 117: // ['u','u']
+			return 98
+		case 118 <= r && r <= 122: // ['v','z']
+			return 19
+		}
+		return NoState
+	},
+	// S90
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 51
+		case 48 <= r && r <= 57: // ['0','9']
+			return 52
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 19
+		case r == 95: // ['_','_']
+			return 53
+		case 97 <= r && r <= 113: // ['a','q']
+			return 19
+		case r == 114: // ['r','r']
+			return 99
+		case 115 <= r && r <= 122: // ['s','z']
+			return 19
+		}
+		return NoState
+	},
+	// S91
+	func(r rune) int {
+		switch {
+		case r == 117: // ['u','u']
+			return 100
+		}
+		return NoState
+	},
+	// S92
+	func(r rune) int {
+		switch {
+		case r == 95: // ['_','_']
+			return 101
+		}
+		return NoState
+	},
+	// S93
+	func(r rune) int {
+		switch {
+		case r == 104: // ['h','h']
+			return 102
+		}
+		return NoState
+	},
+	// S94
+	func(r rune) int {
+		switch {
+		case r == 115: // ['s','s']
+			return 103
+		}
+		return NoState
+	},
+	// S95
+	func(r rune) int {
+		switch {
+		case r == 108: // ['l','l']
+			return 104
+		}
+		return NoState
+	},
+	// S96
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 87
+		case 48 <= r && r <= 57: // ['0','9']
+			return 105
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 96
+		case r == 95: // ['_','_']
+			return 97
+		case 97 <= r && r <= 122: // ['a','z']
+			return 96
+		}
+		return NoState
+	},
+	// S97
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 87
+		case 48 <= r && r <= 57: // ['0','9']
+			return 105
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 96
+		case r == 95: // ['_','_']
+			return 97
+		case 97 <= r && r <= 122: // ['a','z']
+			return 96
+		}
+		return NoState
+	},
+	// S98
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 51
+		case 48 <= r && r <= 57: // ['0','9']
+			return 52
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 19
+		case r == 95: // ['_','_']
+			return 53
+		case 97 <= r && r <= 99: // ['a','c']
+			return 19
+		case r == 100: // ['d','d']
+			return 106
+		case 101 <= r && r <= 122: // ['e','z']
+			return 19
+		}
+		return NoState
+	},
+	// S99
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 51
+		case 48 <= r && r <= 57: // ['0','9']
+			return 52
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 19
+		case r == 95: // ['_','_']
+			return 53
+		case r == 97: // ['a','a']
+			return 107
+		case 98 <= r && r <= 122: // ['b','z']
+			return 19
+		}
+		return NoState
+	},
+	// S100
+	func(r rune) int {
+		switch {
+		case r == 108: // ['l','l']
+			return 108
+		}
+		return NoState
+	},
+	// S101
+	func(r rune) int {
+		switch {
+		case r == 100: // ['d','d']
+			return 109
+		}
+		return NoState
+	},
+	// S102
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S103
+	func(r rune) int {
+		switch {
+		case r == 112: // ['p','p']
+			return 110
+		}
+		return NoState
+	},
+	// S104
+	func(r rune) int {
+		switch {
+		case r == 97: // ['a','a']
+			return 111
+		}
+		return NoState
+	},
+	// S105
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 87
+		case 48 <= r && r <= 57: // ['0','9']
+			return 105
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 96
+		case r == 95: // ['_','_']
+			return 97
+		case 97 <= r && r <= 122: // ['a','z']
+			return 96
+		}
+		return NoState
+	},
+	// S106
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 51
+		case 48 <= r && r <= 57: // ['0','9']
+			return 52
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 19
+		case r == 95: // ['_','_']
+			return 53
+		case 97 <= r && r <= 100: // ['a','d']
+			return 19
+		case r == 101: // ['e','e']
+			return 112
+		case 102 <= r && r <= 122: // ['f','z']
+			return 19
+		}
+		return NoState
+	},
+	// S107
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 51
+		case 48 <= r && r <= 57: // ['0','9']
+			return 52
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 19
+		case r == 95: // ['_','_']
+			return 53
+		case 97 <= r && r <= 111: // ['a','o']
+			return 19
+		case r == 112: // ['p','p']
+			return 113
+		case 113 <= r && r <= 122: // ['q','z']
+			return 19
+		}
+		return NoState
+	},
+	// S108
+	func(r rune) int {
+		switch {
+		case r == 116: // ['t','t']
+			return 114
+		}
+		return NoState
+	},
+	// S109
+	func(r rune) int {
+		switch {
+		case r == 101: // ['e','e']
+			return 115
+		}
+		return NoState
+	},
+	// S110
+	func(r rune) int {
+		switch {
+		case r == 97: // ['a','a']
+			return 116
+		}
+		return NoState
+	},
+	// S111
+	func(r rune) int {
+		switch {
+		case r == 116: // ['t','t']
+			return 117
+		}
+		return NoState
+	},
+	// S112
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 51
+		case 48 <= r && r <= 57: // ['0','9']
+			return 52
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 19
+		case r == 95: // ['_','_']
+			return 53
+		case 97 <= r && r <= 122: // ['a','z']
+			return 19
+		}
+		return NoState
+	},
+	// S113
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 51
+		case 48 <= r && r <= 57: // ['0','9']
+			return 52
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 19
+		case r == 95: // ['_','_']
+			return 53
+		case 97 <= r && r <= 103: // ['a','g']
+			return 19
+		case r == 104: // ['h','h']
+			return 118
+		case 105 <= r && r <= 122: // ['i','z']
+			return 19
+		}
+		return NoState
+	},
+	// S114
+	func(r rune) int {
+		switch {
+		case r == 115: // ['s','s']
+			return 119
+		}
+		return NoState
+	},
+	// S115
+	func(r rune) int {
+		switch {
+		case r == 102: // ['f','f']
+			return 120
+		}
+		return NoState
+	},
+	// S116
+	func(r rune) int {
+		switch {
+		case r == 99: // ['c','c']
+			return 121
+		}
+		return NoState
+	},
+	// S117
+	func(r rune) int {
+		switch {
+		case r == 101: // ['e','e']
+			return 122
+		}
+		return NoState
+	},
+	// S118
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 51
+		case 48 <= r && r <= 57: // ['0','9']
+			return 52
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 19
+		case r == 95: // ['_','_']
+			return 53
+		case 97 <= r && r <= 122: // ['a','z']
+			return 19
+		}
+		return NoState
+	},
+	// S119
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S120
+	func(r rune) int {
+		switch {
+		case r == 97: // ['a','a']
+			return 123
+		}
+		return NoState
+	},
+	// S121
+	func(r rune) int {
+		switch {
+		case r == 101: // ['e','e']
+			return 124
+		}
+		return NoState
+	},
+	// S122
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S123
+	func(r rune) int {
+		switch {
+		case r == 117: // ['u','u']
+			return 125
+		}
+		return NoState
+	},
+	// S124
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S125
+	func(r rune) int {
+		switch {
+		case r == 108: // ['l','l']
+			return 126
+		}
+		return NoState
+	},
+	// S126
+	func(r rune) int {
+		switch {
+		case r == 116: // ['t','t']
+			return 127
+		}
+		return NoState
+	},
+	// S127
+	func(r rune) int {
+		switch {
+		case r == 115: // ['s','s']
+			return 128
+		}
+		return NoState
+	},
+	// S128
 	func(r rune) int {
 		switch {
 		}
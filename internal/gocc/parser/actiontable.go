@@ -19,15 +19,41 @@ var actionTab = actionTable{
 			nil,       // empty
 			nil,       // ;
 			shift(5),  // id
+			shift(6),  // dotted_id
+			shift(7),  // quoted_string
+			shift(8),  // ns_prefix
 			nil,       // [
 			nil,       // ]
+			shift(11), // _
+			nil,       // ,
+			shift(12), // subgraph
+			shift(13), // include
 			nil,       // edgearrow
+			nil,       // edgeline
+			nil,       // edgebiarrow
+			nil,       // edgebackarrow
 			nil,       // edge_attr_open
+			nil,       // edge_attr_open_head
 			nil,       // edge_attr_close
-			nil,       // ,
+			nil,       // edge_attr_close_nohead
+			nil,       // edge_key
+			shift(16), // {
+			nil,       // }
+			nil,       // :
+			shift(27), // @let
 			nil,       // =
+			shift(28), // @template
+			nil,       // (
+			nil,       // )
+			shift(29), // @use
+			shift(30), // @namespace
+			shift(31), // !
+			shift(32), // @graph
+			shift(33), // @defaults
+			shift(34), // @edge_defaults
 			nil,       // numeric_literal
-			nil,       // quoted_string
+			nil,       // raw_string
+			nil,       // param_ref
 		},
 	},
 	actionRow{ // S1
@@ -38,15 +64,41 @@ var actionTab = actionTable{
 			nil,          // empty
 			nil,          // ;
 			nil,          // id
+			nil,          // dotted_id
+			nil,          // quoted_string
+			nil,          // ns_prefix
 			nil,          // [
 			nil,          // ]
+			nil,          // _
+			nil,          // ,
+			nil,          // subgraph
+			nil,          // include
 			nil,          // edgearrow
+			nil,          // edgeline
+			nil,          // edgebiarrow
+			nil,          // edgebackarrow
 			nil,          // edge_attr_open
+			nil,          // edge_attr_open_head
 			nil,          // edge_attr_close
-			nil,          // ,
+			nil,          // edge_attr_close_nohead
+			nil,          // edge_key
+			nil,          // {
+			nil,          // }
+			nil,          // :
+			nil,          // @let
 			nil,          // =
+			nil,          // @template
+			nil,          // (
+			nil,          // )
+			nil,          // @use
+			nil,          // @namespace
+			nil,          // !
+			nil,          // @graph
+			nil,          // @defaults
+			nil,          // @edge_defaults
 			nil,          // numeric_literal
-			nil,          // quoted_string
+			nil,          // raw_string
+			nil,          // param_ref
 		},
 	},
 	actionRow{ // S2
@@ -57,15 +109,41 @@ var actionTab = actionTable{
 			nil,       // empty
 			nil,       // ;
 			shift(5),  // id
+			shift(6),  // dotted_id
+			shift(7),  // quoted_string
+			shift(8),  // ns_prefix
 			nil,       // [
 			nil,       // ]
+			shift(11), // _
+			nil,       // ,
+			shift(12), // subgraph
+			shift(13), // include
 			nil,       // edgearrow
+			nil,       // edgeline
+			nil,       // edgebiarrow
+			nil,       // edgebackarrow
 			nil,       // edge_attr_open
+			nil,       // edge_attr_open_head
 			nil,       // edge_attr_close
-			nil,       // ,
+			nil,       // edge_attr_close_nohead
+			nil,       // edge_key
+			shift(16), // {
+			nil,       // }
+			nil,       // :
+			shift(27), // @let
 			nil,       // =
+			shift(28), // @template
+			nil,       // (
+			nil,       // )
+			shift(29), // @use
+			shift(30), // @namespace
+			shift(31), // !
+			shift(32), // @graph
+			shift(33), // @defaults
+			shift(34), // @edge_defaults
 			nil,       // numeric_literal
-			nil,       // quoted_string
+			nil,       // raw_string
+			nil,       // param_ref
 		},
 	},
 	actionRow{ // S3
@@ -76,167 +154,401 @@ var actionTab = actionTable{
 			nil,       // empty
 			nil,       // ;
 			reduce(3), // id, reduce: TopLevelDeclList
+			reduce(3), // dotted_id, reduce: TopLevelDeclList
+			reduce(3), // quoted_string, reduce: TopLevelDeclList
+			reduce(3), // ns_prefix, reduce: TopLevelDeclList
 			nil,       // [
 			nil,       // ]
-			nil,       // edgearrow
-			nil,       // edge_attr_open
-			nil,       // edge_attr_close
+			reduce(3), // _, reduce: TopLevelDeclList
 			nil,       // ,
-			nil,       // =
-			nil,       // numeric_literal
-			nil,       // quoted_string
-		},
-	},
-	actionRow{ // S4
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,       // INVALID
-			reduce(5), // ␚, reduce: OptSep
-			nil,       // empty
-			shift(9),  // ;
-			reduce(5), // id, reduce: OptSep
-			nil,       // [
-			nil,       // ]
+			reduce(3), // subgraph, reduce: TopLevelDeclList
+			reduce(3), // include, reduce: TopLevelDeclList
 			nil,       // edgearrow
+			nil,       // edgeline
+			nil,       // edgebiarrow
+			nil,       // edgebackarrow
 			nil,       // edge_attr_open
+			nil,       // edge_attr_open_head
 			nil,       // edge_attr_close
-			nil,       // ,
+			nil,       // edge_attr_close_nohead
+			nil,       // edge_key
+			reduce(3), // {, reduce: TopLevelDeclList
+			nil,       // }
+			nil,       // :
+			reduce(3), // @let, reduce: TopLevelDeclList
 			nil,       // =
+			reduce(3), // @template, reduce: TopLevelDeclList
+			nil,       // (
+			nil,       // )
+			reduce(3), // @use, reduce: TopLevelDeclList
+			reduce(3), // @namespace, reduce: TopLevelDeclList
+			reduce(3), // !, reduce: TopLevelDeclList
+			reduce(3), // @graph, reduce: TopLevelDeclList
+			reduce(3), // @defaults, reduce: TopLevelDeclList
+			reduce(3), // @edge_defaults, reduce: TopLevelDeclList
 			nil,       // numeric_literal
-			nil,       // quoted_string
+			nil,       // raw_string
+			nil,       // param_ref
 		},
 	},
-	actionRow{ // S5
+	actionRow{ // S4
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			reduce(11), // ␚, reduce: NodeDecl
+			reduce(16), // ␚, reduce: NodeRef
 			nil,        // empty
-			reduce(11), // ;, reduce: NodeDecl
-			reduce(11), // id, reduce: NodeDecl
-			shift(10),  // [
+			reduce(16), // ;, reduce: NodeRef
+			reduce(16), // id, reduce: NodeRef
+			reduce(16), // dotted_id, reduce: NodeRef
+			reduce(16), // quoted_string, reduce: NodeRef
+			reduce(16), // ns_prefix, reduce: NodeRef
+			reduce(16), // [, reduce: NodeRef
 			nil,        // ]
-			shift(12),  // edgearrow
-			shift(13),  // edge_attr_open
-			nil,        // edge_attr_close
+			reduce(16), // _, reduce: NodeRef
 			nil,        // ,
+			reduce(16), // subgraph, reduce: NodeRef
+			reduce(16), // include, reduce: NodeRef
+			reduce(16), // edgearrow, reduce: NodeRef
+			reduce(16), // edgeline, reduce: NodeRef
+			reduce(16), // edgebiarrow, reduce: NodeRef
+			reduce(16), // edgebackarrow, reduce: NodeRef
+			reduce(16), // edge_attr_open, reduce: NodeRef
+			reduce(16), // edge_attr_open_head, reduce: NodeRef
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(16), // {, reduce: NodeRef
+			nil,        // }
+			shift(36),  // :
+			reduce(16), // @let, reduce: NodeRef
 			nil,        // =
+			reduce(16), // @template, reduce: NodeRef
+			nil,        // (
+			nil,        // )
+			reduce(16), // @use, reduce: NodeRef
+			reduce(16), // @namespace, reduce: NodeRef
+			reduce(16), // !, reduce: NodeRef
+			reduce(16), // @graph, reduce: NodeRef
+			reduce(16), // @defaults, reduce: NodeRef
+			reduce(16), // @edge_defaults, reduce: NodeRef
 			nil,        // numeric_literal
-			nil,        // quoted_string
+			nil,        // raw_string
+			nil,        // param_ref
 		},
 	},
-	actionRow{ // S6
+	actionRow{ // S5
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,       // INVALID
-			reduce(5), // ␚, reduce: OptSep
+			reduce(7), // ␚, reduce: NodeId
 			nil,       // empty
-			shift(9),  // ;
-			reduce(5), // id, reduce: OptSep
-			nil,       // [
+			reduce(7), // ;, reduce: NodeId
+			reduce(7), // id, reduce: NodeId
+			reduce(7), // dotted_id, reduce: NodeId
+			reduce(7), // quoted_string, reduce: NodeId
+			reduce(7), // ns_prefix, reduce: NodeId
+			reduce(7), // [, reduce: NodeId
 			nil,       // ]
-			shift(12), // edgearrow
-			shift(13), // edge_attr_open
-			nil,       // edge_attr_close
+			reduce(7), // _, reduce: NodeId
 			nil,       // ,
+			reduce(7), // subgraph, reduce: NodeId
+			reduce(7), // include, reduce: NodeId
+			reduce(7), // edgearrow, reduce: NodeId
+			reduce(7), // edgeline, reduce: NodeId
+			reduce(7), // edgebiarrow, reduce: NodeId
+			reduce(7), // edgebackarrow, reduce: NodeId
+			reduce(7), // edge_attr_open, reduce: NodeId
+			reduce(7), // edge_attr_open_head, reduce: NodeId
+			nil,       // edge_attr_close
+			nil,       // edge_attr_close_nohead
+			nil,       // edge_key
+			reduce(7), // {, reduce: NodeId
+			nil,       // }
+			reduce(7), // :, reduce: NodeId
+			reduce(7), // @let, reduce: NodeId
 			nil,       // =
+			reduce(7), // @template, reduce: NodeId
+			nil,       // (
+			nil,       // )
+			reduce(7), // @use, reduce: NodeId
+			reduce(7), // @namespace, reduce: NodeId
+			reduce(7), // !, reduce: NodeId
+			reduce(7), // @graph, reduce: NodeId
+			reduce(7), // @defaults, reduce: NodeId
+			reduce(7), // @edge_defaults, reduce: NodeId
 			nil,       // numeric_literal
-			nil,       // quoted_string
+			nil,       // raw_string
+			nil,       // param_ref
 		},
 	},
-	actionRow{ // S7
+	actionRow{ // S6
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,       // INVALID
-			reduce(4), // ␚, reduce: TopLevelDeclList
+			reduce(8), // ␚, reduce: NodeId
 			nil,       // empty
-			nil,       // ;
-			reduce(4), // id, reduce: TopLevelDeclList
-			nil,       // [
+			reduce(8), // ;, reduce: NodeId
+			reduce(8), // id, reduce: NodeId
+			reduce(8), // dotted_id, reduce: NodeId
+			reduce(8), // quoted_string, reduce: NodeId
+			reduce(8), // ns_prefix, reduce: NodeId
+			reduce(8), // [, reduce: NodeId
 			nil,       // ]
-			nil,       // edgearrow
-			nil,       // edge_attr_open
-			nil,       // edge_attr_close
+			reduce(8), // _, reduce: NodeId
 			nil,       // ,
+			reduce(8), // subgraph, reduce: NodeId
+			reduce(8), // include, reduce: NodeId
+			reduce(8), // edgearrow, reduce: NodeId
+			reduce(8), // edgeline, reduce: NodeId
+			reduce(8), // edgebiarrow, reduce: NodeId
+			reduce(8), // edgebackarrow, reduce: NodeId
+			reduce(8), // edge_attr_open, reduce: NodeId
+			reduce(8), // edge_attr_open_head, reduce: NodeId
+			nil,       // edge_attr_close
+			nil,       // edge_attr_close_nohead
+			nil,       // edge_key
+			reduce(8), // {, reduce: NodeId
+			nil,       // }
+			reduce(8), // :, reduce: NodeId
+			reduce(8), // @let, reduce: NodeId
 			nil,       // =
+			reduce(8), // @template, reduce: NodeId
+			nil,       // (
+			nil,       // )
+			reduce(8), // @use, reduce: NodeId
+			reduce(8), // @namespace, reduce: NodeId
+			reduce(8), // !, reduce: NodeId
+			reduce(8), // @graph, reduce: NodeId
+			reduce(8), // @defaults, reduce: NodeId
+			reduce(8), // @edge_defaults, reduce: NodeId
 			nil,       // numeric_literal
-			nil,       // quoted_string
-		},
-	},
-	actionRow{ // S8
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			reduce(20), // ␚, reduce: TopLevelStmt
-			nil,        // empty
-			nil,        // ;
-			reduce(20), // id, reduce: TopLevelStmt
-			nil,        // [
-			nil,        // ]
-			nil,        // edgearrow
-			nil,        // edge_attr_open
-			nil,        // edge_attr_close
-			nil,        // ,
-			nil,        // =
-			nil,        // numeric_literal
-			nil,        // quoted_string
+			nil,       // raw_string
+			nil,       // param_ref
 		},
 	},
-	actionRow{ // S9
+	actionRow{ // S7
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,       // INVALID
-			reduce(6), // ␚, reduce: OptSep
+			reduce(9), // ␚, reduce: NodeId
 			nil,       // empty
-			nil,       // ;
-			reduce(6), // id, reduce: OptSep
-			nil,       // [
+			reduce(9), // ;, reduce: NodeId
+			reduce(9), // id, reduce: NodeId
+			reduce(9), // dotted_id, reduce: NodeId
+			reduce(9), // quoted_string, reduce: NodeId
+			reduce(9), // ns_prefix, reduce: NodeId
+			reduce(9), // [, reduce: NodeId
 			nil,       // ]
-			nil,       // edgearrow
-			nil,       // edge_attr_open
-			nil,       // edge_attr_close
+			reduce(9), // _, reduce: NodeId
 			nil,       // ,
+			reduce(9), // subgraph, reduce: NodeId
+			reduce(9), // include, reduce: NodeId
+			reduce(9), // edgearrow, reduce: NodeId
+			reduce(9), // edgeline, reduce: NodeId
+			reduce(9), // edgebiarrow, reduce: NodeId
+			reduce(9), // edgebackarrow, reduce: NodeId
+			reduce(9), // edge_attr_open, reduce: NodeId
+			reduce(9), // edge_attr_open_head, reduce: NodeId
+			nil,       // edge_attr_close
+			nil,       // edge_attr_close_nohead
+			nil,       // edge_key
+			reduce(9), // {, reduce: NodeId
+			nil,       // }
+			reduce(9), // :, reduce: NodeId
+			reduce(9), // @let, reduce: NodeId
 			nil,       // =
+			reduce(9), // @template, reduce: NodeId
+			nil,       // (
+			nil,       // )
+			reduce(9), // @use, reduce: NodeId
+			reduce(9), // @namespace, reduce: NodeId
+			reduce(9), // !, reduce: NodeId
+			reduce(9), // @graph, reduce: NodeId
+			reduce(9), // @defaults, reduce: NodeId
+			reduce(9), // @edge_defaults, reduce: NodeId
 			nil,       // numeric_literal
-			nil,       // quoted_string
+			nil,       // raw_string
+			nil,       // param_ref
 		},
 	},
-	actionRow{ // S10
+	actionRow{ // S8
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,       // INVALID
 			nil,       // ␚
 			nil,       // empty
 			nil,       // ;
-			shift(16), // id
+			nil,       // id
+			nil,       // dotted_id
+			shift(37), // quoted_string
+			nil,       // ns_prefix
 			nil,       // [
-			shift(18), // ]
+			nil,       // ]
+			nil,       // _
+			nil,       // ,
+			nil,       // subgraph
+			nil,       // include
 			nil,       // edgearrow
+			nil,       // edgeline
+			nil,       // edgebiarrow
+			nil,       // edgebackarrow
 			nil,       // edge_attr_open
+			nil,       // edge_attr_open_head
 			nil,       // edge_attr_close
-			nil,       // ,
+			nil,       // edge_attr_close_nohead
+			nil,       // edge_key
+			nil,       // {
+			nil,       // }
+			nil,       // :
+			nil,       // @let
 			nil,       // =
+			nil,       // @template
+			nil,       // (
+			nil,       // )
+			nil,       // @use
+			nil,       // @namespace
+			nil,       // !
+			nil,       // @graph
+			nil,       // @defaults
+			nil,       // @edge_defaults
 			nil,       // numeric_literal
-			nil,       // quoted_string
+			nil,       // raw_string
+			nil,       // param_ref
 		},
 	},
-	actionRow{ // S11
+	actionRow{ // S9
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			reduce(17), // ␚, reduce: EdgeDecl
+			reduce(5),  // ␚, reduce: OptSep
 			nil,        // empty
-			reduce(17), // ;, reduce: EdgeDecl
-			reduce(17), // id, reduce: EdgeDecl
+			shift(39),  // ;
+			reduce(5),  // id, reduce: OptSep
+			reduce(5),  // dotted_id, reduce: OptSep
+			reduce(5),  // quoted_string, reduce: OptSep
+			reduce(5),  // ns_prefix, reduce: OptSep
 			nil,        // [
 			nil,        // ]
-			reduce(17), // edgearrow, reduce: EdgeDecl
-			reduce(17), // edge_attr_open, reduce: EdgeDecl
+			reduce(5),  // _, reduce: OptSep
+			nil,        // ,
+			reduce(5),  // subgraph, reduce: OptSep
+			reduce(5),  // include, reduce: OptSep
+			reduce(42), // edgearrow, reduce: EdgeRef
+			reduce(42), // edgeline, reduce: EdgeRef
+			reduce(42), // edgebiarrow, reduce: EdgeRef
+			reduce(42), // edgebackarrow, reduce: EdgeRef
+			reduce(42), // edge_attr_open, reduce: EdgeRef
+			reduce(42), // edge_attr_open_head, reduce: EdgeRef
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(5),  // {, reduce: OptSep
+			nil,        // }
+			nil,        // :
+			reduce(5),  // @let, reduce: OptSep
+			nil,        // =
+			reduce(5),  // @template, reduce: OptSep
+			nil,        // (
+			nil,        // )
+			reduce(5),  // @use, reduce: OptSep
+			reduce(5),  // @namespace, reduce: OptSep
+			reduce(5),  // !, reduce: OptSep
+			reduce(5),  // @graph, reduce: OptSep
+			reduce(5),  // @defaults, reduce: OptSep
+			reduce(5),  // @edge_defaults, reduce: OptSep
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S10
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(15), // ␚, reduce: NodeDecl
+			nil,        // empty
+			reduce(15), // ;, reduce: NodeDecl
+			reduce(15), // id, reduce: NodeDecl
+			reduce(15), // dotted_id, reduce: NodeDecl
+			reduce(15), // quoted_string, reduce: NodeDecl
+			reduce(15), // ns_prefix, reduce: NodeDecl
+			shift(40),  // [
+			nil,        // ]
+			reduce(15), // _, reduce: NodeDecl
+			nil,        // ,
+			reduce(15), // subgraph, reduce: NodeDecl
+			reduce(15), // include, reduce: NodeDecl
+			reduce(15), // edgearrow, reduce: NodeDecl
+			reduce(15), // edgeline, reduce: NodeDecl
+			reduce(15), // edgebiarrow, reduce: NodeDecl
+			reduce(15), // edgebackarrow, reduce: NodeDecl
+			reduce(15), // edge_attr_open, reduce: NodeDecl
+			reduce(15), // edge_attr_open_head, reduce: NodeDecl
 			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(15), // {, reduce: NodeDecl
+			nil,        // }
+			nil,        // :
+			reduce(15), // @let, reduce: NodeDecl
+			nil,        // =
+			reduce(15), // @template, reduce: NodeDecl
+			nil,        // (
+			nil,        // )
+			reduce(15), // @use, reduce: NodeDecl
+			reduce(15), // @namespace, reduce: NodeDecl
+			reduce(15), // !, reduce: NodeDecl
+			reduce(15), // @graph, reduce: NodeDecl
+			reduce(15), // @defaults, reduce: NodeDecl
+			reduce(15), // @edge_defaults, reduce: NodeDecl
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S11
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(17), // ␚, reduce: NodeRef
+			nil,        // empty
+			reduce(17), // ;, reduce: NodeRef
+			reduce(17), // id, reduce: NodeRef
+			reduce(17), // dotted_id, reduce: NodeRef
+			reduce(17), // quoted_string, reduce: NodeRef
+			reduce(17), // ns_prefix, reduce: NodeRef
+			reduce(17), // [, reduce: NodeRef
+			nil,        // ]
+			reduce(17), // _, reduce: NodeRef
 			nil,        // ,
+			reduce(17), // subgraph, reduce: NodeRef
+			reduce(17), // include, reduce: NodeRef
+			reduce(17), // edgearrow, reduce: NodeRef
+			reduce(17), // edgeline, reduce: NodeRef
+			reduce(17), // edgebiarrow, reduce: NodeRef
+			reduce(17), // edgebackarrow, reduce: NodeRef
+			reduce(17), // edge_attr_open, reduce: NodeRef
+			reduce(17), // edge_attr_open_head, reduce: NodeRef
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(17), // {, reduce: NodeRef
+			nil,        // }
+			nil,        // :
+			reduce(17), // @let, reduce: NodeRef
 			nil,        // =
+			reduce(17), // @template, reduce: NodeRef
+			nil,        // (
+			nil,        // )
+			reduce(17), // @use, reduce: NodeRef
+			reduce(17), // @namespace, reduce: NodeRef
+			reduce(17), // !, reduce: NodeRef
+			reduce(17), // @graph, reduce: NodeRef
+			reduce(17), // @defaults, reduce: NodeRef
+			reduce(17), // @edge_defaults, reduce: NodeRef
 			nil,        // numeric_literal
-			nil,        // quoted_string
+			nil,        // raw_string
+			nil,        // param_ref
 		},
 	},
 	actionRow{ // S12
@@ -246,16 +558,42 @@ var actionTab = actionTable{
 			nil,       // ␚
 			nil,       // empty
 			nil,       // ;
-			shift(20), // id
+			shift(42), // id
+			shift(43), // dotted_id
+			shift(44), // quoted_string
+			shift(45), // ns_prefix
 			nil,       // [
 			nil,       // ]
+			nil,       // _
+			nil,       // ,
+			nil,       // subgraph
+			nil,       // include
 			nil,       // edgearrow
+			nil,       // edgeline
+			nil,       // edgebiarrow
+			nil,       // edgebackarrow
 			nil,       // edge_attr_open
+			nil,       // edge_attr_open_head
 			nil,       // edge_attr_close
-			nil,       // ,
+			nil,       // edge_attr_close_nohead
+			nil,       // edge_key
+			nil,       // {
+			nil,       // }
+			nil,       // :
+			nil,       // @let
 			nil,       // =
+			nil,       // @template
+			nil,       // (
+			nil,       // )
+			nil,       // @use
+			nil,       // @namespace
+			nil,       // !
+			nil,       // @graph
+			nil,       // @defaults
+			nil,       // @edge_defaults
 			nil,       // numeric_literal
-			nil,       // quoted_string
+			nil,       // raw_string
+			nil,       // param_ref
 		},
 	},
 	actionRow{ // S13
@@ -265,54 +603,132 @@ var actionTab = actionTable{
 			nil,       // ␚
 			nil,       // empty
 			nil,       // ;
-			shift(21), // id
+			nil,       // id
+			nil,       // dotted_id
+			shift(46), // quoted_string
+			nil,       // ns_prefix
 			nil,       // [
 			nil,       // ]
+			nil,       // _
+			nil,       // ,
+			nil,       // subgraph
+			nil,       // include
 			nil,       // edgearrow
+			nil,       // edgeline
+			nil,       // edgebiarrow
+			nil,       // edgebackarrow
 			nil,       // edge_attr_open
-			shift(23), // edge_attr_close
-			nil,       // ,
+			nil,       // edge_attr_open_head
+			nil,       // edge_attr_close
+			nil,       // edge_attr_close_nohead
+			nil,       // edge_key
+			nil,       // {
+			nil,       // }
+			nil,       // :
+			nil,       // @let
 			nil,       // =
+			nil,       // @template
+			nil,       // (
+			nil,       // )
+			nil,       // @use
+			nil,       // @namespace
+			nil,       // !
+			nil,       // @graph
+			nil,       // @defaults
+			nil,       // @edge_defaults
 			nil,       // numeric_literal
-			nil,       // quoted_string
+			nil,       // raw_string
+			nil,       // param_ref
 		},
 	},
 	actionRow{ // S14
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        // INVALID
-			reduce(19), // ␚, reduce: TopLevelStmt
-			nil,        // empty
-			nil,        // ;
-			reduce(19), // id, reduce: TopLevelStmt
-			nil,        // [
-			nil,        // ]
-			nil,        // edgearrow
-			nil,        // edge_attr_open
-			nil,        // edge_attr_close
-			nil,        // ,
-			nil,        // =
-			nil,        // numeric_literal
-			nil,        // quoted_string
+			nil,       // INVALID
+			nil,       // ␚
+			nil,       // empty
+			nil,       // ;
+			nil,       // id
+			nil,       // dotted_id
+			nil,       // quoted_string
+			nil,       // ns_prefix
+			nil,       // [
+			nil,       // ]
+			nil,       // _
+			nil,       // ,
+			nil,       // subgraph
+			nil,       // include
+			shift(48), // edgearrow
+			shift(49), // edgeline
+			shift(50), // edgebiarrow
+			shift(51), // edgebackarrow
+			shift(53), // edge_attr_open
+			shift(54), // edge_attr_open_head
+			nil,       // edge_attr_close
+			nil,       // edge_attr_close_nohead
+			nil,       // edge_key
+			nil,       // {
+			nil,       // }
+			nil,       // :
+			nil,       // @let
+			nil,       // =
+			nil,       // @template
+			nil,       // (
+			nil,       // )
+			nil,       // @use
+			nil,       // @namespace
+			nil,       // !
+			nil,       // @graph
+			nil,       // @defaults
+			nil,       // @edge_defaults
+			nil,       // numeric_literal
+			nil,       // raw_string
+			nil,       // param_ref
 		},
 	},
 	actionRow{ // S15
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			reduce(18), // ␚, reduce: EdgeDecl
+			nil,        // ␚
 			nil,        // empty
-			reduce(18), // ;, reduce: EdgeDecl
-			reduce(18), // id, reduce: EdgeDecl
+			nil,        // ;
+			nil,        // id
+			nil,        // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
 			nil,        // [
 			nil,        // ]
-			reduce(18), // edgearrow, reduce: EdgeDecl
-			reduce(18), // edge_attr_open, reduce: EdgeDecl
-			nil,        // edge_attr_close
+			nil,        // _
 			nil,        // ,
+			nil,        // subgraph
+			nil,        // include
+			reduce(40), // edgearrow, reduce: EdgeEnd
+			reduce(40), // edgeline, reduce: EdgeEnd
+			reduce(40), // edgebiarrow, reduce: EdgeEnd
+			reduce(40), // edgebackarrow, reduce: EdgeEnd
+			reduce(40), // edge_attr_open, reduce: EdgeEnd
+			reduce(40), // edge_attr_open_head, reduce: EdgeEnd
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
 			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
 			nil,        // numeric_literal
-			nil,        // quoted_string
+			nil,        // raw_string
+			nil,        // param_ref
 		},
 	},
 	actionRow{ // S16
@@ -321,207 +737,493 @@ var actionTab = actionTable{
 			nil,       // INVALID
 			nil,       // ␚
 			nil,       // empty
-			shift(26), // ;
-			reduce(5), // id, reduce: OptSep
+			nil,       // ;
+			shift(57), // id
+			shift(58), // dotted_id
+			shift(59), // quoted_string
+			shift(60), // ns_prefix
 			nil,       // [
-			reduce(5), // ], reduce: OptSep
+			nil,       // ]
+			shift(63), // _
+			nil,       // ,
+			nil,       // subgraph
+			nil,       // include
 			nil,       // edgearrow
+			nil,       // edgeline
+			nil,       // edgebiarrow
+			nil,       // edgebackarrow
 			nil,       // edge_attr_open
+			nil,       // edge_attr_open_head
 			nil,       // edge_attr_close
-			nil,       // ,
-			shift(27), // =
+			nil,       // edge_attr_close_nohead
+			nil,       // edge_key
+			nil,       // {
+			nil,       // }
+			nil,       // :
+			nil,       // @let
+			nil,       // =
+			nil,       // @template
+			nil,       // (
+			nil,       // )
+			nil,       // @use
+			nil,       // @namespace
+			nil,       // !
+			nil,       // @graph
+			nil,       // @defaults
+			nil,       // @edge_defaults
 			nil,       // numeric_literal
-			nil,       // quoted_string
+			nil,       // raw_string
+			nil,       // param_ref
 		},
 	},
 	actionRow{ // S17
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,       // INVALID
-			nil,       // ␚
+			reduce(5), // ␚, reduce: OptSep
 			nil,       // empty
-			nil,       // ;
-			shift(28), // id
+			shift(39), // ;
+			reduce(5), // id, reduce: OptSep
+			reduce(5), // dotted_id, reduce: OptSep
+			reduce(5), // quoted_string, reduce: OptSep
+			reduce(5), // ns_prefix, reduce: OptSep
 			nil,       // [
-			shift(29), // ]
-			nil,       // edgearrow
-			nil,       // edge_attr_open
-			nil,       // edge_attr_close
+			nil,       // ]
+			reduce(5), // _, reduce: OptSep
 			nil,       // ,
+			reduce(5), // subgraph, reduce: OptSep
+			reduce(5), // include, reduce: OptSep
+			shift(48), // edgearrow
+			shift(49), // edgeline
+			shift(50), // edgebiarrow
+			shift(51), // edgebackarrow
+			shift(53), // edge_attr_open
+			shift(54), // edge_attr_open_head
+			nil,       // edge_attr_close
+			nil,       // edge_attr_close_nohead
+			nil,       // edge_key
+			reduce(5), // {, reduce: OptSep
+			nil,       // }
+			nil,       // :
+			reduce(5), // @let, reduce: OptSep
 			nil,       // =
+			reduce(5), // @template, reduce: OptSep
+			nil,       // (
+			nil,       // )
+			reduce(5), // @use, reduce: OptSep
+			reduce(5), // @namespace, reduce: OptSep
+			reduce(5), // !, reduce: OptSep
+			reduce(5), // @graph, reduce: OptSep
+			reduce(5), // @defaults, reduce: OptSep
+			reduce(5), // @edge_defaults, reduce: OptSep
 			nil,       // numeric_literal
-			nil,       // quoted_string
+			nil,       // raw_string
+			nil,       // param_ref
 		},
 	},
 	actionRow{ // S18
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        // INVALID
-			reduce(10), // ␚, reduce: NodeDecl
-			nil,        // empty
-			reduce(10), // ;, reduce: NodeDecl
-			reduce(10), // id, reduce: NodeDecl
-			nil,        // [
-			nil,        // ]
-			nil,        // edgearrow
-			nil,        // edge_attr_open
-			nil,        // edge_attr_close
-			nil,        // ,
-			nil,        // =
-			nil,        // numeric_literal
-			nil,        // quoted_string
+			nil,       // INVALID
+			reduce(5), // ␚, reduce: OptSep
+			nil,       // empty
+			shift(39), // ;
+			reduce(5), // id, reduce: OptSep
+			reduce(5), // dotted_id, reduce: OptSep
+			reduce(5), // quoted_string, reduce: OptSep
+			reduce(5), // ns_prefix, reduce: OptSep
+			nil,       // [
+			nil,       // ]
+			reduce(5), // _, reduce: OptSep
+			nil,       // ,
+			reduce(5), // subgraph, reduce: OptSep
+			reduce(5), // include, reduce: OptSep
+			nil,       // edgearrow
+			nil,       // edgeline
+			nil,       // edgebiarrow
+			nil,       // edgebackarrow
+			nil,       // edge_attr_open
+			nil,       // edge_attr_open_head
+			nil,       // edge_attr_close
+			nil,       // edge_attr_close_nohead
+			nil,       // edge_key
+			reduce(5), // {, reduce: OptSep
+			nil,       // }
+			nil,       // :
+			reduce(5), // @let, reduce: OptSep
+			nil,       // =
+			reduce(5), // @template, reduce: OptSep
+			nil,       // (
+			nil,       // )
+			reduce(5), // @use, reduce: OptSep
+			reduce(5), // @namespace, reduce: OptSep
+			reduce(5), // !, reduce: OptSep
+			reduce(5), // @graph, reduce: OptSep
+			reduce(5), // @defaults, reduce: OptSep
+			reduce(5), // @edge_defaults, reduce: OptSep
+			nil,       // numeric_literal
+			nil,       // raw_string
+			nil,       // param_ref
 		},
 	},
 	actionRow{ // S19
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // ␚
-			nil,        // empty
-			nil,        // ;
-			reduce(21), // id, reduce: AttrItems
-			nil,        // [
-			reduce(21), // ], reduce: AttrItems
-			nil,        // edgearrow
-			nil,        // edge_attr_open
-			nil,        // edge_attr_close
-			nil,        // ,
-			nil,        // =
-			nil,        // numeric_literal
-			nil,        // quoted_string
-		},
-	},
-	actionRow{ // S20
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			reduce(12), // ␚, reduce: EdgeRHS
-			nil,        // empty
-			reduce(12), // ;, reduce: EdgeRHS
-			reduce(12), // id, reduce: EdgeRHS
-			nil,        // [
-			nil,        // ]
-			reduce(12), // edgearrow, reduce: EdgeRHS
-			reduce(12), // edge_attr_open, reduce: EdgeRHS
-			nil,        // edge_attr_close
-			nil,        // ,
-			nil,        // =
-			nil,        // numeric_literal
-			nil,        // quoted_string
-		},
-	},
-	actionRow{ // S21
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,       // INVALID
-			nil,       // ␚
+			reduce(5), // ␚, reduce: OptSep
 			nil,       // empty
-			shift(32), // ;
+			shift(39), // ;
 			reduce(5), // id, reduce: OptSep
+			reduce(5), // dotted_id, reduce: OptSep
+			reduce(5), // quoted_string, reduce: OptSep
+			reduce(5), // ns_prefix, reduce: OptSep
 			nil,       // [
 			nil,       // ]
+			reduce(5), // _, reduce: OptSep
+			nil,       // ,
+			reduce(5), // subgraph, reduce: OptSep
+			reduce(5), // include, reduce: OptSep
 			nil,       // edgearrow
+			nil,       // edgeline
+			nil,       // edgebiarrow
+			nil,       // edgebackarrow
 			nil,       // edge_attr_open
-			reduce(5), // edge_attr_close, reduce: OptSep
-			nil,       // ,
-			shift(33), // =
+			nil,       // edge_attr_open_head
+			nil,       // edge_attr_close
+			nil,       // edge_attr_close_nohead
+			nil,       // edge_key
+			reduce(5), // {, reduce: OptSep
+			nil,       // }
+			nil,       // :
+			reduce(5), // @let, reduce: OptSep
+			nil,       // =
+			reduce(5), // @template, reduce: OptSep
+			nil,       // (
+			nil,       // )
+			reduce(5), // @use, reduce: OptSep
+			reduce(5), // @namespace, reduce: OptSep
+			reduce(5), // !, reduce: OptSep
+			reduce(5), // @graph, reduce: OptSep
+			reduce(5), // @defaults, reduce: OptSep
+			reduce(5), // @edge_defaults, reduce: OptSep
 			nil,       // numeric_literal
-			nil,       // quoted_string
+			nil,       // raw_string
+			nil,       // param_ref
 		},
 	},
-	actionRow{ // S22
+	actionRow{ // S20
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,       // INVALID
-			nil,       // ␚
+			reduce(5), // ␚, reduce: OptSep
 			nil,       // empty
-			nil,       // ;
-			shift(34), // id
+			shift(39), // ;
+			reduce(5), // id, reduce: OptSep
+			reduce(5), // dotted_id, reduce: OptSep
+			reduce(5), // quoted_string, reduce: OptSep
+			reduce(5), // ns_prefix, reduce: OptSep
 			nil,       // [
 			nil,       // ]
+			reduce(5), // _, reduce: OptSep
+			nil,       // ,
+			reduce(5), // subgraph, reduce: OptSep
+			reduce(5), // include, reduce: OptSep
 			nil,       // edgearrow
+			nil,       // edgeline
+			nil,       // edgebiarrow
+			nil,       // edgebackarrow
 			nil,       // edge_attr_open
-			shift(35), // edge_attr_close
-			nil,       // ,
+			nil,       // edge_attr_open_head
+			nil,       // edge_attr_close
+			nil,       // edge_attr_close_nohead
+			nil,       // edge_key
+			reduce(5), // {, reduce: OptSep
+			nil,       // }
+			nil,       // :
+			reduce(5), // @let, reduce: OptSep
 			nil,       // =
+			reduce(5), // @template, reduce: OptSep
+			nil,       // (
+			nil,       // )
+			reduce(5), // @use, reduce: OptSep
+			reduce(5), // @namespace, reduce: OptSep
+			reduce(5), // !, reduce: OptSep
+			reduce(5), // @graph, reduce: OptSep
+			reduce(5), // @defaults, reduce: OptSep
+			reduce(5), // @edge_defaults, reduce: OptSep
 			nil,       // numeric_literal
-			nil,       // quoted_string
+			nil,       // raw_string
+			nil,       // param_ref
 		},
 	},
-	actionRow{ // S23
+	actionRow{ // S21
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,       // INVALID
-			nil,       // ␚
+			reduce(5), // ␚, reduce: OptSep
 			nil,       // empty
-			nil,       // ;
-			shift(37), // id
+			shift(39), // ;
+			reduce(5), // id, reduce: OptSep
+			reduce(5), // dotted_id, reduce: OptSep
+			reduce(5), // quoted_string, reduce: OptSep
+			reduce(5), // ns_prefix, reduce: OptSep
 			nil,       // [
 			nil,       // ]
+			reduce(5), // _, reduce: OptSep
+			nil,       // ,
+			reduce(5), // subgraph, reduce: OptSep
+			reduce(5), // include, reduce: OptSep
 			nil,       // edgearrow
+			nil,       // edgeline
+			nil,       // edgebiarrow
+			nil,       // edgebackarrow
 			nil,       // edge_attr_open
+			nil,       // edge_attr_open_head
 			nil,       // edge_attr_close
-			nil,       // ,
+			nil,       // edge_attr_close_nohead
+			nil,       // edge_key
+			reduce(5), // {, reduce: OptSep
+			nil,       // }
+			nil,       // :
+			reduce(5), // @let, reduce: OptSep
 			nil,       // =
+			reduce(5), // @template, reduce: OptSep
+			nil,       // (
+			nil,       // )
+			reduce(5), // @use, reduce: OptSep
+			reduce(5), // @namespace, reduce: OptSep
+			reduce(5), // !, reduce: OptSep
+			reduce(5), // @graph, reduce: OptSep
+			reduce(5), // @defaults, reduce: OptSep
+			reduce(5), // @edge_defaults, reduce: OptSep
 			nil,       // numeric_literal
-			nil,       // quoted_string
+			nil,       // raw_string
+			nil,       // param_ref
 		},
 	},
-	actionRow{ // S24
+	actionRow{ // S22
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // ␚
-			nil,        // empty
-			nil,        // ;
-			reduce(21), // id, reduce: AttrItems
-			nil,        // [
-			nil,        // ]
-			nil,        // edgearrow
-			nil,        // edge_attr_open
-			reduce(21), // edge_attr_close, reduce: AttrItems
-			nil,        // ,
-			nil,        // =
-			nil,        // numeric_literal
-			nil,        // quoted_string
+			nil,       // INVALID
+			reduce(5), // ␚, reduce: OptSep
+			nil,       // empty
+			shift(39), // ;
+			reduce(5), // id, reduce: OptSep
+			reduce(5), // dotted_id, reduce: OptSep
+			reduce(5), // quoted_string, reduce: OptSep
+			reduce(5), // ns_prefix, reduce: OptSep
+			nil,       // [
+			nil,       // ]
+			reduce(5), // _, reduce: OptSep
+			nil,       // ,
+			reduce(5), // subgraph, reduce: OptSep
+			reduce(5), // include, reduce: OptSep
+			nil,       // edgearrow
+			nil,       // edgeline
+			nil,       // edgebiarrow
+			nil,       // edgebackarrow
+			nil,       // edge_attr_open
+			nil,       // edge_attr_open_head
+			nil,       // edge_attr_close
+			nil,       // edge_attr_close_nohead
+			nil,       // edge_key
+			reduce(5), // {, reduce: OptSep
+			nil,       // }
+			nil,       // :
+			reduce(5), // @let, reduce: OptSep
+			nil,       // =
+			reduce(5), // @template, reduce: OptSep
+			nil,       // (
+			nil,       // )
+			reduce(5), // @use, reduce: OptSep
+			reduce(5), // @namespace, reduce: OptSep
+			reduce(5), // !, reduce: OptSep
+			reduce(5), // @graph, reduce: OptSep
+			reduce(5), // @defaults, reduce: OptSep
+			reduce(5), // @edge_defaults, reduce: OptSep
+			nil,       // numeric_literal
+			nil,       // raw_string
+			nil,       // param_ref
 		},
 	},
-	actionRow{ // S25
+	actionRow{ // S23
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,       // INVALID
-			nil,       // ␚
+			reduce(5), // ␚, reduce: OptSep
 			nil,       // empty
-			nil,       // ;
-			shift(28), // id
+			shift(39), // ;
+			reduce(5), // id, reduce: OptSep
+			reduce(5), // dotted_id, reduce: OptSep
+			reduce(5), // quoted_string, reduce: OptSep
+			reduce(5), // ns_prefix, reduce: OptSep
 			nil,       // [
-			shift(39), // ]
+			nil,       // ]
+			reduce(5), // _, reduce: OptSep
+			nil,       // ,
+			reduce(5), // subgraph, reduce: OptSep
+			reduce(5), // include, reduce: OptSep
 			nil,       // edgearrow
+			nil,       // edgeline
+			nil,       // edgebiarrow
+			nil,       // edgebackarrow
 			nil,       // edge_attr_open
+			nil,       // edge_attr_open_head
 			nil,       // edge_attr_close
+			nil,       // edge_attr_close_nohead
+			nil,       // edge_key
+			reduce(5), // {, reduce: OptSep
+			nil,       // }
+			nil,       // :
+			reduce(5), // @let, reduce: OptSep
+			nil,       // =
+			reduce(5), // @template, reduce: OptSep
+			nil,       // (
+			nil,       // )
+			reduce(5), // @use, reduce: OptSep
+			reduce(5), // @namespace, reduce: OptSep
+			reduce(5), // !, reduce: OptSep
+			reduce(5), // @graph, reduce: OptSep
+			reduce(5), // @defaults, reduce: OptSep
+			reduce(5), // @edge_defaults, reduce: OptSep
+			nil,       // numeric_literal
+			nil,       // raw_string
+			nil,       // param_ref
+		},
+	},
+	actionRow{ // S24
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			reduce(5), // ␚, reduce: OptSep
+			nil,       // empty
+			shift(39), // ;
+			reduce(5), // id, reduce: OptSep
+			reduce(5), // dotted_id, reduce: OptSep
+			reduce(5), // quoted_string, reduce: OptSep
+			reduce(5), // ns_prefix, reduce: OptSep
+			nil,       // [
+			nil,       // ]
+			reduce(5), // _, reduce: OptSep
 			nil,       // ,
+			reduce(5), // subgraph, reduce: OptSep
+			reduce(5), // include, reduce: OptSep
+			nil,       // edgearrow
+			nil,       // edgeline
+			nil,       // edgebiarrow
+			nil,       // edgebackarrow
+			nil,       // edge_attr_open
+			nil,       // edge_attr_open_head
+			nil,       // edge_attr_close
+			nil,       // edge_attr_close_nohead
+			nil,       // edge_key
+			reduce(5), // {, reduce: OptSep
+			nil,       // }
+			nil,       // :
+			reduce(5), // @let, reduce: OptSep
 			nil,       // =
+			reduce(5), // @template, reduce: OptSep
+			nil,       // (
+			nil,       // )
+			reduce(5), // @use, reduce: OptSep
+			reduce(5), // @namespace, reduce: OptSep
+			reduce(5), // !, reduce: OptSep
+			reduce(5), // @graph, reduce: OptSep
+			reduce(5), // @defaults, reduce: OptSep
+			reduce(5), // @edge_defaults, reduce: OptSep
 			nil,       // numeric_literal
-			nil,       // quoted_string
+			nil,       // raw_string
+			nil,       // param_ref
 		},
 	},
-	actionRow{ // S26
+	actionRow{ // S25
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,       // INVALID
-			nil,       // ␚
+			reduce(5), // ␚, reduce: OptSep
 			nil,       // empty
-			nil,       // ;
-			reduce(6), // id, reduce: OptSep
+			shift(39), // ;
+			reduce(5), // id, reduce: OptSep
+			reduce(5), // dotted_id, reduce: OptSep
+			reduce(5), // quoted_string, reduce: OptSep
+			reduce(5), // ns_prefix, reduce: OptSep
 			nil,       // [
-			reduce(6), // ], reduce: OptSep
+			nil,       // ]
+			reduce(5), // _, reduce: OptSep
+			nil,       // ,
+			reduce(5), // subgraph, reduce: OptSep
+			reduce(5), // include, reduce: OptSep
 			nil,       // edgearrow
+			nil,       // edgeline
+			nil,       // edgebiarrow
+			nil,       // edgebackarrow
 			nil,       // edge_attr_open
+			nil,       // edge_attr_open_head
 			nil,       // edge_attr_close
+			nil,       // edge_attr_close_nohead
+			nil,       // edge_key
+			reduce(5), // {, reduce: OptSep
+			nil,       // }
+			nil,       // :
+			reduce(5), // @let, reduce: OptSep
+			nil,       // =
+			reduce(5), // @template, reduce: OptSep
+			nil,       // (
+			nil,       // )
+			reduce(5), // @use, reduce: OptSep
+			reduce(5), // @namespace, reduce: OptSep
+			reduce(5), // !, reduce: OptSep
+			reduce(5), // @graph, reduce: OptSep
+			reduce(5), // @defaults, reduce: OptSep
+			reduce(5), // @edge_defaults, reduce: OptSep
+			nil,       // numeric_literal
+			nil,       // raw_string
+			nil,       // param_ref
+		},
+	},
+	actionRow{ // S26
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			reduce(5), // ␚, reduce: OptSep
+			nil,       // empty
+			shift(39), // ;
+			reduce(5), // id, reduce: OptSep
+			reduce(5), // dotted_id, reduce: OptSep
+			reduce(5), // quoted_string, reduce: OptSep
+			reduce(5), // ns_prefix, reduce: OptSep
+			nil,       // [
+			nil,       // ]
+			reduce(5), // _, reduce: OptSep
 			nil,       // ,
+			reduce(5), // subgraph, reduce: OptSep
+			reduce(5), // include, reduce: OptSep
+			nil,       // edgearrow
+			nil,       // edgeline
+			nil,       // edgebiarrow
+			nil,       // edgebackarrow
+			nil,       // edge_attr_open
+			nil,       // edge_attr_open_head
+			nil,       // edge_attr_close
+			nil,       // edge_attr_close_nohead
+			nil,       // edge_key
+			reduce(5), // {, reduce: OptSep
+			nil,       // }
+			nil,       // :
+			reduce(5), // @let, reduce: OptSep
 			nil,       // =
+			reduce(5), // @template, reduce: OptSep
+			nil,       // (
+			nil,       // )
+			reduce(5), // @use, reduce: OptSep
+			reduce(5), // @namespace, reduce: OptSep
+			reduce(5), // !, reduce: OptSep
+			reduce(5), // @graph, reduce: OptSep
+			reduce(5), // @defaults, reduce: OptSep
+			reduce(5), // @edge_defaults, reduce: OptSep
 			nil,       // numeric_literal
-			nil,       // quoted_string
+			nil,       // raw_string
+			nil,       // param_ref
 		},
 	},
 	actionRow{ // S27
@@ -531,16 +1233,42 @@ var actionTab = actionTable{
 			nil,       // ␚
 			nil,       // empty
 			nil,       // ;
-			shift(40), // id
+			shift(77), // id
+			nil,       // dotted_id
+			nil,       // quoted_string
+			nil,       // ns_prefix
 			nil,       // [
 			nil,       // ]
+			nil,       // _
+			nil,       // ,
+			nil,       // subgraph
+			nil,       // include
 			nil,       // edgearrow
+			nil,       // edgeline
+			nil,       // edgebiarrow
+			nil,       // edgebackarrow
 			nil,       // edge_attr_open
+			nil,       // edge_attr_open_head
 			nil,       // edge_attr_close
-			nil,       // ,
+			nil,       // edge_attr_close_nohead
+			nil,       // edge_key
+			nil,       // {
+			nil,       // }
+			nil,       // :
+			nil,       // @let
 			nil,       // =
-			shift(42), // numeric_literal
-			shift(43), // quoted_string
+			nil,       // @template
+			nil,       // (
+			nil,       // )
+			nil,       // @use
+			nil,       // @namespace
+			nil,       // !
+			nil,       // @graph
+			nil,       // @defaults
+			nil,       // @edge_defaults
+			nil,       // numeric_literal
+			nil,       // raw_string
+			nil,       // param_ref
 		},
 	},
 	actionRow{ // S28
@@ -550,54 +1278,132 @@ var actionTab = actionTable{
 			nil,       // ␚
 			nil,       // empty
 			nil,       // ;
-			nil,       // id
+			shift(78), // id
+			nil,       // dotted_id
+			nil,       // quoted_string
+			nil,       // ns_prefix
 			nil,       // [
 			nil,       // ]
+			nil,       // _
+			nil,       // ,
+			nil,       // subgraph
+			nil,       // include
 			nil,       // edgearrow
+			nil,       // edgeline
+			nil,       // edgebiarrow
+			nil,       // edgebackarrow
 			nil,       // edge_attr_open
+			nil,       // edge_attr_open_head
 			nil,       // edge_attr_close
-			nil,       // ,
-			shift(27), // =
+			nil,       // edge_attr_close_nohead
+			nil,       // edge_key
+			nil,       // {
+			nil,       // }
+			nil,       // :
+			nil,       // @let
+			nil,       // =
+			nil,       // @template
+			nil,       // (
+			nil,       // )
+			nil,       // @use
+			nil,       // @namespace
+			nil,       // !
+			nil,       // @graph
+			nil,       // @defaults
+			nil,       // @edge_defaults
 			nil,       // numeric_literal
-			nil,       // quoted_string
+			nil,       // raw_string
+			nil,       // param_ref
 		},
 	},
 	actionRow{ // S29
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,       // INVALID
-			reduce(7), // ␚, reduce: NodeDecl
+			nil,       // ␚
 			nil,       // empty
-			reduce(7), // ;, reduce: NodeDecl
-			reduce(7), // id, reduce: NodeDecl
+			nil,       // ;
+			shift(80), // id
+			shift(81), // dotted_id
+			shift(82), // quoted_string
+			shift(83), // ns_prefix
 			nil,       // [
 			nil,       // ]
+			nil,       // _
+			nil,       // ,
+			nil,       // subgraph
+			nil,       // include
 			nil,       // edgearrow
+			nil,       // edgeline
+			nil,       // edgebiarrow
+			nil,       // edgebackarrow
 			nil,       // edge_attr_open
+			nil,       // edge_attr_open_head
 			nil,       // edge_attr_close
-			nil,       // ,
+			nil,       // edge_attr_close_nohead
+			nil,       // edge_key
+			nil,       // {
+			nil,       // }
+			nil,       // :
+			nil,       // @let
 			nil,       // =
+			nil,       // @template
+			nil,       // (
+			nil,       // )
+			nil,       // @use
+			nil,       // @namespace
+			nil,       // !
+			nil,       // @graph
+			nil,       // @defaults
+			nil,       // @edge_defaults
 			nil,       // numeric_literal
-			nil,       // quoted_string
+			nil,       // raw_string
+			nil,       // param_ref
 		},
 	},
 	actionRow{ // S30
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // ␚
-			nil,        // empty
-			nil,        // ;
-			reduce(22), // id, reduce: AttrItems
-			nil,        // [
-			reduce(22), // ], reduce: AttrItems
-			nil,        // edgearrow
-			nil,        // edge_attr_open
-			nil,        // edge_attr_close
-			nil,        // ,
-			nil,        // =
-			nil,        // numeric_literal
-			nil,        // quoted_string
+			nil,       // INVALID
+			nil,       // ␚
+			nil,       // empty
+			nil,       // ;
+			shift(85), // id
+			shift(86), // dotted_id
+			shift(87), // quoted_string
+			shift(88), // ns_prefix
+			nil,       // [
+			nil,       // ]
+			nil,       // _
+			nil,       // ,
+			nil,       // subgraph
+			nil,       // include
+			nil,       // edgearrow
+			nil,       // edgeline
+			nil,       // edgebiarrow
+			nil,       // edgebackarrow
+			nil,       // edge_attr_open
+			nil,       // edge_attr_open_head
+			nil,       // edge_attr_close
+			nil,       // edge_attr_close_nohead
+			nil,       // edge_key
+			nil,       // {
+			nil,       // }
+			nil,       // :
+			nil,       // @let
+			nil,       // =
+			nil,       // @template
+			nil,       // (
+			nil,       // )
+			nil,       // @use
+			nil,       // @namespace
+			nil,       // !
+			nil,       // @graph
+			nil,       // @defaults
+			nil,       // @edge_defaults
+			nil,       // numeric_literal
+			nil,       // raw_string
+			nil,       // param_ref
 		},
 	},
 	actionRow{ // S31
@@ -607,16 +1413,42 @@ var actionTab = actionTable{
 			nil,       // ␚
 			nil,       // empty
 			nil,       // ;
-			shift(34), // id
+			shift(5),  // id
+			shift(6),  // dotted_id
+			shift(7),  // quoted_string
+			shift(8),  // ns_prefix
 			nil,       // [
 			nil,       // ]
+			shift(92), // _
+			nil,       // ,
+			nil,       // subgraph
+			nil,       // include
 			nil,       // edgearrow
+			nil,       // edgeline
+			nil,       // edgebiarrow
+			nil,       // edgebackarrow
 			nil,       // edge_attr_open
-			shift(45), // edge_attr_close
-			nil,       // ,
+			nil,       // edge_attr_open_head
+			nil,       // edge_attr_close
+			nil,       // edge_attr_close_nohead
+			nil,       // edge_key
+			shift(16), // {
+			nil,       // }
+			nil,       // :
+			nil,       // @let
 			nil,       // =
+			nil,       // @template
+			nil,       // (
+			nil,       // )
+			nil,       // @use
+			nil,       // @namespace
+			nil,       // !
+			nil,       // @graph
+			nil,       // @defaults
+			nil,       // @edge_defaults
 			nil,       // numeric_literal
-			nil,       // quoted_string
+			nil,       // raw_string
+			nil,       // param_ref
 		},
 	},
 	actionRow{ // S32
@@ -626,16 +1458,42 @@ var actionTab = actionTable{
 			nil,       // ␚
 			nil,       // empty
 			nil,       // ;
-			reduce(6), // id, reduce: OptSep
-			nil,       // [
+			nil,       // id
+			nil,       // dotted_id
+			nil,       // quoted_string
+			nil,       // ns_prefix
+			shift(94), // [
 			nil,       // ]
+			nil,       // _
+			nil,       // ,
+			nil,       // subgraph
+			nil,       // include
 			nil,       // edgearrow
+			nil,       // edgeline
+			nil,       // edgebiarrow
+			nil,       // edgebackarrow
 			nil,       // edge_attr_open
-			reduce(6), // edge_attr_close, reduce: OptSep
-			nil,       // ,
+			nil,       // edge_attr_open_head
+			nil,       // edge_attr_close
+			nil,       // edge_attr_close_nohead
+			nil,       // edge_key
+			nil,       // {
+			nil,       // }
+			nil,       // :
+			nil,       // @let
 			nil,       // =
+			nil,       // @template
+			nil,       // (
+			nil,       // )
+			nil,       // @use
+			nil,       // @namespace
+			nil,       // !
+			nil,       // @graph
+			nil,       // @defaults
+			nil,       // @edge_defaults
 			nil,       // numeric_literal
-			nil,       // quoted_string
+			nil,       // raw_string
+			nil,       // param_ref
 		},
 	},
 	actionRow{ // S33
@@ -645,16 +1503,42 @@ var actionTab = actionTable{
 			nil,       // ␚
 			nil,       // empty
 			nil,       // ;
-			shift(46), // id
+			shift(95), // id
+			nil,       // dotted_id
+			nil,       // quoted_string
+			nil,       // ns_prefix
 			nil,       // [
 			nil,       // ]
+			shift(96), // _
+			nil,       // ,
+			shift(98), // subgraph
+			shift(99), // include
 			nil,       // edgearrow
+			nil,       // edgeline
+			nil,       // edgebiarrow
+			nil,       // edgebackarrow
 			nil,       // edge_attr_open
+			nil,       // edge_attr_open_head
 			nil,       // edge_attr_close
-			nil,       // ,
+			nil,       // edge_attr_close_nohead
+			nil,       // edge_key
+			nil,       // {
+			nil,       // }
+			nil,       // :
+			nil,       // @let
 			nil,       // =
-			shift(48), // numeric_literal
-			shift(49), // quoted_string
+			nil,       // @template
+			nil,       // (
+			nil,       // )
+			nil,       // @use
+			nil,       // @namespace
+			nil,       // !
+			nil,       // @graph
+			nil,       // @defaults
+			nil,       // @edge_defaults
+			nil,       // numeric_literal
+			nil,       // raw_string
+			nil,       // param_ref
 		},
 	},
 	actionRow{ // S34
@@ -664,35 +1548,87 @@ var actionTab = actionTable{
 			nil,       // ␚
 			nil,       // empty
 			nil,       // ;
-			nil,       // id
+			shift(95), // id
+			nil,       // dotted_id
+			nil,       // quoted_string
+			nil,       // ns_prefix
 			nil,       // [
 			nil,       // ]
+			shift(96), // _
+			nil,       // ,
+			shift(98), // subgraph
+			shift(99), // include
 			nil,       // edgearrow
+			nil,       // edgeline
+			nil,       // edgebiarrow
+			nil,       // edgebackarrow
 			nil,       // edge_attr_open
+			nil,       // edge_attr_open_head
 			nil,       // edge_attr_close
-			nil,       // ,
-			shift(33), // =
+			nil,       // edge_attr_close_nohead
+			nil,       // edge_key
+			nil,       // {
+			nil,       // }
+			nil,       // :
+			nil,       // @let
+			nil,       // =
+			nil,       // @template
+			nil,       // (
+			nil,       // )
+			nil,       // @use
+			nil,       // @namespace
+			nil,       // !
+			nil,       // @graph
+			nil,       // @defaults
+			nil,       // @edge_defaults
 			nil,       // numeric_literal
-			nil,       // quoted_string
+			nil,       // raw_string
+			nil,       // param_ref
 		},
 	},
 	actionRow{ // S35
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,       // INVALID
-			nil,       // ␚
+			reduce(4), // ␚, reduce: TopLevelDeclList
 			nil,       // empty
 			nil,       // ;
-			shift(50), // id
+			reduce(4), // id, reduce: TopLevelDeclList
+			reduce(4), // dotted_id, reduce: TopLevelDeclList
+			reduce(4), // quoted_string, reduce: TopLevelDeclList
+			reduce(4), // ns_prefix, reduce: TopLevelDeclList
 			nil,       // [
 			nil,       // ]
+			reduce(4), // _, reduce: TopLevelDeclList
+			nil,       // ,
+			reduce(4), // subgraph, reduce: TopLevelDeclList
+			reduce(4), // include, reduce: TopLevelDeclList
 			nil,       // edgearrow
+			nil,       // edgeline
+			nil,       // edgebiarrow
+			nil,       // edgebackarrow
 			nil,       // edge_attr_open
+			nil,       // edge_attr_open_head
 			nil,       // edge_attr_close
-			nil,       // ,
+			nil,       // edge_attr_close_nohead
+			nil,       // edge_key
+			reduce(4), // {, reduce: TopLevelDeclList
+			nil,       // }
+			nil,       // :
+			reduce(4), // @let, reduce: TopLevelDeclList
 			nil,       // =
+			reduce(4), // @template, reduce: TopLevelDeclList
+			nil,       // (
+			nil,       // )
+			reduce(4), // @use, reduce: TopLevelDeclList
+			reduce(4), // @namespace, reduce: TopLevelDeclList
+			reduce(4), // !, reduce: TopLevelDeclList
+			reduce(4), // @graph, reduce: TopLevelDeclList
+			reduce(4), // @defaults, reduce: TopLevelDeclList
+			reduce(4), // @edge_defaults, reduce: TopLevelDeclList
 			nil,       // numeric_literal
-			nil,       // quoted_string
+			nil,       // raw_string
+			nil,       // param_ref
 		},
 	},
 	actionRow{ // S36
@@ -702,73 +1638,177 @@ var actionTab = actionTable{
 			nil,        // ␚
 			nil,        // empty
 			nil,        // ;
-			reduce(22), // id, reduce: AttrItems
+			shift(102), // id
+			shift(103), // dotted_id
+			shift(104), // quoted_string
+			shift(105), // ns_prefix
 			nil,        // [
 			nil,        // ]
+			nil,        // _
+			nil,        // ,
+			nil,        // subgraph
+			nil,        // include
 			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
 			nil,        // edge_attr_open
-			reduce(22), // edge_attr_close, reduce: AttrItems
-			nil,        // ,
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
 			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
 			nil,        // numeric_literal
-			nil,        // quoted_string
+			nil,        // raw_string
+			nil,        // param_ref
 		},
 	},
 	actionRow{ // S37
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			reduce(13), // ␚, reduce: EdgeRHS
+			reduce(10), // ␚, reduce: NodeId
 			nil,        // empty
-			reduce(13), // ;, reduce: EdgeRHS
-			reduce(13), // id, reduce: EdgeRHS
-			nil,        // [
+			reduce(10), // ;, reduce: NodeId
+			reduce(10), // id, reduce: NodeId
+			reduce(10), // dotted_id, reduce: NodeId
+			reduce(10), // quoted_string, reduce: NodeId
+			reduce(10), // ns_prefix, reduce: NodeId
+			reduce(10), // [, reduce: NodeId
 			nil,        // ]
-			reduce(13), // edgearrow, reduce: EdgeRHS
-			reduce(13), // edge_attr_open, reduce: EdgeRHS
-			nil,        // edge_attr_close
+			reduce(10), // _, reduce: NodeId
 			nil,        // ,
+			reduce(10), // subgraph, reduce: NodeId
+			reduce(10), // include, reduce: NodeId
+			reduce(10), // edgearrow, reduce: NodeId
+			reduce(10), // edgeline, reduce: NodeId
+			reduce(10), // edgebiarrow, reduce: NodeId
+			reduce(10), // edgebackarrow, reduce: NodeId
+			reduce(10), // edge_attr_open, reduce: NodeId
+			reduce(10), // edge_attr_open_head, reduce: NodeId
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(10), // {, reduce: NodeId
+			nil,        // }
+			reduce(10), // :, reduce: NodeId
+			reduce(10), // @let, reduce: NodeId
 			nil,        // =
+			reduce(10), // @template, reduce: NodeId
+			nil,        // (
+			nil,        // )
+			reduce(10), // @use, reduce: NodeId
+			reduce(10), // @namespace, reduce: NodeId
+			reduce(10), // !, reduce: NodeId
+			reduce(10), // @graph, reduce: NodeId
+			reduce(10), // @defaults, reduce: NodeId
+			reduce(10), // @edge_defaults, reduce: NodeId
 			nil,        // numeric_literal
-			nil,        // quoted_string
+			nil,        // raw_string
+			nil,        // param_ref
 		},
 	},
 	actionRow{ // S38
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // ␚
-			nil,       // empty
-			nil,       // ;
-			shift(28), // id
-			nil,       // [
-			shift(51), // ]
-			nil,       // edgearrow
-			nil,       // edge_attr_open
-			nil,       // edge_attr_close
-			nil,       // ,
-			nil,       // =
-			nil,       // numeric_literal
-			nil,       // quoted_string
+			nil,        // INVALID
+			reduce(50), // ␚, reduce: TopLevelStmt
+			nil,        // empty
+			nil,        // ;
+			reduce(50), // id, reduce: TopLevelStmt
+			reduce(50), // dotted_id, reduce: TopLevelStmt
+			reduce(50), // quoted_string, reduce: TopLevelStmt
+			reduce(50), // ns_prefix, reduce: TopLevelStmt
+			nil,        // [
+			nil,        // ]
+			reduce(50), // _, reduce: TopLevelStmt
+			nil,        // ,
+			reduce(50), // subgraph, reduce: TopLevelStmt
+			reduce(50), // include, reduce: TopLevelStmt
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(50), // {, reduce: TopLevelStmt
+			nil,        // }
+			nil,        // :
+			reduce(50), // @let, reduce: TopLevelStmt
+			nil,        // =
+			reduce(50), // @template, reduce: TopLevelStmt
+			nil,        // (
+			nil,        // )
+			reduce(50), // @use, reduce: TopLevelStmt
+			reduce(50), // @namespace, reduce: TopLevelStmt
+			reduce(50), // !, reduce: TopLevelStmt
+			reduce(50), // @graph, reduce: TopLevelStmt
+			reduce(50), // @defaults, reduce: TopLevelStmt
+			reduce(50), // @edge_defaults, reduce: TopLevelStmt
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
 		},
 	},
 	actionRow{ // S39
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,       // INVALID
-			reduce(9), // ␚, reduce: NodeDecl
+			reduce(6), // ␚, reduce: OptSep
 			nil,       // empty
-			reduce(9), // ;, reduce: NodeDecl
-			reduce(9), // id, reduce: NodeDecl
+			nil,       // ;
+			reduce(6), // id, reduce: OptSep
+			reduce(6), // dotted_id, reduce: OptSep
+			reduce(6), // quoted_string, reduce: OptSep
+			reduce(6), // ns_prefix, reduce: OptSep
 			nil,       // [
 			nil,       // ]
+			reduce(6), // _, reduce: OptSep
+			nil,       // ,
+			reduce(6), // subgraph, reduce: OptSep
+			reduce(6), // include, reduce: OptSep
 			nil,       // edgearrow
+			nil,       // edgeline
+			nil,       // edgebiarrow
+			nil,       // edgebackarrow
 			nil,       // edge_attr_open
+			nil,       // edge_attr_open_head
 			nil,       // edge_attr_close
-			nil,       // ,
+			nil,       // edge_attr_close_nohead
+			nil,       // edge_key
+			reduce(6), // {, reduce: OptSep
+			nil,       // }
+			nil,       // :
+			reduce(6), // @let, reduce: OptSep
 			nil,       // =
+			reduce(6), // @template, reduce: OptSep
+			nil,       // (
+			nil,       // )
+			reduce(6), // @use, reduce: OptSep
+			reduce(6), // @namespace, reduce: OptSep
+			reduce(6), // !, reduce: OptSep
+			reduce(6), // @graph, reduce: OptSep
+			reduce(6), // @defaults, reduce: OptSep
+			reduce(6), // @edge_defaults, reduce: OptSep
 			nil,       // numeric_literal
-			nil,       // quoted_string
+			nil,       // raw_string
+			nil,       // param_ref
 		},
 	},
 	actionRow{ // S40
@@ -778,16 +1818,42 @@ var actionTab = actionTable{
 			nil,        // ␚
 			nil,        // empty
 			nil,        // ;
-			reduce(26), // id, reduce: AttrVal
+			shift(106), // id
+			shift(107), // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
 			nil,        // [
-			reduce(26), // ], reduce: AttrVal
+			shift(109), // ]
+			shift(111), // _
+			nil,        // ,
+			shift(113), // subgraph
+			shift(114), // include
 			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
 			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
 			nil,        // edge_attr_close
-			reduce(26), // ,, reduce: AttrVal
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
 			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			shift(115), // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
 			nil,        // numeric_literal
-			nil,        // quoted_string
+			nil,        // raw_string
+			nil,        // param_ref
 		},
 	},
 	actionRow{ // S41
@@ -797,130 +1863,312 @@ var actionTab = actionTable{
 			nil,        // ␚
 			nil,        // empty
 			nil,        // ;
-			reduce(23), // id, reduce: OptAttrSep
-			nil,        // [
-			reduce(23), // ], reduce: OptAttrSep
+			nil,        // id
+			nil,        // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			shift(118), // [
+			nil,        // ]
+			nil,        // _
+			nil,        // ,
+			nil,        // subgraph
+			nil,        // include
 			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
 			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
 			nil,        // edge_attr_close
-			shift(53),  // ,
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			shift(119), // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
 			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
 			nil,        // numeric_literal
-			nil,        // quoted_string
+			nil,        // raw_string
+			nil,        // param_ref
 		},
 	},
 	actionRow{ // S42
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // ␚
-			nil,        // empty
-			nil,        // ;
-			reduce(27), // id, reduce: AttrVal
-			nil,        // [
-			reduce(27), // ], reduce: AttrVal
-			nil,        // edgearrow
-			nil,        // edge_attr_open
-			nil,        // edge_attr_close
-			reduce(27), // ,, reduce: AttrVal
-			nil,        // =
-			nil,        // numeric_literal
-			nil,        // quoted_string
+			nil,       // INVALID
+			nil,       // ␚
+			nil,       // empty
+			nil,       // ;
+			nil,       // id
+			nil,       // dotted_id
+			nil,       // quoted_string
+			nil,       // ns_prefix
+			reduce(7), // [, reduce: NodeId
+			nil,       // ]
+			nil,       // _
+			nil,       // ,
+			nil,       // subgraph
+			nil,       // include
+			nil,       // edgearrow
+			nil,       // edgeline
+			nil,       // edgebiarrow
+			nil,       // edgebackarrow
+			nil,       // edge_attr_open
+			nil,       // edge_attr_open_head
+			nil,       // edge_attr_close
+			nil,       // edge_attr_close_nohead
+			nil,       // edge_key
+			reduce(7), // {, reduce: NodeId
+			nil,       // }
+			nil,       // :
+			nil,       // @let
+			nil,       // =
+			nil,       // @template
+			nil,       // (
+			nil,       // )
+			nil,       // @use
+			nil,       // @namespace
+			nil,       // !
+			nil,       // @graph
+			nil,       // @defaults
+			nil,       // @edge_defaults
+			nil,       // numeric_literal
+			nil,       // raw_string
+			nil,       // param_ref
 		},
 	},
 	actionRow{ // S43
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // ␚
-			nil,        // empty
-			nil,        // ;
-			reduce(28), // id, reduce: AttrVal
-			nil,        // [
-			reduce(28), // ], reduce: AttrVal
-			nil,        // edgearrow
-			nil,        // edge_attr_open
-			nil,        // edge_attr_close
-			reduce(28), // ,, reduce: AttrVal
-			nil,        // =
-			nil,        // numeric_literal
-			nil,        // quoted_string
-		},
-	},
-	actionRow{ // S44
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,       // INVALID
 			nil,       // ␚
 			nil,       // empty
 			nil,       // ;
-			shift(34), // id
-			nil,       // [
+			nil,       // id
+			nil,       // dotted_id
+			nil,       // quoted_string
+			nil,       // ns_prefix
+			reduce(8), // [, reduce: NodeId
 			nil,       // ]
+			nil,       // _
+			nil,       // ,
+			nil,       // subgraph
+			nil,       // include
 			nil,       // edgearrow
+			nil,       // edgeline
+			nil,       // edgebiarrow
+			nil,       // edgebackarrow
 			nil,       // edge_attr_open
-			shift(54), // edge_attr_close
-			nil,       // ,
+			nil,       // edge_attr_open_head
+			nil,       // edge_attr_close
+			nil,       // edge_attr_close_nohead
+			nil,       // edge_key
+			reduce(8), // {, reduce: NodeId
+			nil,       // }
+			nil,       // :
+			nil,       // @let
 			nil,       // =
+			nil,       // @template
+			nil,       // (
+			nil,       // )
+			nil,       // @use
+			nil,       // @namespace
+			nil,       // !
+			nil,       // @graph
+			nil,       // @defaults
+			nil,       // @edge_defaults
 			nil,       // numeric_literal
-			nil,       // quoted_string
+			nil,       // raw_string
+			nil,       // param_ref
 		},
 	},
-	actionRow{ // S45
+	actionRow{ // S44
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,       // INVALID
 			nil,       // ␚
 			nil,       // empty
 			nil,       // ;
-			shift(55), // id
-			nil,       // [
+			nil,       // id
+			nil,       // dotted_id
+			nil,       // quoted_string
+			nil,       // ns_prefix
+			reduce(9), // [, reduce: NodeId
 			nil,       // ]
+			nil,       // _
+			nil,       // ,
+			nil,       // subgraph
+			nil,       // include
 			nil,       // edgearrow
+			nil,       // edgeline
+			nil,       // edgebiarrow
+			nil,       // edgebackarrow
 			nil,       // edge_attr_open
+			nil,       // edge_attr_open_head
 			nil,       // edge_attr_close
-			nil,       // ,
+			nil,       // edge_attr_close_nohead
+			nil,       // edge_key
+			reduce(9), // {, reduce: NodeId
+			nil,       // }
+			nil,       // :
+			nil,       // @let
 			nil,       // =
+			nil,       // @template
+			nil,       // (
+			nil,       // )
+			nil,       // @use
+			nil,       // @namespace
+			nil,       // !
+			nil,       // @graph
+			nil,       // @defaults
+			nil,       // @edge_defaults
 			nil,       // numeric_literal
-			nil,       // quoted_string
+			nil,       // raw_string
+			nil,       // param_ref
 		},
 	},
-	actionRow{ // S46
+	actionRow{ // S45
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // ␚
 			nil,        // empty
 			nil,        // ;
-			reduce(26), // id, reduce: AttrVal
+			nil,        // id
+			nil,        // dotted_id
+			shift(121), // quoted_string
+			nil,        // ns_prefix
 			nil,        // [
 			nil,        // ]
+			nil,        // _
+			nil,        // ,
+			nil,        // subgraph
+			nil,        // include
 			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
 			nil,        // edge_attr_open
-			reduce(26), // edge_attr_close, reduce: AttrVal
-			reduce(26), // ,, reduce: AttrVal
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
 			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
 			nil,        // numeric_literal
-			nil,        // quoted_string
+			nil,        // raw_string
+			nil,        // param_ref
 		},
 	},
-	actionRow{ // S47
+	actionRow{ // S46
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			nil,        // ␚
+			reduce(75), // ␚, reduce: IncludeDecl
 			nil,        // empty
-			nil,        // ;
-			reduce(23), // id, reduce: OptAttrSep
+			reduce(75), // ;, reduce: IncludeDecl
+			reduce(75), // id, reduce: IncludeDecl
+			reduce(75), // dotted_id, reduce: IncludeDecl
+			reduce(75), // quoted_string, reduce: IncludeDecl
+			reduce(75), // ns_prefix, reduce: IncludeDecl
 			nil,        // [
 			nil,        // ]
+			reduce(75), // _, reduce: IncludeDecl
+			nil,        // ,
+			reduce(75), // subgraph, reduce: IncludeDecl
+			reduce(75), // include, reduce: IncludeDecl
 			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
 			nil,        // edge_attr_open
-			reduce(23), // edge_attr_close, reduce: OptAttrSep
-			shift(57),  // ,
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(75), // {, reduce: IncludeDecl
+			nil,        // }
+			nil,        // :
+			reduce(75), // @let, reduce: IncludeDecl
 			nil,        // =
+			reduce(75), // @template, reduce: IncludeDecl
+			nil,        // (
+			nil,        // )
+			reduce(75), // @use, reduce: IncludeDecl
+			reduce(75), // @namespace, reduce: IncludeDecl
+			reduce(75), // !, reduce: IncludeDecl
+			reduce(75), // @graph, reduce: IncludeDecl
+			reduce(75), // @defaults, reduce: IncludeDecl
+			reduce(75), // @edge_defaults, reduce: IncludeDecl
 			nil,        // numeric_literal
-			nil,        // quoted_string
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S47
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			shift(5),   // id
+			shift(6),   // dotted_id
+			shift(7),   // quoted_string
+			shift(8),   // ns_prefix
+			nil,        // [
+			nil,        // ]
+			shift(11),  // _
+			nil,        // ,
+			nil,        // subgraph
+			nil,        // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			shift(126), // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
 		},
 	},
 	actionRow{ // S48
@@ -930,16 +2178,42 @@ var actionTab = actionTable{
 			nil,        // ␚
 			nil,        // empty
 			nil,        // ;
-			reduce(27), // id, reduce: AttrVal
+			reduce(24), // id, reduce: EdgeArrow
+			reduce(24), // dotted_id, reduce: EdgeArrow
+			reduce(24), // quoted_string, reduce: EdgeArrow
+			reduce(24), // ns_prefix, reduce: EdgeArrow
 			nil,        // [
 			nil,        // ]
+			reduce(24), // _, reduce: EdgeArrow
+			nil,        // ,
+			nil,        // subgraph
+			nil,        // include
 			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
 			nil,        // edge_attr_open
-			reduce(27), // edge_attr_close, reduce: AttrVal
-			reduce(27), // ,, reduce: AttrVal
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(24), // {, reduce: EdgeArrow
+			nil,        // }
+			nil,        // :
+			nil,        // @let
 			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
 			nil,        // numeric_literal
-			nil,        // quoted_string
+			nil,        // raw_string
+			nil,        // param_ref
 		},
 	},
 	actionRow{ // S49
@@ -949,54 +2223,132 @@ var actionTab = actionTable{
 			nil,        // ␚
 			nil,        // empty
 			nil,        // ;
-			reduce(28), // id, reduce: AttrVal
+			reduce(25), // id, reduce: EdgeArrow
+			reduce(25), // dotted_id, reduce: EdgeArrow
+			reduce(25), // quoted_string, reduce: EdgeArrow
+			reduce(25), // ns_prefix, reduce: EdgeArrow
 			nil,        // [
 			nil,        // ]
+			reduce(25), // _, reduce: EdgeArrow
+			nil,        // ,
+			nil,        // subgraph
+			nil,        // include
 			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
 			nil,        // edge_attr_open
-			reduce(28), // edge_attr_close, reduce: AttrVal
-			reduce(28), // ,, reduce: AttrVal
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(25), // {, reduce: EdgeArrow
+			nil,        // }
+			nil,        // :
+			nil,        // @let
 			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
 			nil,        // numeric_literal
-			nil,        // quoted_string
+			nil,        // raw_string
+			nil,        // param_ref
 		},
 	},
 	actionRow{ // S50
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			reduce(14), // ␚, reduce: EdgeRHS
+			nil,        // ␚
 			nil,        // empty
-			reduce(14), // ;, reduce: EdgeRHS
-			reduce(14), // id, reduce: EdgeRHS
+			nil,        // ;
+			reduce(26), // id, reduce: EdgeArrow
+			reduce(26), // dotted_id, reduce: EdgeArrow
+			reduce(26), // quoted_string, reduce: EdgeArrow
+			reduce(26), // ns_prefix, reduce: EdgeArrow
 			nil,        // [
 			nil,        // ]
-			reduce(14), // edgearrow, reduce: EdgeRHS
-			reduce(14), // edge_attr_open, reduce: EdgeRHS
-			nil,        // edge_attr_close
+			reduce(26), // _, reduce: EdgeArrow
 			nil,        // ,
+			nil,        // subgraph
+			nil,        // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(26), // {, reduce: EdgeArrow
+			nil,        // }
+			nil,        // :
+			nil,        // @let
 			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
 			nil,        // numeric_literal
-			nil,        // quoted_string
+			nil,        // raw_string
+			nil,        // param_ref
 		},
 	},
 	actionRow{ // S51
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       // INVALID
-			reduce(8), // ␚, reduce: NodeDecl
-			nil,       // empty
-			reduce(8), // ;, reduce: NodeDecl
-			reduce(8), // id, reduce: NodeDecl
-			nil,       // [
-			nil,       // ]
-			nil,       // edgearrow
-			nil,       // edge_attr_open
-			nil,       // edge_attr_close
-			nil,       // ,
-			nil,       // =
-			nil,       // numeric_literal
-			nil,       // quoted_string
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			reduce(27), // id, reduce: EdgeArrow
+			reduce(27), // dotted_id, reduce: EdgeArrow
+			reduce(27), // quoted_string, reduce: EdgeArrow
+			reduce(27), // ns_prefix, reduce: EdgeArrow
+			nil,        // [
+			nil,        // ]
+			reduce(27), // _, reduce: EdgeArrow
+			nil,        // ,
+			nil,        // subgraph
+			nil,        // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(27), // {, reduce: EdgeArrow
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
 		},
 	},
 	actionRow{ // S52
@@ -1006,16 +2358,42 @@ var actionTab = actionTable{
 			nil,        // ␚
 			nil,        // empty
 			nil,        // ;
-			reduce(25), // id, reduce: Attr
+			shift(127), // id
+			shift(107), // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
 			nil,        // [
-			reduce(25), // ], reduce: Attr
+			nil,        // ]
+			shift(129), // _
+			nil,        // ,
+			shift(131), // subgraph
+			shift(132), // include
 			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
 			nil,        // edge_attr_open
-			nil,        // edge_attr_close
-			nil,        // ,
+			nil,        // edge_attr_open_head
+			shift(134), // edge_attr_close
+			shift(135), // edge_attr_close_nohead
+			shift(137), // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
 			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			shift(138), // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
 			nil,        // numeric_literal
-			nil,        // quoted_string
+			nil,        // raw_string
+			nil,        // param_ref
 		},
 	},
 	actionRow{ // S53
@@ -1025,111 +2403,20292 @@ var actionTab = actionTable{
 			nil,        // ␚
 			nil,        // empty
 			nil,        // ;
-			reduce(24), // id, reduce: OptAttrSep
+			reduce(28), // id, reduce: EdgeAttrOpen
+			reduce(28), // dotted_id, reduce: EdgeAttrOpen
+			nil,        // quoted_string
+			nil,        // ns_prefix
 			nil,        // [
-			reduce(24), // ], reduce: OptAttrSep
+			nil,        // ]
+			reduce(28), // _, reduce: EdgeAttrOpen
+			nil,        // ,
+			reduce(28), // subgraph, reduce: EdgeAttrOpen
+			reduce(28), // include, reduce: EdgeAttrOpen
 			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
 			nil,        // edge_attr_open
-			nil,        // edge_attr_close
-			nil,        // ,
+			nil,        // edge_attr_open_head
+			reduce(28), // edge_attr_close, reduce: EdgeAttrOpen
+			reduce(28), // edge_attr_close_nohead, reduce: EdgeAttrOpen
+			reduce(28), // edge_key, reduce: EdgeAttrOpen
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
 			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			reduce(28), // !, reduce: EdgeAttrOpen
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
 			nil,        // numeric_literal
-			nil,        // quoted_string
+			nil,        // raw_string
+			nil,        // param_ref
 		},
 	},
 	actionRow{ // S54
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			reduce(29), // id, reduce: EdgeAttrOpen
+			reduce(29), // dotted_id, reduce: EdgeAttrOpen
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			reduce(29), // _, reduce: EdgeAttrOpen
+			nil,        // ,
+			reduce(29), // subgraph, reduce: EdgeAttrOpen
+			reduce(29), // include, reduce: EdgeAttrOpen
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			reduce(29), // edge_attr_close, reduce: EdgeAttrOpen
+			reduce(29), // edge_attr_close_nohead, reduce: EdgeAttrOpen
+			reduce(29), // edge_key, reduce: EdgeAttrOpen
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			reduce(29), // !, reduce: EdgeAttrOpen
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S55
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(47), // ␚, reduce: EdgeDecl
+			nil,        // empty
+			reduce(47), // ;, reduce: EdgeDecl
+			reduce(47), // id, reduce: EdgeDecl
+			reduce(47), // dotted_id, reduce: EdgeDecl
+			reduce(47), // quoted_string, reduce: EdgeDecl
+			reduce(47), // ns_prefix, reduce: EdgeDecl
+			nil,        // [
+			nil,        // ]
+			reduce(47), // _, reduce: EdgeDecl
+			nil,        // ,
+			reduce(47), // subgraph, reduce: EdgeDecl
+			reduce(47), // include, reduce: EdgeDecl
+			reduce(47), // edgearrow, reduce: EdgeDecl
+			reduce(47), // edgeline, reduce: EdgeDecl
+			reduce(47), // edgebiarrow, reduce: EdgeDecl
+			reduce(47), // edgebackarrow, reduce: EdgeDecl
+			reduce(47), // edge_attr_open, reduce: EdgeDecl
+			reduce(47), // edge_attr_open_head, reduce: EdgeDecl
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(47), // {, reduce: EdgeDecl
+			nil,        // }
+			nil,        // :
+			reduce(47), // @let, reduce: EdgeDecl
+			nil,        // =
+			reduce(47), // @template, reduce: EdgeDecl
+			nil,        // (
+			nil,        // )
+			reduce(47), // @use, reduce: EdgeDecl
+			reduce(47), // @namespace, reduce: EdgeDecl
+			reduce(47), // !, reduce: EdgeDecl
+			reduce(47), // @graph, reduce: EdgeDecl
+			reduce(47), // @defaults, reduce: EdgeDecl
+			reduce(47), // @edge_defaults, reduce: EdgeDecl
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S56
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			reduce(16), // id, reduce: NodeRef
+			reduce(16), // dotted_id, reduce: NodeRef
+			reduce(16), // quoted_string, reduce: NodeRef
+			reduce(16), // ns_prefix, reduce: NodeRef
+			reduce(16), // [, reduce: NodeRef
+			nil,        // ]
+			reduce(16), // _, reduce: NodeRef
+			reduce(16), // ,, reduce: NodeRef
+			nil,        // subgraph
+			nil,        // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			reduce(16), // }, reduce: NodeRef
+			shift(141), // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S57
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,       // INVALID
 			nil,       // ␚
 			nil,       // empty
 			nil,       // ;
-			shift(58), // id
-			nil,       // [
+			reduce(7), // id, reduce: NodeId
+			reduce(7), // dotted_id, reduce: NodeId
+			reduce(7), // quoted_string, reduce: NodeId
+			reduce(7), // ns_prefix, reduce: NodeId
+			reduce(7), // [, reduce: NodeId
 			nil,       // ]
+			reduce(7), // _, reduce: NodeId
+			reduce(7), // ,, reduce: NodeId
+			nil,       // subgraph
+			nil,       // include
 			nil,       // edgearrow
+			nil,       // edgeline
+			nil,       // edgebiarrow
+			nil,       // edgebackarrow
 			nil,       // edge_attr_open
+			nil,       // edge_attr_open_head
 			nil,       // edge_attr_close
-			nil,       // ,
+			nil,       // edge_attr_close_nohead
+			nil,       // edge_key
+			nil,       // {
+			reduce(7), // }, reduce: NodeId
+			reduce(7), // :, reduce: NodeId
+			nil,       // @let
 			nil,       // =
+			nil,       // @template
+			nil,       // (
+			nil,       // )
+			nil,       // @use
+			nil,       // @namespace
+			nil,       // !
+			nil,       // @graph
+			nil,       // @defaults
+			nil,       // @edge_defaults
 			nil,       // numeric_literal
-			nil,       // quoted_string
+			nil,       // raw_string
+			nil,       // param_ref
 		},
 	},
-	actionRow{ // S55
+	actionRow{ // S58
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			nil,       // ␚
+			nil,       // empty
+			nil,       // ;
+			reduce(8), // id, reduce: NodeId
+			reduce(8), // dotted_id, reduce: NodeId
+			reduce(8), // quoted_string, reduce: NodeId
+			reduce(8), // ns_prefix, reduce: NodeId
+			reduce(8), // [, reduce: NodeId
+			nil,       // ]
+			reduce(8), // _, reduce: NodeId
+			reduce(8), // ,, reduce: NodeId
+			nil,       // subgraph
+			nil,       // include
+			nil,       // edgearrow
+			nil,       // edgeline
+			nil,       // edgebiarrow
+			nil,       // edgebackarrow
+			nil,       // edge_attr_open
+			nil,       // edge_attr_open_head
+			nil,       // edge_attr_close
+			nil,       // edge_attr_close_nohead
+			nil,       // edge_key
+			nil,       // {
+			reduce(8), // }, reduce: NodeId
+			reduce(8), // :, reduce: NodeId
+			nil,       // @let
+			nil,       // =
+			nil,       // @template
+			nil,       // (
+			nil,       // )
+			nil,       // @use
+			nil,       // @namespace
+			nil,       // !
+			nil,       // @graph
+			nil,       // @defaults
+			nil,       // @edge_defaults
+			nil,       // numeric_literal
+			nil,       // raw_string
+			nil,       // param_ref
+		},
+	},
+	actionRow{ // S59
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			nil,       // ␚
+			nil,       // empty
+			nil,       // ;
+			reduce(9), // id, reduce: NodeId
+			reduce(9), // dotted_id, reduce: NodeId
+			reduce(9), // quoted_string, reduce: NodeId
+			reduce(9), // ns_prefix, reduce: NodeId
+			reduce(9), // [, reduce: NodeId
+			nil,       // ]
+			reduce(9), // _, reduce: NodeId
+			reduce(9), // ,, reduce: NodeId
+			nil,       // subgraph
+			nil,       // include
+			nil,       // edgearrow
+			nil,       // edgeline
+			nil,       // edgebiarrow
+			nil,       // edgebackarrow
+			nil,       // edge_attr_open
+			nil,       // edge_attr_open_head
+			nil,       // edge_attr_close
+			nil,       // edge_attr_close_nohead
+			nil,       // edge_key
+			nil,       // {
+			reduce(9), // }, reduce: NodeId
+			reduce(9), // :, reduce: NodeId
+			nil,       // @let
+			nil,       // =
+			nil,       // @template
+			nil,       // (
+			nil,       // )
+			nil,       // @use
+			nil,       // @namespace
+			nil,       // !
+			nil,       // @graph
+			nil,       // @defaults
+			nil,       // @edge_defaults
+			nil,       // numeric_literal
+			nil,       // raw_string
+			nil,       // param_ref
+		},
+	},
+	actionRow{ // S60
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			reduce(15), // ␚, reduce: EdgeRHS
+			nil,        // ␚
 			nil,        // empty
-			reduce(15), // ;, reduce: EdgeRHS
-			reduce(15), // id, reduce: EdgeRHS
+			nil,        // ;
+			nil,        // id
+			nil,        // dotted_id
+			shift(142), // quoted_string
+			nil,        // ns_prefix
 			nil,        // [
 			nil,        // ]
-			reduce(15), // edgearrow, reduce: EdgeRHS
-			reduce(15), // edge_attr_open, reduce: EdgeRHS
-			nil,        // edge_attr_close
+			nil,        // _
 			nil,        // ,
+			nil,        // subgraph
+			nil,        // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
 			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
 			nil,        // numeric_literal
-			nil,        // quoted_string
+			nil,        // raw_string
+			nil,        // param_ref
 		},
 	},
-	actionRow{ // S56
+	actionRow{ // S61
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // ␚
 			nil,        // empty
 			nil,        // ;
-			reduce(25), // id, reduce: Attr
+			reduce(42), // id, reduce: EdgeRef
+			reduce(42), // dotted_id, reduce: EdgeRef
+			reduce(42), // quoted_string, reduce: EdgeRef
+			reduce(42), // ns_prefix, reduce: EdgeRef
 			nil,        // [
 			nil,        // ]
+			reduce(42), // _, reduce: EdgeRef
+			reduce(42), // ,, reduce: EdgeRef
+			nil,        // subgraph
+			nil,        // include
 			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
 			nil,        // edge_attr_open
-			reduce(25), // edge_attr_close, reduce: Attr
-			nil,        // ,
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			reduce(42), // }, reduce: EdgeRef
+			nil,        // :
+			nil,        // @let
 			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
 			nil,        // numeric_literal
-			nil,        // quoted_string
+			nil,        // raw_string
+			nil,        // param_ref
 		},
 	},
-	actionRow{ // S57
+	actionRow{ // S62
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			reduce(15), // id, reduce: NodeDecl
+			reduce(15), // dotted_id, reduce: NodeDecl
+			reduce(15), // quoted_string, reduce: NodeDecl
+			reduce(15), // ns_prefix, reduce: NodeDecl
+			shift(143), // [
+			nil,        // ]
+			reduce(15), // _, reduce: NodeDecl
+			reduce(15), // ,, reduce: NodeDecl
+			nil,        // subgraph
+			nil,        // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			reduce(15), // }, reduce: NodeDecl
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S63
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			reduce(17), // id, reduce: NodeRef
+			reduce(17), // dotted_id, reduce: NodeRef
+			reduce(17), // quoted_string, reduce: NodeRef
+			reduce(17), // ns_prefix, reduce: NodeRef
+			reduce(17), // [, reduce: NodeRef
+			nil,        // ]
+			reduce(17), // _, reduce: NodeRef
+			reduce(17), // ,, reduce: NodeRef
+			nil,        // subgraph
+			nil,        // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			reduce(17), // }, reduce: NodeRef
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S64
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			reduce(44), // id, reduce: EdgeRefList
+			reduce(44), // dotted_id, reduce: EdgeRefList
+			reduce(44), // quoted_string, reduce: EdgeRefList
+			reduce(44), // ns_prefix, reduce: EdgeRefList
+			nil,        // [
+			nil,        // ]
+			reduce(44), // _, reduce: EdgeRefList
+			reduce(44), // ,, reduce: EdgeRefList
+			nil,        // subgraph
+			nil,        // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			reduce(44), // }, reduce: EdgeRefList
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S65
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // ␚
 			nil,        // empty
 			nil,        // ;
-			reduce(24), // id, reduce: OptAttrSep
+			shift(57),  // id
+			shift(58),  // dotted_id
+			shift(59),  // quoted_string
+			shift(60),  // ns_prefix
 			nil,        // [
 			nil,        // ]
+			shift(63),  // _
+			shift(144), // ,
+			nil,        // subgraph
+			nil,        // include
 			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
 			nil,        // edge_attr_open
-			reduce(24), // edge_attr_close, reduce: OptAttrSep
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			shift(146), // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S66
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(49), // ␚, reduce: TopLevelStmt
+			nil,        // empty
+			nil,        // ;
+			reduce(49), // id, reduce: TopLevelStmt
+			reduce(49), // dotted_id, reduce: TopLevelStmt
+			reduce(49), // quoted_string, reduce: TopLevelStmt
+			reduce(49), // ns_prefix, reduce: TopLevelStmt
+			nil,        // [
+			nil,        // ]
+			reduce(49), // _, reduce: TopLevelStmt
 			nil,        // ,
+			reduce(49), // subgraph, reduce: TopLevelStmt
+			reduce(49), // include, reduce: TopLevelStmt
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(49), // {, reduce: TopLevelStmt
+			nil,        // }
+			nil,        // :
+			reduce(49), // @let, reduce: TopLevelStmt
 			nil,        // =
+			reduce(49), // @template, reduce: TopLevelStmt
+			nil,        // (
+			nil,        // )
+			reduce(49), // @use, reduce: TopLevelStmt
+			reduce(49), // @namespace, reduce: TopLevelStmt
+			reduce(49), // !, reduce: TopLevelStmt
+			reduce(49), // @graph, reduce: TopLevelStmt
+			reduce(49), // @defaults, reduce: TopLevelStmt
+			reduce(49), // @edge_defaults, reduce: TopLevelStmt
 			nil,        // numeric_literal
-			nil,        // quoted_string
+			nil,        // raw_string
+			nil,        // param_ref
 		},
 	},
-	actionRow{ // S58
+	actionRow{ // S67
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(48), // ␚, reduce: EdgeDecl
+			nil,        // empty
+			reduce(48), // ;, reduce: EdgeDecl
+			reduce(48), // id, reduce: EdgeDecl
+			reduce(48), // dotted_id, reduce: EdgeDecl
+			reduce(48), // quoted_string, reduce: EdgeDecl
+			reduce(48), // ns_prefix, reduce: EdgeDecl
+			nil,        // [
+			nil,        // ]
+			reduce(48), // _, reduce: EdgeDecl
+			nil,        // ,
+			reduce(48), // subgraph, reduce: EdgeDecl
+			reduce(48), // include, reduce: EdgeDecl
+			reduce(48), // edgearrow, reduce: EdgeDecl
+			reduce(48), // edgeline, reduce: EdgeDecl
+			reduce(48), // edgebiarrow, reduce: EdgeDecl
+			reduce(48), // edgebackarrow, reduce: EdgeDecl
+			reduce(48), // edge_attr_open, reduce: EdgeDecl
+			reduce(48), // edge_attr_open_head, reduce: EdgeDecl
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(48), // {, reduce: EdgeDecl
+			nil,        // }
+			nil,        // :
+			reduce(48), // @let, reduce: EdgeDecl
+			nil,        // =
+			reduce(48), // @template, reduce: EdgeDecl
+			nil,        // (
+			nil,        // )
+			reduce(48), // @use, reduce: EdgeDecl
+			reduce(48), // @namespace, reduce: EdgeDecl
+			reduce(48), // !, reduce: EdgeDecl
+			reduce(48), // @graph, reduce: EdgeDecl
+			reduce(48), // @defaults, reduce: EdgeDecl
+			reduce(48), // @edge_defaults, reduce: EdgeDecl
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S68
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			reduce(16), // ␚, reduce: EdgeRHS
+			reduce(51), // ␚, reduce: TopLevelStmt
 			nil,        // empty
-			reduce(16), // ;, reduce: EdgeRHS
-			reduce(16), // id, reduce: EdgeRHS
+			nil,        // ;
+			reduce(51), // id, reduce: TopLevelStmt
+			reduce(51), // dotted_id, reduce: TopLevelStmt
+			reduce(51), // quoted_string, reduce: TopLevelStmt
+			reduce(51), // ns_prefix, reduce: TopLevelStmt
 			nil,        // [
 			nil,        // ]
-			reduce(16), // edgearrow, reduce: EdgeRHS
-			reduce(16), // edge_attr_open, reduce: EdgeRHS
+			reduce(51), // _, reduce: TopLevelStmt
+			nil,        // ,
+			reduce(51), // subgraph, reduce: TopLevelStmt
+			reduce(51), // include, reduce: TopLevelStmt
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
 			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(51), // {, reduce: TopLevelStmt
+			nil,        // }
+			nil,        // :
+			reduce(51), // @let, reduce: TopLevelStmt
+			nil,        // =
+			reduce(51), // @template, reduce: TopLevelStmt
+			nil,        // (
+			nil,        // )
+			reduce(51), // @use, reduce: TopLevelStmt
+			reduce(51), // @namespace, reduce: TopLevelStmt
+			reduce(51), // !, reduce: TopLevelStmt
+			reduce(51), // @graph, reduce: TopLevelStmt
+			reduce(51), // @defaults, reduce: TopLevelStmt
+			reduce(51), // @edge_defaults, reduce: TopLevelStmt
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S69
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(52), // ␚, reduce: TopLevelStmt
+			nil,        // empty
+			nil,        // ;
+			reduce(52), // id, reduce: TopLevelStmt
+			reduce(52), // dotted_id, reduce: TopLevelStmt
+			reduce(52), // quoted_string, reduce: TopLevelStmt
+			reduce(52), // ns_prefix, reduce: TopLevelStmt
+			nil,        // [
+			nil,        // ]
+			reduce(52), // _, reduce: TopLevelStmt
 			nil,        // ,
+			reduce(52), // subgraph, reduce: TopLevelStmt
+			reduce(52), // include, reduce: TopLevelStmt
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(52), // {, reduce: TopLevelStmt
+			nil,        // }
+			nil,        // :
+			reduce(52), // @let, reduce: TopLevelStmt
 			nil,        // =
+			reduce(52), // @template, reduce: TopLevelStmt
+			nil,        // (
+			nil,        // )
+			reduce(52), // @use, reduce: TopLevelStmt
+			reduce(52), // @namespace, reduce: TopLevelStmt
+			reduce(52), // !, reduce: TopLevelStmt
+			reduce(52), // @graph, reduce: TopLevelStmt
+			reduce(52), // @defaults, reduce: TopLevelStmt
+			reduce(52), // @edge_defaults, reduce: TopLevelStmt
 			nil,        // numeric_literal
-			nil,        // quoted_string
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S70
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(53), // ␚, reduce: TopLevelStmt
+			nil,        // empty
+			nil,        // ;
+			reduce(53), // id, reduce: TopLevelStmt
+			reduce(53), // dotted_id, reduce: TopLevelStmt
+			reduce(53), // quoted_string, reduce: TopLevelStmt
+			reduce(53), // ns_prefix, reduce: TopLevelStmt
+			nil,        // [
+			nil,        // ]
+			reduce(53), // _, reduce: TopLevelStmt
+			nil,        // ,
+			reduce(53), // subgraph, reduce: TopLevelStmt
+			reduce(53), // include, reduce: TopLevelStmt
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(53), // {, reduce: TopLevelStmt
+			nil,        // }
+			nil,        // :
+			reduce(53), // @let, reduce: TopLevelStmt
+			nil,        // =
+			reduce(53), // @template, reduce: TopLevelStmt
+			nil,        // (
+			nil,        // )
+			reduce(53), // @use, reduce: TopLevelStmt
+			reduce(53), // @namespace, reduce: TopLevelStmt
+			reduce(53), // !, reduce: TopLevelStmt
+			reduce(53), // @graph, reduce: TopLevelStmt
+			reduce(53), // @defaults, reduce: TopLevelStmt
+			reduce(53), // @edge_defaults, reduce: TopLevelStmt
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S71
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(54), // ␚, reduce: TopLevelStmt
+			nil,        // empty
+			nil,        // ;
+			reduce(54), // id, reduce: TopLevelStmt
+			reduce(54), // dotted_id, reduce: TopLevelStmt
+			reduce(54), // quoted_string, reduce: TopLevelStmt
+			reduce(54), // ns_prefix, reduce: TopLevelStmt
+			nil,        // [
+			nil,        // ]
+			reduce(54), // _, reduce: TopLevelStmt
+			nil,        // ,
+			reduce(54), // subgraph, reduce: TopLevelStmt
+			reduce(54), // include, reduce: TopLevelStmt
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(54), // {, reduce: TopLevelStmt
+			nil,        // }
+			nil,        // :
+			reduce(54), // @let, reduce: TopLevelStmt
+			nil,        // =
+			reduce(54), // @template, reduce: TopLevelStmt
+			nil,        // (
+			nil,        // )
+			reduce(54), // @use, reduce: TopLevelStmt
+			reduce(54), // @namespace, reduce: TopLevelStmt
+			reduce(54), // !, reduce: TopLevelStmt
+			reduce(54), // @graph, reduce: TopLevelStmt
+			reduce(54), // @defaults, reduce: TopLevelStmt
+			reduce(54), // @edge_defaults, reduce: TopLevelStmt
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S72
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(55), // ␚, reduce: TopLevelStmt
+			nil,        // empty
+			nil,        // ;
+			reduce(55), // id, reduce: TopLevelStmt
+			reduce(55), // dotted_id, reduce: TopLevelStmt
+			reduce(55), // quoted_string, reduce: TopLevelStmt
+			reduce(55), // ns_prefix, reduce: TopLevelStmt
+			nil,        // [
+			nil,        // ]
+			reduce(55), // _, reduce: TopLevelStmt
+			nil,        // ,
+			reduce(55), // subgraph, reduce: TopLevelStmt
+			reduce(55), // include, reduce: TopLevelStmt
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(55), // {, reduce: TopLevelStmt
+			nil,        // }
+			nil,        // :
+			reduce(55), // @let, reduce: TopLevelStmt
+			nil,        // =
+			reduce(55), // @template, reduce: TopLevelStmt
+			nil,        // (
+			nil,        // )
+			reduce(55), // @use, reduce: TopLevelStmt
+			reduce(55), // @namespace, reduce: TopLevelStmt
+			reduce(55), // !, reduce: TopLevelStmt
+			reduce(55), // @graph, reduce: TopLevelStmt
+			reduce(55), // @defaults, reduce: TopLevelStmt
+			reduce(55), // @edge_defaults, reduce: TopLevelStmt
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S73
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(56), // ␚, reduce: TopLevelStmt
+			nil,        // empty
+			nil,        // ;
+			reduce(56), // id, reduce: TopLevelStmt
+			reduce(56), // dotted_id, reduce: TopLevelStmt
+			reduce(56), // quoted_string, reduce: TopLevelStmt
+			reduce(56), // ns_prefix, reduce: TopLevelStmt
+			nil,        // [
+			nil,        // ]
+			reduce(56), // _, reduce: TopLevelStmt
+			nil,        // ,
+			reduce(56), // subgraph, reduce: TopLevelStmt
+			reduce(56), // include, reduce: TopLevelStmt
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(56), // {, reduce: TopLevelStmt
+			nil,        // }
+			nil,        // :
+			reduce(56), // @let, reduce: TopLevelStmt
+			nil,        // =
+			reduce(56), // @template, reduce: TopLevelStmt
+			nil,        // (
+			nil,        // )
+			reduce(56), // @use, reduce: TopLevelStmt
+			reduce(56), // @namespace, reduce: TopLevelStmt
+			reduce(56), // !, reduce: TopLevelStmt
+			reduce(56), // @graph, reduce: TopLevelStmt
+			reduce(56), // @defaults, reduce: TopLevelStmt
+			reduce(56), // @edge_defaults, reduce: TopLevelStmt
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S74
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(57), // ␚, reduce: TopLevelStmt
+			nil,        // empty
+			nil,        // ;
+			reduce(57), // id, reduce: TopLevelStmt
+			reduce(57), // dotted_id, reduce: TopLevelStmt
+			reduce(57), // quoted_string, reduce: TopLevelStmt
+			reduce(57), // ns_prefix, reduce: TopLevelStmt
+			nil,        // [
+			nil,        // ]
+			reduce(57), // _, reduce: TopLevelStmt
+			nil,        // ,
+			reduce(57), // subgraph, reduce: TopLevelStmt
+			reduce(57), // include, reduce: TopLevelStmt
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(57), // {, reduce: TopLevelStmt
+			nil,        // }
+			nil,        // :
+			reduce(57), // @let, reduce: TopLevelStmt
+			nil,        // =
+			reduce(57), // @template, reduce: TopLevelStmt
+			nil,        // (
+			nil,        // )
+			reduce(57), // @use, reduce: TopLevelStmt
+			reduce(57), // @namespace, reduce: TopLevelStmt
+			reduce(57), // !, reduce: TopLevelStmt
+			reduce(57), // @graph, reduce: TopLevelStmt
+			reduce(57), // @defaults, reduce: TopLevelStmt
+			reduce(57), // @edge_defaults, reduce: TopLevelStmt
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S75
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(58), // ␚, reduce: TopLevelStmt
+			nil,        // empty
+			nil,        // ;
+			reduce(58), // id, reduce: TopLevelStmt
+			reduce(58), // dotted_id, reduce: TopLevelStmt
+			reduce(58), // quoted_string, reduce: TopLevelStmt
+			reduce(58), // ns_prefix, reduce: TopLevelStmt
+			nil,        // [
+			nil,        // ]
+			reduce(58), // _, reduce: TopLevelStmt
+			nil,        // ,
+			reduce(58), // subgraph, reduce: TopLevelStmt
+			reduce(58), // include, reduce: TopLevelStmt
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(58), // {, reduce: TopLevelStmt
+			nil,        // }
+			nil,        // :
+			reduce(58), // @let, reduce: TopLevelStmt
+			nil,        // =
+			reduce(58), // @template, reduce: TopLevelStmt
+			nil,        // (
+			nil,        // )
+			reduce(58), // @use, reduce: TopLevelStmt
+			reduce(58), // @namespace, reduce: TopLevelStmt
+			reduce(58), // !, reduce: TopLevelStmt
+			reduce(58), // @graph, reduce: TopLevelStmt
+			reduce(58), // @defaults, reduce: TopLevelStmt
+			reduce(58), // @edge_defaults, reduce: TopLevelStmt
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S76
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(59), // ␚, reduce: TopLevelStmt
+			nil,        // empty
+			nil,        // ;
+			reduce(59), // id, reduce: TopLevelStmt
+			reduce(59), // dotted_id, reduce: TopLevelStmt
+			reduce(59), // quoted_string, reduce: TopLevelStmt
+			reduce(59), // ns_prefix, reduce: TopLevelStmt
+			nil,        // [
+			nil,        // ]
+			reduce(59), // _, reduce: TopLevelStmt
+			nil,        // ,
+			reduce(59), // subgraph, reduce: TopLevelStmt
+			reduce(59), // include, reduce: TopLevelStmt
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(59), // {, reduce: TopLevelStmt
+			nil,        // }
+			nil,        // :
+			reduce(59), // @let, reduce: TopLevelStmt
+			nil,        // =
+			reduce(59), // @template, reduce: TopLevelStmt
+			nil,        // (
+			nil,        // )
+			reduce(59), // @use, reduce: TopLevelStmt
+			reduce(59), // @namespace, reduce: TopLevelStmt
+			reduce(59), // !, reduce: TopLevelStmt
+			reduce(59), // @graph, reduce: TopLevelStmt
+			reduce(59), // @defaults, reduce: TopLevelStmt
+			reduce(59), // @edge_defaults, reduce: TopLevelStmt
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S77
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // id
+			nil,        // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			nil,        // _
+			nil,        // ,
+			nil,        // subgraph
+			nil,        // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			shift(147), // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S78
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // id
+			nil,        // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			nil,        // _
+			nil,        // ,
+			nil,        // subgraph
+			nil,        // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			shift(148), // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S79
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // id
+			nil,        // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			nil,        // _
+			nil,        // ,
+			nil,        // subgraph
+			nil,        // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			shift(149), // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S80
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // id
+			nil,        // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			nil,        // _
+			nil,        // ,
+			nil,        // subgraph
+			nil,        // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			reduce(7),  // =, reduce: NodeId
+			nil,        // @template
+			shift(150), // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S81
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			nil,       // ␚
+			nil,       // empty
+			nil,       // ;
+			nil,       // id
+			nil,       // dotted_id
+			nil,       // quoted_string
+			nil,       // ns_prefix
+			nil,       // [
+			nil,       // ]
+			nil,       // _
+			nil,       // ,
+			nil,       // subgraph
+			nil,       // include
+			nil,       // edgearrow
+			nil,       // edgeline
+			nil,       // edgebiarrow
+			nil,       // edgebackarrow
+			nil,       // edge_attr_open
+			nil,       // edge_attr_open_head
+			nil,       // edge_attr_close
+			nil,       // edge_attr_close_nohead
+			nil,       // edge_key
+			nil,       // {
+			nil,       // }
+			nil,       // :
+			nil,       // @let
+			reduce(8), // =, reduce: NodeId
+			nil,       // @template
+			nil,       // (
+			nil,       // )
+			nil,       // @use
+			nil,       // @namespace
+			nil,       // !
+			nil,       // @graph
+			nil,       // @defaults
+			nil,       // @edge_defaults
+			nil,       // numeric_literal
+			nil,       // raw_string
+			nil,       // param_ref
+		},
+	},
+	actionRow{ // S82
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			nil,       // ␚
+			nil,       // empty
+			nil,       // ;
+			nil,       // id
+			nil,       // dotted_id
+			nil,       // quoted_string
+			nil,       // ns_prefix
+			nil,       // [
+			nil,       // ]
+			nil,       // _
+			nil,       // ,
+			nil,       // subgraph
+			nil,       // include
+			nil,       // edgearrow
+			nil,       // edgeline
+			nil,       // edgebiarrow
+			nil,       // edgebackarrow
+			nil,       // edge_attr_open
+			nil,       // edge_attr_open_head
+			nil,       // edge_attr_close
+			nil,       // edge_attr_close_nohead
+			nil,       // edge_key
+			nil,       // {
+			nil,       // }
+			nil,       // :
+			nil,       // @let
+			reduce(9), // =, reduce: NodeId
+			nil,       // @template
+			nil,       // (
+			nil,       // )
+			nil,       // @use
+			nil,       // @namespace
+			nil,       // !
+			nil,       // @graph
+			nil,       // @defaults
+			nil,       // @edge_defaults
+			nil,       // numeric_literal
+			nil,       // raw_string
+			nil,       // param_ref
+		},
+	},
+	actionRow{ // S83
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // id
+			nil,        // dotted_id
+			shift(151), // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			nil,        // _
+			nil,        // ,
+			nil,        // subgraph
+			nil,        // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S84
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // id
+			nil,        // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			nil,        // _
+			nil,        // ,
+			nil,        // subgraph
+			nil,        // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			shift(119), // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S85
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			nil,       // ␚
+			nil,       // empty
+			nil,       // ;
+			nil,       // id
+			nil,       // dotted_id
+			nil,       // quoted_string
+			nil,       // ns_prefix
+			nil,       // [
+			nil,       // ]
+			nil,       // _
+			nil,       // ,
+			nil,       // subgraph
+			nil,       // include
+			nil,       // edgearrow
+			nil,       // edgeline
+			nil,       // edgebiarrow
+			nil,       // edgebackarrow
+			nil,       // edge_attr_open
+			nil,       // edge_attr_open_head
+			nil,       // edge_attr_close
+			nil,       // edge_attr_close_nohead
+			nil,       // edge_key
+			reduce(7), // {, reduce: NodeId
+			nil,       // }
+			nil,       // :
+			nil,       // @let
+			nil,       // =
+			nil,       // @template
+			nil,       // (
+			nil,       // )
+			nil,       // @use
+			nil,       // @namespace
+			nil,       // !
+			nil,       // @graph
+			nil,       // @defaults
+			nil,       // @edge_defaults
+			nil,       // numeric_literal
+			nil,       // raw_string
+			nil,       // param_ref
+		},
+	},
+	actionRow{ // S86
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			nil,       // ␚
+			nil,       // empty
+			nil,       // ;
+			nil,       // id
+			nil,       // dotted_id
+			nil,       // quoted_string
+			nil,       // ns_prefix
+			nil,       // [
+			nil,       // ]
+			nil,       // _
+			nil,       // ,
+			nil,       // subgraph
+			nil,       // include
+			nil,       // edgearrow
+			nil,       // edgeline
+			nil,       // edgebiarrow
+			nil,       // edgebackarrow
+			nil,       // edge_attr_open
+			nil,       // edge_attr_open_head
+			nil,       // edge_attr_close
+			nil,       // edge_attr_close_nohead
+			nil,       // edge_key
+			reduce(8), // {, reduce: NodeId
+			nil,       // }
+			nil,       // :
+			nil,       // @let
+			nil,       // =
+			nil,       // @template
+			nil,       // (
+			nil,       // )
+			nil,       // @use
+			nil,       // @namespace
+			nil,       // !
+			nil,       // @graph
+			nil,       // @defaults
+			nil,       // @edge_defaults
+			nil,       // numeric_literal
+			nil,       // raw_string
+			nil,       // param_ref
+		},
+	},
+	actionRow{ // S87
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			nil,       // ␚
+			nil,       // empty
+			nil,       // ;
+			nil,       // id
+			nil,       // dotted_id
+			nil,       // quoted_string
+			nil,       // ns_prefix
+			nil,       // [
+			nil,       // ]
+			nil,       // _
+			nil,       // ,
+			nil,       // subgraph
+			nil,       // include
+			nil,       // edgearrow
+			nil,       // edgeline
+			nil,       // edgebiarrow
+			nil,       // edgebackarrow
+			nil,       // edge_attr_open
+			nil,       // edge_attr_open_head
+			nil,       // edge_attr_close
+			nil,       // edge_attr_close_nohead
+			nil,       // edge_key
+			reduce(9), // {, reduce: NodeId
+			nil,       // }
+			nil,       // :
+			nil,       // @let
+			nil,       // =
+			nil,       // @template
+			nil,       // (
+			nil,       // )
+			nil,       // @use
+			nil,       // @namespace
+			nil,       // !
+			nil,       // @graph
+			nil,       // @defaults
+			nil,       // @edge_defaults
+			nil,       // numeric_literal
+			nil,       // raw_string
+			nil,       // param_ref
+		},
+	},
+	actionRow{ // S88
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // id
+			nil,        // dotted_id
+			shift(153), // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			nil,        // _
+			nil,        // ,
+			nil,        // subgraph
+			nil,        // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S89
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(70), // ␚, reduce: DeleteDecl
+			nil,        // empty
+			reduce(70), // ;, reduce: DeleteDecl
+			reduce(70), // id, reduce: DeleteDecl
+			reduce(70), // dotted_id, reduce: DeleteDecl
+			reduce(70), // quoted_string, reduce: DeleteDecl
+			reduce(70), // ns_prefix, reduce: DeleteDecl
+			reduce(16), // [, reduce: NodeRef
+			nil,        // ]
+			reduce(70), // _, reduce: DeleteDecl
+			nil,        // ,
+			reduce(70), // subgraph, reduce: DeleteDecl
+			reduce(70), // include, reduce: DeleteDecl
+			reduce(16), // edgearrow, reduce: NodeRef
+			reduce(16), // edgeline, reduce: NodeRef
+			reduce(16), // edgebiarrow, reduce: NodeRef
+			reduce(16), // edgebackarrow, reduce: NodeRef
+			reduce(16), // edge_attr_open, reduce: NodeRef
+			reduce(16), // edge_attr_open_head, reduce: NodeRef
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(70), // {, reduce: DeleteDecl
+			nil,        // }
+			shift(36),  // :
+			reduce(70), // @let, reduce: DeleteDecl
+			nil,        // =
+			reduce(70), // @template, reduce: DeleteDecl
+			nil,        // (
+			nil,        // )
+			reduce(70), // @use, reduce: DeleteDecl
+			reduce(70), // @namespace, reduce: DeleteDecl
+			reduce(70), // !, reduce: DeleteDecl
+			reduce(70), // @graph, reduce: DeleteDecl
+			reduce(70), // @defaults, reduce: DeleteDecl
+			reduce(70), // @edge_defaults, reduce: DeleteDecl
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S90
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // id
+			nil,        // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			nil,        // _
+			nil,        // ,
+			nil,        // subgraph
+			nil,        // include
+			reduce(42), // edgearrow, reduce: EdgeRef
+			reduce(42), // edgeline, reduce: EdgeRef
+			reduce(42), // edgebiarrow, reduce: EdgeRef
+			reduce(42), // edgebackarrow, reduce: EdgeRef
+			reduce(42), // edge_attr_open, reduce: EdgeRef
+			reduce(42), // edge_attr_open_head, reduce: EdgeRef
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S91
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // id
+			nil,        // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			shift(154), // [
+			nil,        // ]
+			nil,        // _
+			nil,        // ,
+			nil,        // subgraph
+			nil,        // include
+			reduce(15), // edgearrow, reduce: NodeDecl
+			reduce(15), // edgeline, reduce: NodeDecl
+			reduce(15), // edgebiarrow, reduce: NodeDecl
+			reduce(15), // edgebackarrow, reduce: NodeDecl
+			reduce(15), // edge_attr_open, reduce: NodeDecl
+			reduce(15), // edge_attr_open_head, reduce: NodeDecl
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S92
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // id
+			nil,        // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			reduce(17), // [, reduce: NodeRef
+			nil,        // ]
+			nil,        // _
+			nil,        // ,
+			nil,        // subgraph
+			nil,        // include
+			reduce(17), // edgearrow, reduce: NodeRef
+			reduce(17), // edgeline, reduce: NodeRef
+			reduce(17), // edgebiarrow, reduce: NodeRef
+			reduce(17), // edgebackarrow, reduce: NodeRef
+			reduce(17), // edge_attr_open, reduce: NodeRef
+			reduce(17), // edge_attr_open_head, reduce: NodeRef
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S93
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(71), // ␚, reduce: DeleteDecl
+			nil,        // empty
+			reduce(71), // ;, reduce: DeleteDecl
+			reduce(71), // id, reduce: DeleteDecl
+			reduce(71), // dotted_id, reduce: DeleteDecl
+			reduce(71), // quoted_string, reduce: DeleteDecl
+			reduce(71), // ns_prefix, reduce: DeleteDecl
+			nil,        // [
+			nil,        // ]
+			reduce(71), // _, reduce: DeleteDecl
+			nil,        // ,
+			reduce(71), // subgraph, reduce: DeleteDecl
+			reduce(71), // include, reduce: DeleteDecl
+			shift(48),  // edgearrow
+			shift(49),  // edgeline
+			shift(50),  // edgebiarrow
+			shift(51),  // edgebackarrow
+			shift(53),  // edge_attr_open
+			shift(54),  // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(71), // {, reduce: DeleteDecl
+			nil,        // }
+			nil,        // :
+			reduce(71), // @let, reduce: DeleteDecl
+			nil,        // =
+			reduce(71), // @template, reduce: DeleteDecl
+			nil,        // (
+			nil,        // )
+			reduce(71), // @use, reduce: DeleteDecl
+			reduce(71), // @namespace, reduce: DeleteDecl
+			reduce(71), // !, reduce: DeleteDecl
+			reduce(71), // @graph, reduce: DeleteDecl
+			reduce(71), // @defaults, reduce: DeleteDecl
+			reduce(71), // @edge_defaults, reduce: DeleteDecl
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S94
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			shift(155), // id
+			shift(107), // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			shift(157), // _
+			nil,        // ,
+			shift(159), // subgraph
+			shift(160), // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			shift(115), // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S95
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // id
+			nil,        // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			reduce(20), // [, reduce: Word
+			nil,        // ]
+			nil,        // _
+			nil,        // ,
+			nil,        // subgraph
+			nil,        // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S96
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // id
+			nil,        // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			reduce(23), // [, reduce: Word
+			nil,        // ]
+			nil,        // _
+			nil,        // ,
+			nil,        // subgraph
+			nil,        // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S97
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // id
+			nil,        // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			shift(161), // [
+			nil,        // ]
+			nil,        // _
+			nil,        // ,
+			nil,        // subgraph
+			nil,        // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S98
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // id
+			nil,        // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			reduce(21), // [, reduce: Word
+			nil,        // ]
+			nil,        // _
+			nil,        // ,
+			nil,        // subgraph
+			nil,        // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S99
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // id
+			nil,        // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			reduce(22), // [, reduce: Word
+			nil,        // ]
+			nil,        // _
+			nil,        // ,
+			nil,        // subgraph
+			nil,        // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S100
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // id
+			nil,        // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			shift(162), // [
+			nil,        // ]
+			nil,        // _
+			nil,        // ,
+			nil,        // subgraph
+			nil,        // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S101
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // id
+			nil,        // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			nil,        // _
+			nil,        // ,
+			nil,        // subgraph
+			nil,        // include
+			reduce(43), // edgearrow, reduce: EdgeRef
+			reduce(43), // edgeline, reduce: EdgeRef
+			reduce(43), // edgebiarrow, reduce: EdgeRef
+			reduce(43), // edgebackarrow, reduce: EdgeRef
+			reduce(43), // edge_attr_open, reduce: EdgeRef
+			reduce(43), // edge_attr_open_head, reduce: EdgeRef
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S102
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			nil,       // ␚
+			nil,       // empty
+			nil,       // ;
+			nil,       // id
+			nil,       // dotted_id
+			nil,       // quoted_string
+			nil,       // ns_prefix
+			nil,       // [
+			nil,       // ]
+			nil,       // _
+			nil,       // ,
+			nil,       // subgraph
+			nil,       // include
+			reduce(7), // edgearrow, reduce: NodeId
+			reduce(7), // edgeline, reduce: NodeId
+			reduce(7), // edgebiarrow, reduce: NodeId
+			reduce(7), // edgebackarrow, reduce: NodeId
+			reduce(7), // edge_attr_open, reduce: NodeId
+			reduce(7), // edge_attr_open_head, reduce: NodeId
+			nil,       // edge_attr_close
+			nil,       // edge_attr_close_nohead
+			nil,       // edge_key
+			nil,       // {
+			nil,       // }
+			nil,       // :
+			nil,       // @let
+			nil,       // =
+			nil,       // @template
+			nil,       // (
+			nil,       // )
+			nil,       // @use
+			nil,       // @namespace
+			nil,       // !
+			nil,       // @graph
+			nil,       // @defaults
+			nil,       // @edge_defaults
+			nil,       // numeric_literal
+			nil,       // raw_string
+			nil,       // param_ref
+		},
+	},
+	actionRow{ // S103
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			nil,       // ␚
+			nil,       // empty
+			nil,       // ;
+			nil,       // id
+			nil,       // dotted_id
+			nil,       // quoted_string
+			nil,       // ns_prefix
+			nil,       // [
+			nil,       // ]
+			nil,       // _
+			nil,       // ,
+			nil,       // subgraph
+			nil,       // include
+			reduce(8), // edgearrow, reduce: NodeId
+			reduce(8), // edgeline, reduce: NodeId
+			reduce(8), // edgebiarrow, reduce: NodeId
+			reduce(8), // edgebackarrow, reduce: NodeId
+			reduce(8), // edge_attr_open, reduce: NodeId
+			reduce(8), // edge_attr_open_head, reduce: NodeId
+			nil,       // edge_attr_close
+			nil,       // edge_attr_close_nohead
+			nil,       // edge_key
+			nil,       // {
+			nil,       // }
+			nil,       // :
+			nil,       // @let
+			nil,       // =
+			nil,       // @template
+			nil,       // (
+			nil,       // )
+			nil,       // @use
+			nil,       // @namespace
+			nil,       // !
+			nil,       // @graph
+			nil,       // @defaults
+			nil,       // @edge_defaults
+			nil,       // numeric_literal
+			nil,       // raw_string
+			nil,       // param_ref
+		},
+	},
+	actionRow{ // S104
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			nil,       // ␚
+			nil,       // empty
+			nil,       // ;
+			nil,       // id
+			nil,       // dotted_id
+			nil,       // quoted_string
+			nil,       // ns_prefix
+			nil,       // [
+			nil,       // ]
+			nil,       // _
+			nil,       // ,
+			nil,       // subgraph
+			nil,       // include
+			reduce(9), // edgearrow, reduce: NodeId
+			reduce(9), // edgeline, reduce: NodeId
+			reduce(9), // edgebiarrow, reduce: NodeId
+			reduce(9), // edgebackarrow, reduce: NodeId
+			reduce(9), // edge_attr_open, reduce: NodeId
+			reduce(9), // edge_attr_open_head, reduce: NodeId
+			nil,       // edge_attr_close
+			nil,       // edge_attr_close_nohead
+			nil,       // edge_key
+			nil,       // {
+			nil,       // }
+			nil,       // :
+			nil,       // @let
+			nil,       // =
+			nil,       // @template
+			nil,       // (
+			nil,       // )
+			nil,       // @use
+			nil,       // @namespace
+			nil,       // !
+			nil,       // @graph
+			nil,       // @defaults
+			nil,       // @edge_defaults
+			nil,       // numeric_literal
+			nil,       // raw_string
+			nil,       // param_ref
+		},
+	},
+	actionRow{ // S105
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // id
+			nil,        // dotted_id
+			shift(163), // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			nil,        // _
+			nil,        // ,
+			nil,        // subgraph
+			nil,        // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S106
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(20), // ;, reduce: Word
+			reduce(20), // id, reduce: Word
+			reduce(20), // dotted_id, reduce: Word
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			reduce(20), // ], reduce: Word
+			reduce(20), // _, reduce: Word
+			reduce(20), // ,, reduce: Word
+			reduce(20), // subgraph, reduce: Word
+			reduce(20), // include, reduce: Word
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			reduce(20), // =, reduce: Word
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			reduce(20), // !, reduce: Word
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S107
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // id
+			nil,        // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			nil,        // _
+			nil,        // ,
+			nil,        // subgraph
+			nil,        // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			reduce(90), // =, reduce: AttrKey
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S108
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			shift(155), // id
+			shift(107), // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			shift(164), // ]
+			shift(157), // _
+			nil,        // ,
+			shift(159), // subgraph
+			shift(160), // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			shift(115), // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S109
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(14), // ␚, reduce: NodeDecl
+			nil,        // empty
+			reduce(14), // ;, reduce: NodeDecl
+			reduce(14), // id, reduce: NodeDecl
+			reduce(14), // dotted_id, reduce: NodeDecl
+			reduce(14), // quoted_string, reduce: NodeDecl
+			reduce(14), // ns_prefix, reduce: NodeDecl
+			nil,        // [
+			nil,        // ]
+			reduce(14), // _, reduce: NodeDecl
+			nil,        // ,
+			reduce(14), // subgraph, reduce: NodeDecl
+			reduce(14), // include, reduce: NodeDecl
+			reduce(14), // edgearrow, reduce: NodeDecl
+			reduce(14), // edgeline, reduce: NodeDecl
+			reduce(14), // edgebiarrow, reduce: NodeDecl
+			reduce(14), // edgebackarrow, reduce: NodeDecl
+			reduce(14), // edge_attr_open, reduce: NodeDecl
+			reduce(14), // edge_attr_open_head, reduce: NodeDecl
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(14), // {, reduce: NodeDecl
+			nil,        // }
+			nil,        // :
+			reduce(14), // @let, reduce: NodeDecl
+			nil,        // =
+			reduce(14), // @template, reduce: NodeDecl
+			nil,        // (
+			nil,        // )
+			reduce(14), // @use, reduce: NodeDecl
+			reduce(14), // @namespace, reduce: NodeDecl
+			reduce(14), // !, reduce: NodeDecl
+			reduce(14), // @graph, reduce: NodeDecl
+			reduce(14), // @defaults, reduce: NodeDecl
+			reduce(14), // @edge_defaults, reduce: NodeDecl
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S110
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			shift(167), // ;
+			reduce(5),  // id, reduce: OptSep
+			reduce(5),  // dotted_id, reduce: OptSep
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			reduce(5),  // ], reduce: OptSep
+			reduce(5),  // _, reduce: OptSep
+			shift(168), // ,
+			reduce(5),  // subgraph, reduce: OptSep
+			reduce(5),  // include, reduce: OptSep
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			reduce(5),  // !, reduce: OptSep
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S111
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(23), // ;, reduce: Word
+			reduce(23), // id, reduce: Word
+			reduce(23), // dotted_id, reduce: Word
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			reduce(23), // ], reduce: Word
+			reduce(23), // _, reduce: Word
+			reduce(23), // ,, reduce: Word
+			reduce(23), // subgraph, reduce: Word
+			reduce(23), // include, reduce: Word
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			reduce(23), // =, reduce: Word
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			reduce(23), // !, reduce: Word
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S112
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(18), // ;, reduce: TypeList
+			reduce(18), // id, reduce: TypeList
+			reduce(18), // dotted_id, reduce: TypeList
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			reduce(18), // ], reduce: TypeList
+			reduce(18), // _, reduce: TypeList
+			reduce(18), // ,, reduce: TypeList
+			reduce(18), // subgraph, reduce: TypeList
+			reduce(18), // include, reduce: TypeList
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			reduce(89), // =, reduce: AttrKey
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			reduce(18), // !, reduce: TypeList
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S113
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(21), // ;, reduce: Word
+			reduce(21), // id, reduce: Word
+			reduce(21), // dotted_id, reduce: Word
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			reduce(21), // ], reduce: Word
+			reduce(21), // _, reduce: Word
+			reduce(21), // ,, reduce: Word
+			reduce(21), // subgraph, reduce: Word
+			reduce(21), // include, reduce: Word
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			reduce(21), // =, reduce: Word
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			reduce(21), // !, reduce: Word
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S114
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(22), // ;, reduce: Word
+			reduce(22), // id, reduce: Word
+			reduce(22), // dotted_id, reduce: Word
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			reduce(22), // ], reduce: Word
+			reduce(22), // _, reduce: Word
+			reduce(22), // ,, reduce: Word
+			reduce(22), // subgraph, reduce: Word
+			reduce(22), // include, reduce: Word
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			reduce(22), // =, reduce: Word
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			reduce(22), // !, reduce: Word
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S115
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			shift(169), // id
+			shift(170), // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			shift(171), // _
+			nil,        // ,
+			shift(173), // subgraph
+			shift(174), // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S116
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			reduce(83), // id, reduce: AttrItems
+			reduce(83), // dotted_id, reduce: AttrItems
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			reduce(83), // ], reduce: AttrItems
+			reduce(83), // _, reduce: AttrItems
+			nil,        // ,
+			reduce(83), // subgraph, reduce: AttrItems
+			reduce(83), // include, reduce: AttrItems
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			reduce(83), // !, reduce: AttrItems
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S117
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // id
+			nil,        // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			nil,        // _
+			nil,        // ,
+			nil,        // subgraph
+			nil,        // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			shift(176), // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S118
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			shift(177), // id
+			shift(107), // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			shift(179), // ]
+			shift(180), // _
+			nil,        // ,
+			shift(182), // subgraph
+			shift(183), // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			shift(115), // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S119
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			shift(187), // id
+			shift(188), // dotted_id
+			shift(189), // quoted_string
+			shift(190), // ns_prefix
+			nil,        // [
+			nil,        // ]
+			shift(193), // _
+			nil,        // ,
+			shift(194), // subgraph
+			shift(195), // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			shift(16),  // {
+			shift(197), // }
+			nil,        // :
+			shift(208), // @let
+			nil,        // =
+			shift(209), // @template
+			nil,        // (
+			nil,        // )
+			shift(210), // @use
+			shift(211), // @namespace
+			shift(212), // !
+			shift(213), // @graph
+			shift(214), // @defaults
+			shift(215), // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S120
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(76), // ␚, reduce: GroupDecl
+			nil,        // empty
+			reduce(76), // ;, reduce: GroupDecl
+			reduce(76), // id, reduce: GroupDecl
+			reduce(76), // dotted_id, reduce: GroupDecl
+			reduce(76), // quoted_string, reduce: GroupDecl
+			reduce(76), // ns_prefix, reduce: GroupDecl
+			nil,        // [
+			nil,        // ]
+			reduce(76), // _, reduce: GroupDecl
+			nil,        // ,
+			reduce(76), // subgraph, reduce: GroupDecl
+			reduce(76), // include, reduce: GroupDecl
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(76), // {, reduce: GroupDecl
+			nil,        // }
+			nil,        // :
+			reduce(76), // @let, reduce: GroupDecl
+			nil,        // =
+			reduce(76), // @template, reduce: GroupDecl
+			nil,        // (
+			nil,        // )
+			reduce(76), // @use, reduce: GroupDecl
+			reduce(76), // @namespace, reduce: GroupDecl
+			reduce(76), // !, reduce: GroupDecl
+			reduce(76), // @graph, reduce: GroupDecl
+			reduce(76), // @defaults, reduce: GroupDecl
+			reduce(76), // @edge_defaults, reduce: GroupDecl
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S121
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // id
+			nil,        // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			reduce(10), // [, reduce: NodeId
+			nil,        // ]
+			nil,        // _
+			nil,        // ,
+			nil,        // subgraph
+			nil,        // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(10), // {, reduce: NodeId
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S122
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(16), // ␚, reduce: NodeRef
+			nil,        // empty
+			reduce(16), // ;, reduce: NodeRef
+			reduce(16), // id, reduce: NodeRef
+			reduce(16), // dotted_id, reduce: NodeRef
+			reduce(16), // quoted_string, reduce: NodeRef
+			reduce(16), // ns_prefix, reduce: NodeRef
+			reduce(16), // [, reduce: NodeRef
+			nil,        // ]
+			reduce(16), // _, reduce: NodeRef
+			nil,        // ,
+			reduce(16), // subgraph, reduce: NodeRef
+			reduce(16), // include, reduce: NodeRef
+			reduce(16), // edgearrow, reduce: NodeRef
+			reduce(16), // edgeline, reduce: NodeRef
+			reduce(16), // edgebiarrow, reduce: NodeRef
+			reduce(16), // edgebackarrow, reduce: NodeRef
+			reduce(16), // edge_attr_open, reduce: NodeRef
+			reduce(16), // edge_attr_open_head, reduce: NodeRef
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(16), // {, reduce: NodeRef
+			nil,        // }
+			shift(216), // :
+			reduce(16), // @let, reduce: NodeRef
+			nil,        // =
+			reduce(16), // @template, reduce: NodeRef
+			nil,        // (
+			nil,        // )
+			reduce(16), // @use, reduce: NodeRef
+			reduce(16), // @namespace, reduce: NodeRef
+			reduce(16), // !, reduce: NodeRef
+			reduce(16), // @graph, reduce: NodeRef
+			reduce(16), // @defaults, reduce: NodeRef
+			reduce(16), // @edge_defaults, reduce: NodeRef
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S123
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(42), // ␚, reduce: EdgeRef
+			nil,        // empty
+			reduce(42), // ;, reduce: EdgeRef
+			reduce(42), // id, reduce: EdgeRef
+			reduce(42), // dotted_id, reduce: EdgeRef
+			reduce(42), // quoted_string, reduce: EdgeRef
+			reduce(42), // ns_prefix, reduce: EdgeRef
+			nil,        // [
+			nil,        // ]
+			reduce(42), // _, reduce: EdgeRef
+			nil,        // ,
+			reduce(42), // subgraph, reduce: EdgeRef
+			reduce(42), // include, reduce: EdgeRef
+			reduce(42), // edgearrow, reduce: EdgeRef
+			reduce(42), // edgeline, reduce: EdgeRef
+			reduce(42), // edgebiarrow, reduce: EdgeRef
+			reduce(42), // edgebackarrow, reduce: EdgeRef
+			reduce(42), // edge_attr_open, reduce: EdgeRef
+			reduce(42), // edge_attr_open_head, reduce: EdgeRef
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(42), // {, reduce: EdgeRef
+			nil,        // }
+			nil,        // :
+			reduce(42), // @let, reduce: EdgeRef
+			nil,        // =
+			reduce(42), // @template, reduce: EdgeRef
+			nil,        // (
+			nil,        // )
+			reduce(42), // @use, reduce: EdgeRef
+			reduce(42), // @namespace, reduce: EdgeRef
+			reduce(42), // !, reduce: EdgeRef
+			reduce(42), // @graph, reduce: EdgeRef
+			reduce(42), // @defaults, reduce: EdgeRef
+			reduce(42), // @edge_defaults, reduce: EdgeRef
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S124
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(35), // ␚, reduce: EdgeRHS
+			nil,        // empty
+			reduce(35), // ;, reduce: EdgeRHS
+			reduce(35), // id, reduce: EdgeRHS
+			reduce(35), // dotted_id, reduce: EdgeRHS
+			reduce(35), // quoted_string, reduce: EdgeRHS
+			reduce(35), // ns_prefix, reduce: EdgeRHS
+			nil,        // [
+			nil,        // ]
+			reduce(35), // _, reduce: EdgeRHS
+			nil,        // ,
+			reduce(35), // subgraph, reduce: EdgeRHS
+			reduce(35), // include, reduce: EdgeRHS
+			reduce(35), // edgearrow, reduce: EdgeRHS
+			reduce(35), // edgeline, reduce: EdgeRHS
+			reduce(35), // edgebiarrow, reduce: EdgeRHS
+			reduce(35), // edgebackarrow, reduce: EdgeRHS
+			reduce(35), // edge_attr_open, reduce: EdgeRHS
+			reduce(35), // edge_attr_open_head, reduce: EdgeRHS
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(35), // {, reduce: EdgeRHS
+			nil,        // }
+			nil,        // :
+			reduce(35), // @let, reduce: EdgeRHS
+			nil,        // =
+			reduce(35), // @template, reduce: EdgeRHS
+			nil,        // (
+			nil,        // )
+			reduce(35), // @use, reduce: EdgeRHS
+			reduce(35), // @namespace, reduce: EdgeRHS
+			reduce(35), // !, reduce: EdgeRHS
+			reduce(35), // @graph, reduce: EdgeRHS
+			reduce(35), // @defaults, reduce: EdgeRHS
+			reduce(35), // @edge_defaults, reduce: EdgeRHS
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S125
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(40), // ␚, reduce: EdgeEnd
+			nil,        // empty
+			reduce(40), // ;, reduce: EdgeEnd
+			reduce(40), // id, reduce: EdgeEnd
+			reduce(40), // dotted_id, reduce: EdgeEnd
+			reduce(40), // quoted_string, reduce: EdgeEnd
+			reduce(40), // ns_prefix, reduce: EdgeEnd
+			nil,        // [
+			nil,        // ]
+			reduce(40), // _, reduce: EdgeEnd
+			nil,        // ,
+			reduce(40), // subgraph, reduce: EdgeEnd
+			reduce(40), // include, reduce: EdgeEnd
+			reduce(40), // edgearrow, reduce: EdgeEnd
+			reduce(40), // edgeline, reduce: EdgeEnd
+			reduce(40), // edgebiarrow, reduce: EdgeEnd
+			reduce(40), // edgebackarrow, reduce: EdgeEnd
+			reduce(40), // edge_attr_open, reduce: EdgeEnd
+			reduce(40), // edge_attr_open_head, reduce: EdgeEnd
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(40), // {, reduce: EdgeEnd
+			nil,        // }
+			nil,        // :
+			reduce(40), // @let, reduce: EdgeEnd
+			nil,        // =
+			reduce(40), // @template, reduce: EdgeEnd
+			nil,        // (
+			nil,        // )
+			reduce(40), // @use, reduce: EdgeEnd
+			reduce(40), // @namespace, reduce: EdgeEnd
+			reduce(40), // !, reduce: EdgeEnd
+			reduce(40), // @graph, reduce: EdgeEnd
+			reduce(40), // @defaults, reduce: EdgeEnd
+			reduce(40), // @edge_defaults, reduce: EdgeEnd
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S126
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			nil,       // ␚
+			nil,       // empty
+			nil,       // ;
+			shift(57), // id
+			shift(58), // dotted_id
+			shift(59), // quoted_string
+			shift(60), // ns_prefix
+			nil,       // [
+			nil,       // ]
+			shift(63), // _
+			nil,       // ,
+			nil,       // subgraph
+			nil,       // include
+			nil,       // edgearrow
+			nil,       // edgeline
+			nil,       // edgebiarrow
+			nil,       // edgebackarrow
+			nil,       // edge_attr_open
+			nil,       // edge_attr_open_head
+			nil,       // edge_attr_close
+			nil,       // edge_attr_close_nohead
+			nil,       // edge_key
+			nil,       // {
+			nil,       // }
+			nil,       // :
+			nil,       // @let
+			nil,       // =
+			nil,       // @template
+			nil,       // (
+			nil,       // )
+			nil,       // @use
+			nil,       // @namespace
+			nil,       // !
+			nil,       // @graph
+			nil,       // @defaults
+			nil,       // @edge_defaults
+			nil,       // numeric_literal
+			nil,       // raw_string
+			nil,       // param_ref
+		},
+	},
+	actionRow{ // S127
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(20), // ;, reduce: Word
+			reduce(20), // id, reduce: Word
+			reduce(20), // dotted_id, reduce: Word
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			reduce(20), // _, reduce: Word
+			nil,        // ,
+			reduce(20), // subgraph, reduce: Word
+			reduce(20), // include, reduce: Word
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			reduce(20), // edge_attr_close, reduce: Word
+			reduce(20), // edge_attr_close_nohead, reduce: Word
+			reduce(20), // edge_key, reduce: Word
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			reduce(20), // =, reduce: Word
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			reduce(20), // !, reduce: Word
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S128
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			shift(155), // id
+			shift(107), // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			shift(157), // _
+			nil,        // ,
+			shift(159), // subgraph
+			shift(160), // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			shift(134), // edge_attr_close
+			shift(135), // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			shift(138), // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S129
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(23), // ;, reduce: Word
+			reduce(23), // id, reduce: Word
+			reduce(23), // dotted_id, reduce: Word
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			reduce(23), // _, reduce: Word
+			nil,        // ,
+			reduce(23), // subgraph, reduce: Word
+			reduce(23), // include, reduce: Word
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			reduce(23), // edge_attr_close, reduce: Word
+			reduce(23), // edge_attr_close_nohead, reduce: Word
+			reduce(23), // edge_key, reduce: Word
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			reduce(23), // =, reduce: Word
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			reduce(23), // !, reduce: Word
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S130
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(32), // ;, reduce: EdgeType
+			reduce(32), // id, reduce: EdgeType
+			reduce(32), // dotted_id, reduce: EdgeType
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			reduce(32), // _, reduce: EdgeType
+			nil,        // ,
+			reduce(32), // subgraph, reduce: EdgeType
+			reduce(32), // include, reduce: EdgeType
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			reduce(32), // edge_attr_close, reduce: EdgeType
+			reduce(32), // edge_attr_close_nohead, reduce: EdgeType
+			shift(220), // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			reduce(89), // =, reduce: AttrKey
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			reduce(32), // !, reduce: EdgeType
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S131
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(21), // ;, reduce: Word
+			reduce(21), // id, reduce: Word
+			reduce(21), // dotted_id, reduce: Word
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			reduce(21), // _, reduce: Word
+			nil,        // ,
+			reduce(21), // subgraph, reduce: Word
+			reduce(21), // include, reduce: Word
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			reduce(21), // edge_attr_close, reduce: Word
+			reduce(21), // edge_attr_close_nohead, reduce: Word
+			reduce(21), // edge_key, reduce: Word
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			reduce(21), // =, reduce: Word
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			reduce(21), // !, reduce: Word
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S132
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(22), // ;, reduce: Word
+			reduce(22), // id, reduce: Word
+			reduce(22), // dotted_id, reduce: Word
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			reduce(22), // _, reduce: Word
+			nil,        // ,
+			reduce(22), // subgraph, reduce: Word
+			reduce(22), // include, reduce: Word
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			reduce(22), // edge_attr_close, reduce: Word
+			reduce(22), // edge_attr_close_nohead, reduce: Word
+			reduce(22), // edge_key, reduce: Word
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			reduce(22), // =, reduce: Word
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			reduce(22), // !, reduce: Word
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S133
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			shift(5),   // id
+			shift(6),   // dotted_id
+			shift(7),   // quoted_string
+			shift(8),   // ns_prefix
+			nil,        // [
+			nil,        // ]
+			shift(11),  // _
+			nil,        // ,
+			nil,        // subgraph
+			nil,        // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			shift(126), // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S134
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			reduce(30), // id, reduce: EdgeAttrClose
+			reduce(30), // dotted_id, reduce: EdgeAttrClose
+			reduce(30), // quoted_string, reduce: EdgeAttrClose
+			reduce(30), // ns_prefix, reduce: EdgeAttrClose
+			nil,        // [
+			nil,        // ]
+			reduce(30), // _, reduce: EdgeAttrClose
+			nil,        // ,
+			nil,        // subgraph
+			nil,        // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(30), // {, reduce: EdgeAttrClose
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S135
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			reduce(31), // id, reduce: EdgeAttrClose
+			reduce(31), // dotted_id, reduce: EdgeAttrClose
+			reduce(31), // quoted_string, reduce: EdgeAttrClose
+			reduce(31), // ns_prefix, reduce: EdgeAttrClose
+			nil,        // [
+			nil,        // ]
+			reduce(31), // _, reduce: EdgeAttrClose
+			nil,        // ,
+			nil,        // subgraph
+			nil,        // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(31), // {, reduce: EdgeAttrClose
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S136
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			shift(223), // ;
+			reduce(5),  // id, reduce: OptSep
+			reduce(5),  // dotted_id, reduce: OptSep
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			reduce(5),  // _, reduce: OptSep
+			nil,        // ,
+			reduce(5),  // subgraph, reduce: OptSep
+			reduce(5),  // include, reduce: OptSep
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			reduce(5),  // edge_attr_close, reduce: OptSep
+			reduce(5),  // edge_attr_close_nohead, reduce: OptSep
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			reduce(5),  // !, reduce: OptSep
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S137
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(34), // ;, reduce: EdgeType
+			reduce(34), // id, reduce: EdgeType
+			reduce(34), // dotted_id, reduce: EdgeType
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			reduce(34), // _, reduce: EdgeType
+			nil,        // ,
+			reduce(34), // subgraph, reduce: EdgeType
+			reduce(34), // include, reduce: EdgeType
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			reduce(34), // edge_attr_close, reduce: EdgeType
+			reduce(34), // edge_attr_close_nohead, reduce: EdgeType
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			reduce(34), // !, reduce: EdgeType
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S138
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			shift(224), // id
+			shift(225), // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			shift(226), // _
+			nil,        // ,
+			shift(228), // subgraph
+			shift(229), // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S139
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			reduce(83), // id, reduce: AttrItems
+			reduce(83), // dotted_id, reduce: AttrItems
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			reduce(83), // _, reduce: AttrItems
+			nil,        // ,
+			reduce(83), // subgraph, reduce: AttrItems
+			reduce(83), // include, reduce: AttrItems
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			reduce(83), // edge_attr_close, reduce: AttrItems
+			reduce(83), // edge_attr_close_nohead, reduce: AttrItems
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			reduce(83), // !, reduce: AttrItems
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S140
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // id
+			nil,        // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			nil,        // _
+			nil,        // ,
+			nil,        // subgraph
+			nil,        // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			shift(231), // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S141
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			shift(233), // id
+			shift(234), // dotted_id
+			shift(235), // quoted_string
+			shift(236), // ns_prefix
+			nil,        // [
+			nil,        // ]
+			nil,        // _
+			nil,        // ,
+			nil,        // subgraph
+			nil,        // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S142
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			reduce(10), // id, reduce: NodeId
+			reduce(10), // dotted_id, reduce: NodeId
+			reduce(10), // quoted_string, reduce: NodeId
+			reduce(10), // ns_prefix, reduce: NodeId
+			reduce(10), // [, reduce: NodeId
+			nil,        // ]
+			reduce(10), // _, reduce: NodeId
+			reduce(10), // ,, reduce: NodeId
+			nil,        // subgraph
+			nil,        // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			reduce(10), // }, reduce: NodeId
+			reduce(10), // :, reduce: NodeId
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S143
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			shift(106), // id
+			shift(107), // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			shift(238), // ]
+			shift(111), // _
+			nil,        // ,
+			shift(113), // subgraph
+			shift(114), // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			shift(115), // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S144
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			nil,       // ␚
+			nil,       // empty
+			nil,       // ;
+			shift(57), // id
+			shift(58), // dotted_id
+			shift(59), // quoted_string
+			shift(60), // ns_prefix
+			nil,       // [
+			nil,       // ]
+			shift(63), // _
+			nil,       // ,
+			nil,       // subgraph
+			nil,       // include
+			nil,       // edgearrow
+			nil,       // edgeline
+			nil,       // edgebiarrow
+			nil,       // edgebackarrow
+			nil,       // edge_attr_open
+			nil,       // edge_attr_open_head
+			nil,       // edge_attr_close
+			nil,       // edge_attr_close_nohead
+			nil,       // edge_key
+			nil,       // {
+			nil,       // }
+			nil,       // :
+			nil,       // @let
+			nil,       // =
+			nil,       // @template
+			nil,       // (
+			nil,       // )
+			nil,       // @use
+			nil,       // @namespace
+			nil,       // !
+			nil,       // @graph
+			nil,       // @defaults
+			nil,       // @edge_defaults
+			nil,       // numeric_literal
+			nil,       // raw_string
+			nil,       // param_ref
+		},
+	},
+	actionRow{ // S145
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			reduce(45), // id, reduce: EdgeRefList
+			reduce(45), // dotted_id, reduce: EdgeRefList
+			reduce(45), // quoted_string, reduce: EdgeRefList
+			reduce(45), // ns_prefix, reduce: EdgeRefList
+			nil,        // [
+			nil,        // ]
+			reduce(45), // _, reduce: EdgeRefList
+			reduce(45), // ,, reduce: EdgeRefList
+			nil,        // subgraph
+			nil,        // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			reduce(45), // }, reduce: EdgeRefList
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S146
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // id
+			nil,        // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			nil,        // _
+			nil,        // ,
+			nil,        // subgraph
+			nil,        // include
+			reduce(41), // edgearrow, reduce: EdgeEnd
+			reduce(41), // edgeline, reduce: EdgeEnd
+			reduce(41), // edgebiarrow, reduce: EdgeEnd
+			reduce(41), // edgebackarrow, reduce: EdgeEnd
+			reduce(41), // edge_attr_open, reduce: EdgeEnd
+			reduce(41), // edge_attr_open_head, reduce: EdgeEnd
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S147
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			shift(241), // id
+			nil,        // dotted_id
+			shift(242), // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			shift(243), // _
+			nil,        // ,
+			shift(245), // subgraph
+			shift(246), // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			shift(248), // numeric_literal
+			shift(249), // raw_string
+			shift(250), // param_ref
+		},
+	},
+	actionRow{ // S148
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			shift(251), // id
+			nil,        // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			nil,        // _
+			nil,        // ,
+			nil,        // subgraph
+			nil,        // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			shift(252), // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S149
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			shift(254), // id
+			nil,        // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			nil,        // _
+			nil,        // ,
+			nil,        // subgraph
+			nil,        // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S150
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			shift(155), // id
+			shift(107), // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			shift(157), // _
+			nil,        // ,
+			shift(159), // subgraph
+			shift(160), // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			shift(256), // )
+			nil,        // @use
+			nil,        // @namespace
+			shift(257), // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S151
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // id
+			nil,        // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			nil,        // _
+			nil,        // ,
+			nil,        // subgraph
+			nil,        // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			reduce(10), // =, reduce: NodeId
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S152
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(69), // ␚, reduce: NamespaceDecl
+			nil,        // empty
+			reduce(69), // ;, reduce: NamespaceDecl
+			reduce(69), // id, reduce: NamespaceDecl
+			reduce(69), // dotted_id, reduce: NamespaceDecl
+			reduce(69), // quoted_string, reduce: NamespaceDecl
+			reduce(69), // ns_prefix, reduce: NamespaceDecl
+			nil,        // [
+			nil,        // ]
+			reduce(69), // _, reduce: NamespaceDecl
+			nil,        // ,
+			reduce(69), // subgraph, reduce: NamespaceDecl
+			reduce(69), // include, reduce: NamespaceDecl
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(69), // {, reduce: NamespaceDecl
+			nil,        // }
+			nil,        // :
+			reduce(69), // @let, reduce: NamespaceDecl
+			nil,        // =
+			reduce(69), // @template, reduce: NamespaceDecl
+			nil,        // (
+			nil,        // )
+			reduce(69), // @use, reduce: NamespaceDecl
+			reduce(69), // @namespace, reduce: NamespaceDecl
+			reduce(69), // !, reduce: NamespaceDecl
+			reduce(69), // @graph, reduce: NamespaceDecl
+			reduce(69), // @defaults, reduce: NamespaceDecl
+			reduce(69), // @edge_defaults, reduce: NamespaceDecl
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S153
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // id
+			nil,        // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			nil,        // _
+			nil,        // ,
+			nil,        // subgraph
+			nil,        // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(10), // {, reduce: NodeId
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S154
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			shift(106), // id
+			shift(107), // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			shift(261), // ]
+			shift(111), // _
+			nil,        // ,
+			shift(113), // subgraph
+			shift(114), // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			shift(115), // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S155
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // id
+			nil,        // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			nil,        // _
+			nil,        // ,
+			nil,        // subgraph
+			nil,        // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			reduce(20), // =, reduce: Word
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S156
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			shift(155), // id
+			shift(107), // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			shift(263), // ]
+			shift(157), // _
+			nil,        // ,
+			shift(159), // subgraph
+			shift(160), // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			shift(115), // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S157
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // id
+			nil,        // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			nil,        // _
+			nil,        // ,
+			nil,        // subgraph
+			nil,        // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			reduce(23), // =, reduce: Word
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S158
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // id
+			nil,        // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			nil,        // _
+			nil,        // ,
+			nil,        // subgraph
+			nil,        // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			reduce(89), // =, reduce: AttrKey
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S159
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // id
+			nil,        // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			nil,        // _
+			nil,        // ,
+			nil,        // subgraph
+			nil,        // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			reduce(21), // =, reduce: Word
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S160
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // id
+			nil,        // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			nil,        // _
+			nil,        // ,
+			nil,        // subgraph
+			nil,        // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			reduce(22), // =, reduce: Word
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S161
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			shift(155), // id
+			shift(107), // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			shift(157), // _
+			nil,        // ,
+			shift(159), // subgraph
+			shift(160), // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			shift(115), // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S162
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			shift(155), // id
+			shift(107), // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			shift(157), // _
+			nil,        // ,
+			shift(159), // subgraph
+			shift(160), // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			shift(115), // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S163
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // id
+			nil,        // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			nil,        // _
+			nil,        // ,
+			nil,        // subgraph
+			nil,        // include
+			reduce(10), // edgearrow, reduce: NodeId
+			reduce(10), // edgeline, reduce: NodeId
+			reduce(10), // edgebiarrow, reduce: NodeId
+			reduce(10), // edgebackarrow, reduce: NodeId
+			reduce(10), // edge_attr_open, reduce: NodeId
+			reduce(10), // edge_attr_open_head, reduce: NodeId
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S164
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(11), // ␚, reduce: NodeDecl
+			nil,        // empty
+			reduce(11), // ;, reduce: NodeDecl
+			reduce(11), // id, reduce: NodeDecl
+			reduce(11), // dotted_id, reduce: NodeDecl
+			reduce(11), // quoted_string, reduce: NodeDecl
+			reduce(11), // ns_prefix, reduce: NodeDecl
+			nil,        // [
+			nil,        // ]
+			reduce(11), // _, reduce: NodeDecl
+			nil,        // ,
+			reduce(11), // subgraph, reduce: NodeDecl
+			reduce(11), // include, reduce: NodeDecl
+			reduce(11), // edgearrow, reduce: NodeDecl
+			reduce(11), // edgeline, reduce: NodeDecl
+			reduce(11), // edgebiarrow, reduce: NodeDecl
+			reduce(11), // edgebackarrow, reduce: NodeDecl
+			reduce(11), // edge_attr_open, reduce: NodeDecl
+			reduce(11), // edge_attr_open_head, reduce: NodeDecl
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(11), // {, reduce: NodeDecl
+			nil,        // }
+			nil,        // :
+			reduce(11), // @let, reduce: NodeDecl
+			nil,        // =
+			reduce(11), // @template, reduce: NodeDecl
+			nil,        // (
+			nil,        // )
+			reduce(11), // @use, reduce: NodeDecl
+			reduce(11), // @namespace, reduce: NodeDecl
+			reduce(11), // !, reduce: NodeDecl
+			reduce(11), // @graph, reduce: NodeDecl
+			reduce(11), // @defaults, reduce: NodeDecl
+			reduce(11), // @edge_defaults, reduce: NodeDecl
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S165
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			reduce(84), // id, reduce: AttrItems
+			reduce(84), // dotted_id, reduce: AttrItems
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			reduce(84), // ], reduce: AttrItems
+			reduce(84), // _, reduce: AttrItems
+			nil,        // ,
+			reduce(84), // subgraph, reduce: AttrItems
+			reduce(84), // include, reduce: AttrItems
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			reduce(84), // !, reduce: AttrItems
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S166
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			shift(155), // id
+			shift(107), // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			shift(267), // ]
+			shift(157), // _
+			nil,        // ,
+			shift(159), // subgraph
+			shift(160), // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			shift(115), // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S167
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			nil,       // ␚
+			nil,       // empty
+			nil,       // ;
+			reduce(6), // id, reduce: OptSep
+			reduce(6), // dotted_id, reduce: OptSep
+			nil,       // quoted_string
+			nil,       // ns_prefix
+			nil,       // [
+			reduce(6), // ], reduce: OptSep
+			reduce(6), // _, reduce: OptSep
+			nil,       // ,
+			reduce(6), // subgraph, reduce: OptSep
+			reduce(6), // include, reduce: OptSep
+			nil,       // edgearrow
+			nil,       // edgeline
+			nil,       // edgebiarrow
+			nil,       // edgebackarrow
+			nil,       // edge_attr_open
+			nil,       // edge_attr_open_head
+			nil,       // edge_attr_close
+			nil,       // edge_attr_close_nohead
+			nil,       // edge_key
+			nil,       // {
+			nil,       // }
+			nil,       // :
+			nil,       // @let
+			nil,       // =
+			nil,       // @template
+			nil,       // (
+			nil,       // )
+			nil,       // @use
+			nil,       // @namespace
+			reduce(6), // !, reduce: OptSep
+			nil,       // @graph
+			nil,       // @defaults
+			nil,       // @edge_defaults
+			nil,       // numeric_literal
+			nil,       // raw_string
+			nil,       // param_ref
+		},
+	},
+	actionRow{ // S168
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			shift(268), // id
+			nil,        // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			shift(269), // _
+			nil,        // ,
+			shift(271), // subgraph
+			shift(272), // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S169
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			reduce(20), // id, reduce: Word
+			reduce(20), // dotted_id, reduce: Word
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			reduce(20), // ], reduce: Word
+			reduce(20), // _, reduce: Word
+			reduce(20), // ,, reduce: Word
+			reduce(20), // subgraph, reduce: Word
+			reduce(20), // include, reduce: Word
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			reduce(20), // !, reduce: Word
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S170
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			reduce(90), // id, reduce: AttrKey
+			reduce(90), // dotted_id, reduce: AttrKey
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			reduce(90), // ], reduce: AttrKey
+			reduce(90), // _, reduce: AttrKey
+			reduce(90), // ,, reduce: AttrKey
+			reduce(90), // subgraph, reduce: AttrKey
+			reduce(90), // include, reduce: AttrKey
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			reduce(90), // !, reduce: AttrKey
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S171
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			reduce(23), // id, reduce: Word
+			reduce(23), // dotted_id, reduce: Word
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			reduce(23), // ], reduce: Word
+			reduce(23), // _, reduce: Word
+			reduce(23), // ,, reduce: Word
+			reduce(23), // subgraph, reduce: Word
+			reduce(23), // include, reduce: Word
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			reduce(23), // !, reduce: Word
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S172
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			reduce(89), // id, reduce: AttrKey
+			reduce(89), // dotted_id, reduce: AttrKey
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			reduce(89), // ], reduce: AttrKey
+			reduce(89), // _, reduce: AttrKey
+			reduce(89), // ,, reduce: AttrKey
+			reduce(89), // subgraph, reduce: AttrKey
+			reduce(89), // include, reduce: AttrKey
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			reduce(89), // !, reduce: AttrKey
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S173
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			reduce(21), // id, reduce: Word
+			reduce(21), // dotted_id, reduce: Word
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			reduce(21), // ], reduce: Word
+			reduce(21), // _, reduce: Word
+			reduce(21), // ,, reduce: Word
+			reduce(21), // subgraph, reduce: Word
+			reduce(21), // include, reduce: Word
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			reduce(21), // !, reduce: Word
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S174
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			reduce(22), // id, reduce: Word
+			reduce(22), // dotted_id, reduce: Word
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			reduce(22), // ], reduce: Word
+			reduce(22), // _, reduce: Word
+			reduce(22), // ,, reduce: Word
+			reduce(22), // subgraph, reduce: Word
+			reduce(22), // include, reduce: Word
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			reduce(22), // !, reduce: Word
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S175
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			reduce(85), // id, reduce: OptAttrSep
+			reduce(85), // dotted_id, reduce: OptAttrSep
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			reduce(85), // ], reduce: OptAttrSep
+			reduce(85), // _, reduce: OptAttrSep
+			shift(273), // ,
+			reduce(85), // subgraph, reduce: OptAttrSep
+			reduce(85), // include, reduce: OptAttrSep
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			reduce(85), // !, reduce: OptAttrSep
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S176
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			shift(169), // id
+			nil,        // dotted_id
+			shift(275), // quoted_string
+			nil,        // ns_prefix
+			shift(276), // [
+			nil,        // ]
+			shift(171), // _
+			nil,        // ,
+			shift(173), // subgraph
+			shift(174), // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			shift(280), // numeric_literal
+			shift(281), // raw_string
+			shift(282), // param_ref
+		},
+	},
+	actionRow{ // S177
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(20), // ;, reduce: Word
+			reduce(20), // id, reduce: Word
+			reduce(20), // dotted_id, reduce: Word
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			reduce(20), // ], reduce: Word
+			reduce(20), // _, reduce: Word
+			nil,        // ,
+			reduce(20), // subgraph, reduce: Word
+			reduce(20), // include, reduce: Word
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			reduce(20), // =, reduce: Word
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			reduce(20), // !, reduce: Word
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S178
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			shift(155), // id
+			shift(107), // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			shift(283), // ]
+			shift(157), // _
+			nil,        // ,
+			shift(159), // subgraph
+			shift(160), // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			shift(115), // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S179
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // id
+			nil,        // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			nil,        // _
+			nil,        // ,
+			nil,        // subgraph
+			nil,        // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			shift(119), // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S180
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(23), // ;, reduce: Word
+			reduce(23), // id, reduce: Word
+			reduce(23), // dotted_id, reduce: Word
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			reduce(23), // ], reduce: Word
+			reduce(23), // _, reduce: Word
+			nil,        // ,
+			reduce(23), // subgraph, reduce: Word
+			reduce(23), // include, reduce: Word
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			reduce(23), // =, reduce: Word
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			reduce(23), // !, reduce: Word
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S181
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			shift(167), // ;
+			reduce(5),  // id, reduce: OptSep
+			reduce(5),  // dotted_id, reduce: OptSep
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			reduce(5),  // ], reduce: OptSep
+			reduce(5),  // _, reduce: OptSep
+			nil,        // ,
+			reduce(5),  // subgraph, reduce: OptSep
+			reduce(5),  // include, reduce: OptSep
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			reduce(89), // =, reduce: AttrKey
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			reduce(5),  // !, reduce: OptSep
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S182
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(21), // ;, reduce: Word
+			reduce(21), // id, reduce: Word
+			reduce(21), // dotted_id, reduce: Word
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			reduce(21), // ], reduce: Word
+			reduce(21), // _, reduce: Word
+			nil,        // ,
+			reduce(21), // subgraph, reduce: Word
+			reduce(21), // include, reduce: Word
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			reduce(21), // =, reduce: Word
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			reduce(21), // !, reduce: Word
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S183
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(22), // ;, reduce: Word
+			reduce(22), // id, reduce: Word
+			reduce(22), // dotted_id, reduce: Word
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			reduce(22), // ], reduce: Word
+			reduce(22), // _, reduce: Word
+			nil,        // ,
+			reduce(22), // subgraph, reduce: Word
+			reduce(22), // include, reduce: Word
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			reduce(22), // =, reduce: Word
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			reduce(22), // !, reduce: Word
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S184
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			shift(187), // id
+			shift(188), // dotted_id
+			shift(189), // quoted_string
+			shift(190), // ns_prefix
+			nil,        // [
+			nil,        // ]
+			shift(193), // _
+			nil,        // ,
+			shift(194), // subgraph
+			shift(195), // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			shift(16),  // {
+			shift(287), // }
+			nil,        // :
+			shift(208), // @let
+			nil,        // =
+			shift(209), // @template
+			nil,        // (
+			nil,        // )
+			shift(210), // @use
+			shift(211), // @namespace
+			shift(212), // !
+			shift(213), // @graph
+			shift(214), // @defaults
+			shift(215), // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S185
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			nil,       // ␚
+			nil,       // empty
+			nil,       // ;
+			reduce(3), // id, reduce: TopLevelDeclList
+			reduce(3), // dotted_id, reduce: TopLevelDeclList
+			reduce(3), // quoted_string, reduce: TopLevelDeclList
+			reduce(3), // ns_prefix, reduce: TopLevelDeclList
+			nil,       // [
+			nil,       // ]
+			reduce(3), // _, reduce: TopLevelDeclList
+			nil,       // ,
+			reduce(3), // subgraph, reduce: TopLevelDeclList
+			reduce(3), // include, reduce: TopLevelDeclList
+			nil,       // edgearrow
+			nil,       // edgeline
+			nil,       // edgebiarrow
+			nil,       // edgebackarrow
+			nil,       // edge_attr_open
+			nil,       // edge_attr_open_head
+			nil,       // edge_attr_close
+			nil,       // edge_attr_close_nohead
+			nil,       // edge_key
+			reduce(3), // {, reduce: TopLevelDeclList
+			reduce(3), // }, reduce: TopLevelDeclList
+			nil,       // :
+			reduce(3), // @let, reduce: TopLevelDeclList
+			nil,       // =
+			reduce(3), // @template, reduce: TopLevelDeclList
+			nil,       // (
+			nil,       // )
+			reduce(3), // @use, reduce: TopLevelDeclList
+			reduce(3), // @namespace, reduce: TopLevelDeclList
+			reduce(3), // !, reduce: TopLevelDeclList
+			reduce(3), // @graph, reduce: TopLevelDeclList
+			reduce(3), // @defaults, reduce: TopLevelDeclList
+			reduce(3), // @edge_defaults, reduce: TopLevelDeclList
+			nil,       // numeric_literal
+			nil,       // raw_string
+			nil,       // param_ref
+		},
+	},
+	actionRow{ // S186
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(16), // ;, reduce: NodeRef
+			reduce(16), // id, reduce: NodeRef
+			reduce(16), // dotted_id, reduce: NodeRef
+			reduce(16), // quoted_string, reduce: NodeRef
+			reduce(16), // ns_prefix, reduce: NodeRef
+			reduce(16), // [, reduce: NodeRef
+			nil,        // ]
+			reduce(16), // _, reduce: NodeRef
+			nil,        // ,
+			reduce(16), // subgraph, reduce: NodeRef
+			reduce(16), // include, reduce: NodeRef
+			reduce(16), // edgearrow, reduce: NodeRef
+			reduce(16), // edgeline, reduce: NodeRef
+			reduce(16), // edgebiarrow, reduce: NodeRef
+			reduce(16), // edgebackarrow, reduce: NodeRef
+			reduce(16), // edge_attr_open, reduce: NodeRef
+			reduce(16), // edge_attr_open_head, reduce: NodeRef
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(16), // {, reduce: NodeRef
+			reduce(16), // }, reduce: NodeRef
+			shift(36),  // :
+			reduce(16), // @let, reduce: NodeRef
+			nil,        // =
+			reduce(16), // @template, reduce: NodeRef
+			nil,        // (
+			nil,        // )
+			reduce(16), // @use, reduce: NodeRef
+			reduce(16), // @namespace, reduce: NodeRef
+			reduce(16), // !, reduce: NodeRef
+			reduce(16), // @graph, reduce: NodeRef
+			reduce(16), // @defaults, reduce: NodeRef
+			reduce(16), // @edge_defaults, reduce: NodeRef
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S187
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			nil,       // ␚
+			nil,       // empty
+			reduce(7), // ;, reduce: NodeId
+			reduce(7), // id, reduce: NodeId
+			reduce(7), // dotted_id, reduce: NodeId
+			reduce(7), // quoted_string, reduce: NodeId
+			reduce(7), // ns_prefix, reduce: NodeId
+			reduce(7), // [, reduce: NodeId
+			nil,       // ]
+			reduce(7), // _, reduce: NodeId
+			nil,       // ,
+			reduce(7), // subgraph, reduce: NodeId
+			reduce(7), // include, reduce: NodeId
+			reduce(7), // edgearrow, reduce: NodeId
+			reduce(7), // edgeline, reduce: NodeId
+			reduce(7), // edgebiarrow, reduce: NodeId
+			reduce(7), // edgebackarrow, reduce: NodeId
+			reduce(7), // edge_attr_open, reduce: NodeId
+			reduce(7), // edge_attr_open_head, reduce: NodeId
+			nil,       // edge_attr_close
+			nil,       // edge_attr_close_nohead
+			nil,       // edge_key
+			reduce(7), // {, reduce: NodeId
+			reduce(7), // }, reduce: NodeId
+			reduce(7), // :, reduce: NodeId
+			reduce(7), // @let, reduce: NodeId
+			nil,       // =
+			reduce(7), // @template, reduce: NodeId
+			nil,       // (
+			nil,       // )
+			reduce(7), // @use, reduce: NodeId
+			reduce(7), // @namespace, reduce: NodeId
+			reduce(7), // !, reduce: NodeId
+			reduce(7), // @graph, reduce: NodeId
+			reduce(7), // @defaults, reduce: NodeId
+			reduce(7), // @edge_defaults, reduce: NodeId
+			nil,       // numeric_literal
+			nil,       // raw_string
+			nil,       // param_ref
+		},
+	},
+	actionRow{ // S188
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			nil,       // ␚
+			nil,       // empty
+			reduce(8), // ;, reduce: NodeId
+			reduce(8), // id, reduce: NodeId
+			reduce(8), // dotted_id, reduce: NodeId
+			reduce(8), // quoted_string, reduce: NodeId
+			reduce(8), // ns_prefix, reduce: NodeId
+			reduce(8), // [, reduce: NodeId
+			nil,       // ]
+			reduce(8), // _, reduce: NodeId
+			nil,       // ,
+			reduce(8), // subgraph, reduce: NodeId
+			reduce(8), // include, reduce: NodeId
+			reduce(8), // edgearrow, reduce: NodeId
+			reduce(8), // edgeline, reduce: NodeId
+			reduce(8), // edgebiarrow, reduce: NodeId
+			reduce(8), // edgebackarrow, reduce: NodeId
+			reduce(8), // edge_attr_open, reduce: NodeId
+			reduce(8), // edge_attr_open_head, reduce: NodeId
+			nil,       // edge_attr_close
+			nil,       // edge_attr_close_nohead
+			nil,       // edge_key
+			reduce(8), // {, reduce: NodeId
+			reduce(8), // }, reduce: NodeId
+			reduce(8), // :, reduce: NodeId
+			reduce(8), // @let, reduce: NodeId
+			nil,       // =
+			reduce(8), // @template, reduce: NodeId
+			nil,       // (
+			nil,       // )
+			reduce(8), // @use, reduce: NodeId
+			reduce(8), // @namespace, reduce: NodeId
+			reduce(8), // !, reduce: NodeId
+			reduce(8), // @graph, reduce: NodeId
+			reduce(8), // @defaults, reduce: NodeId
+			reduce(8), // @edge_defaults, reduce: NodeId
+			nil,       // numeric_literal
+			nil,       // raw_string
+			nil,       // param_ref
+		},
+	},
+	actionRow{ // S189
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			nil,       // ␚
+			nil,       // empty
+			reduce(9), // ;, reduce: NodeId
+			reduce(9), // id, reduce: NodeId
+			reduce(9), // dotted_id, reduce: NodeId
+			reduce(9), // quoted_string, reduce: NodeId
+			reduce(9), // ns_prefix, reduce: NodeId
+			reduce(9), // [, reduce: NodeId
+			nil,       // ]
+			reduce(9), // _, reduce: NodeId
+			nil,       // ,
+			reduce(9), // subgraph, reduce: NodeId
+			reduce(9), // include, reduce: NodeId
+			reduce(9), // edgearrow, reduce: NodeId
+			reduce(9), // edgeline, reduce: NodeId
+			reduce(9), // edgebiarrow, reduce: NodeId
+			reduce(9), // edgebackarrow, reduce: NodeId
+			reduce(9), // edge_attr_open, reduce: NodeId
+			reduce(9), // edge_attr_open_head, reduce: NodeId
+			nil,       // edge_attr_close
+			nil,       // edge_attr_close_nohead
+			nil,       // edge_key
+			reduce(9), // {, reduce: NodeId
+			reduce(9), // }, reduce: NodeId
+			reduce(9), // :, reduce: NodeId
+			reduce(9), // @let, reduce: NodeId
+			nil,       // =
+			reduce(9), // @template, reduce: NodeId
+			nil,       // (
+			nil,       // )
+			reduce(9), // @use, reduce: NodeId
+			reduce(9), // @namespace, reduce: NodeId
+			reduce(9), // !, reduce: NodeId
+			reduce(9), // @graph, reduce: NodeId
+			reduce(9), // @defaults, reduce: NodeId
+			reduce(9), // @edge_defaults, reduce: NodeId
+			nil,       // numeric_literal
+			nil,       // raw_string
+			nil,       // param_ref
+		},
+	},
+	actionRow{ // S190
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // id
+			nil,        // dotted_id
+			shift(288), // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			nil,        // _
+			nil,        // ,
+			nil,        // subgraph
+			nil,        // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S191
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			shift(290), // ;
+			reduce(5),  // id, reduce: OptSep
+			reduce(5),  // dotted_id, reduce: OptSep
+			reduce(5),  // quoted_string, reduce: OptSep
+			reduce(5),  // ns_prefix, reduce: OptSep
+			nil,        // [
+			nil,        // ]
+			reduce(5),  // _, reduce: OptSep
+			nil,        // ,
+			reduce(5),  // subgraph, reduce: OptSep
+			reduce(5),  // include, reduce: OptSep
+			reduce(42), // edgearrow, reduce: EdgeRef
+			reduce(42), // edgeline, reduce: EdgeRef
+			reduce(42), // edgebiarrow, reduce: EdgeRef
+			reduce(42), // edgebackarrow, reduce: EdgeRef
+			reduce(42), // edge_attr_open, reduce: EdgeRef
+			reduce(42), // edge_attr_open_head, reduce: EdgeRef
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(5),  // {, reduce: OptSep
+			reduce(5),  // }, reduce: OptSep
+			nil,        // :
+			reduce(5),  // @let, reduce: OptSep
+			nil,        // =
+			reduce(5),  // @template, reduce: OptSep
+			nil,        // (
+			nil,        // )
+			reduce(5),  // @use, reduce: OptSep
+			reduce(5),  // @namespace, reduce: OptSep
+			reduce(5),  // !, reduce: OptSep
+			reduce(5),  // @graph, reduce: OptSep
+			reduce(5),  // @defaults, reduce: OptSep
+			reduce(5),  // @edge_defaults, reduce: OptSep
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S192
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(15), // ;, reduce: NodeDecl
+			reduce(15), // id, reduce: NodeDecl
+			reduce(15), // dotted_id, reduce: NodeDecl
+			reduce(15), // quoted_string, reduce: NodeDecl
+			reduce(15), // ns_prefix, reduce: NodeDecl
+			shift(291), // [
+			nil,        // ]
+			reduce(15), // _, reduce: NodeDecl
+			nil,        // ,
+			reduce(15), // subgraph, reduce: NodeDecl
+			reduce(15), // include, reduce: NodeDecl
+			reduce(15), // edgearrow, reduce: NodeDecl
+			reduce(15), // edgeline, reduce: NodeDecl
+			reduce(15), // edgebiarrow, reduce: NodeDecl
+			reduce(15), // edgebackarrow, reduce: NodeDecl
+			reduce(15), // edge_attr_open, reduce: NodeDecl
+			reduce(15), // edge_attr_open_head, reduce: NodeDecl
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(15), // {, reduce: NodeDecl
+			reduce(15), // }, reduce: NodeDecl
+			nil,        // :
+			reduce(15), // @let, reduce: NodeDecl
+			nil,        // =
+			reduce(15), // @template, reduce: NodeDecl
+			nil,        // (
+			nil,        // )
+			reduce(15), // @use, reduce: NodeDecl
+			reduce(15), // @namespace, reduce: NodeDecl
+			reduce(15), // !, reduce: NodeDecl
+			reduce(15), // @graph, reduce: NodeDecl
+			reduce(15), // @defaults, reduce: NodeDecl
+			reduce(15), // @edge_defaults, reduce: NodeDecl
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S193
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(17), // ;, reduce: NodeRef
+			reduce(17), // id, reduce: NodeRef
+			reduce(17), // dotted_id, reduce: NodeRef
+			reduce(17), // quoted_string, reduce: NodeRef
+			reduce(17), // ns_prefix, reduce: NodeRef
+			reduce(17), // [, reduce: NodeRef
+			nil,        // ]
+			reduce(17), // _, reduce: NodeRef
+			nil,        // ,
+			reduce(17), // subgraph, reduce: NodeRef
+			reduce(17), // include, reduce: NodeRef
+			reduce(17), // edgearrow, reduce: NodeRef
+			reduce(17), // edgeline, reduce: NodeRef
+			reduce(17), // edgebiarrow, reduce: NodeRef
+			reduce(17), // edgebackarrow, reduce: NodeRef
+			reduce(17), // edge_attr_open, reduce: NodeRef
+			reduce(17), // edge_attr_open_head, reduce: NodeRef
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(17), // {, reduce: NodeRef
+			reduce(17), // }, reduce: NodeRef
+			nil,        // :
+			reduce(17), // @let, reduce: NodeRef
+			nil,        // =
+			reduce(17), // @template, reduce: NodeRef
+			nil,        // (
+			nil,        // )
+			reduce(17), // @use, reduce: NodeRef
+			reduce(17), // @namespace, reduce: NodeRef
+			reduce(17), // !, reduce: NodeRef
+			reduce(17), // @graph, reduce: NodeRef
+			reduce(17), // @defaults, reduce: NodeRef
+			reduce(17), // @edge_defaults, reduce: NodeRef
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S194
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			nil,       // ␚
+			nil,       // empty
+			nil,       // ;
+			shift(42), // id
+			shift(43), // dotted_id
+			shift(44), // quoted_string
+			shift(45), // ns_prefix
+			nil,       // [
+			nil,       // ]
+			nil,       // _
+			nil,       // ,
+			nil,       // subgraph
+			nil,       // include
+			nil,       // edgearrow
+			nil,       // edgeline
+			nil,       // edgebiarrow
+			nil,       // edgebackarrow
+			nil,       // edge_attr_open
+			nil,       // edge_attr_open_head
+			nil,       // edge_attr_close
+			nil,       // edge_attr_close_nohead
+			nil,       // edge_key
+			nil,       // {
+			nil,       // }
+			nil,       // :
+			nil,       // @let
+			nil,       // =
+			nil,       // @template
+			nil,       // (
+			nil,       // )
+			nil,       // @use
+			nil,       // @namespace
+			nil,       // !
+			nil,       // @graph
+			nil,       // @defaults
+			nil,       // @edge_defaults
+			nil,       // numeric_literal
+			nil,       // raw_string
+			nil,       // param_ref
+		},
+	},
+	actionRow{ // S195
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // id
+			nil,        // dotted_id
+			shift(293), // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			nil,        // _
+			nil,        // ,
+			nil,        // subgraph
+			nil,        // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S196
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			nil,       // ␚
+			nil,       // empty
+			nil,       // ;
+			nil,       // id
+			nil,       // dotted_id
+			nil,       // quoted_string
+			nil,       // ns_prefix
+			nil,       // [
+			nil,       // ]
+			nil,       // _
+			nil,       // ,
+			nil,       // subgraph
+			nil,       // include
+			shift(48), // edgearrow
+			shift(49), // edgeline
+			shift(50), // edgebiarrow
+			shift(51), // edgebackarrow
+			shift(53), // edge_attr_open
+			shift(54), // edge_attr_open_head
+			nil,       // edge_attr_close
+			nil,       // edge_attr_close_nohead
+			nil,       // edge_key
+			nil,       // {
+			nil,       // }
+			nil,       // :
+			nil,       // @let
+			nil,       // =
+			nil,       // @template
+			nil,       // (
+			nil,       // )
+			nil,       // @use
+			nil,       // @namespace
+			nil,       // !
+			nil,       // @graph
+			nil,       // @defaults
+			nil,       // @edge_defaults
+			nil,       // numeric_literal
+			nil,       // raw_string
+			nil,       // param_ref
+		},
+	},
+	actionRow{ // S197
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(81), // ␚, reduce: GroupBody
+			nil,        // empty
+			reduce(81), // ;, reduce: GroupBody
+			reduce(81), // id, reduce: GroupBody
+			reduce(81), // dotted_id, reduce: GroupBody
+			reduce(81), // quoted_string, reduce: GroupBody
+			reduce(81), // ns_prefix, reduce: GroupBody
+			nil,        // [
+			nil,        // ]
+			reduce(81), // _, reduce: GroupBody
+			nil,        // ,
+			reduce(81), // subgraph, reduce: GroupBody
+			reduce(81), // include, reduce: GroupBody
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(81), // {, reduce: GroupBody
+			nil,        // }
+			nil,        // :
+			reduce(81), // @let, reduce: GroupBody
+			nil,        // =
+			reduce(81), // @template, reduce: GroupBody
+			nil,        // (
+			nil,        // )
+			reduce(81), // @use, reduce: GroupBody
+			reduce(81), // @namespace, reduce: GroupBody
+			reduce(81), // !, reduce: GroupBody
+			reduce(81), // @graph, reduce: GroupBody
+			reduce(81), // @defaults, reduce: GroupBody
+			reduce(81), // @edge_defaults, reduce: GroupBody
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S198
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			shift(290), // ;
+			reduce(5),  // id, reduce: OptSep
+			reduce(5),  // dotted_id, reduce: OptSep
+			reduce(5),  // quoted_string, reduce: OptSep
+			reduce(5),  // ns_prefix, reduce: OptSep
+			nil,        // [
+			nil,        // ]
+			reduce(5),  // _, reduce: OptSep
+			nil,        // ,
+			reduce(5),  // subgraph, reduce: OptSep
+			reduce(5),  // include, reduce: OptSep
+			shift(48),  // edgearrow
+			shift(49),  // edgeline
+			shift(50),  // edgebiarrow
+			shift(51),  // edgebackarrow
+			shift(53),  // edge_attr_open
+			shift(54),  // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(5),  // {, reduce: OptSep
+			reduce(5),  // }, reduce: OptSep
+			nil,        // :
+			reduce(5),  // @let, reduce: OptSep
+			nil,        // =
+			reduce(5),  // @template, reduce: OptSep
+			nil,        // (
+			nil,        // )
+			reduce(5),  // @use, reduce: OptSep
+			reduce(5),  // @namespace, reduce: OptSep
+			reduce(5),  // !, reduce: OptSep
+			reduce(5),  // @graph, reduce: OptSep
+			reduce(5),  // @defaults, reduce: OptSep
+			reduce(5),  // @edge_defaults, reduce: OptSep
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S199
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			shift(290), // ;
+			reduce(5),  // id, reduce: OptSep
+			reduce(5),  // dotted_id, reduce: OptSep
+			reduce(5),  // quoted_string, reduce: OptSep
+			reduce(5),  // ns_prefix, reduce: OptSep
+			nil,        // [
+			nil,        // ]
+			reduce(5),  // _, reduce: OptSep
+			nil,        // ,
+			reduce(5),  // subgraph, reduce: OptSep
+			reduce(5),  // include, reduce: OptSep
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(5),  // {, reduce: OptSep
+			reduce(5),  // }, reduce: OptSep
+			nil,        // :
+			reduce(5),  // @let, reduce: OptSep
+			nil,        // =
+			reduce(5),  // @template, reduce: OptSep
+			nil,        // (
+			nil,        // )
+			reduce(5),  // @use, reduce: OptSep
+			reduce(5),  // @namespace, reduce: OptSep
+			reduce(5),  // !, reduce: OptSep
+			reduce(5),  // @graph, reduce: OptSep
+			reduce(5),  // @defaults, reduce: OptSep
+			reduce(5),  // @edge_defaults, reduce: OptSep
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S200
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			shift(290), // ;
+			reduce(5),  // id, reduce: OptSep
+			reduce(5),  // dotted_id, reduce: OptSep
+			reduce(5),  // quoted_string, reduce: OptSep
+			reduce(5),  // ns_prefix, reduce: OptSep
+			nil,        // [
+			nil,        // ]
+			reduce(5),  // _, reduce: OptSep
+			nil,        // ,
+			reduce(5),  // subgraph, reduce: OptSep
+			reduce(5),  // include, reduce: OptSep
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(5),  // {, reduce: OptSep
+			reduce(5),  // }, reduce: OptSep
+			nil,        // :
+			reduce(5),  // @let, reduce: OptSep
+			nil,        // =
+			reduce(5),  // @template, reduce: OptSep
+			nil,        // (
+			nil,        // )
+			reduce(5),  // @use, reduce: OptSep
+			reduce(5),  // @namespace, reduce: OptSep
+			reduce(5),  // !, reduce: OptSep
+			reduce(5),  // @graph, reduce: OptSep
+			reduce(5),  // @defaults, reduce: OptSep
+			reduce(5),  // @edge_defaults, reduce: OptSep
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S201
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			shift(290), // ;
+			reduce(5),  // id, reduce: OptSep
+			reduce(5),  // dotted_id, reduce: OptSep
+			reduce(5),  // quoted_string, reduce: OptSep
+			reduce(5),  // ns_prefix, reduce: OptSep
+			nil,        // [
+			nil,        // ]
+			reduce(5),  // _, reduce: OptSep
+			nil,        // ,
+			reduce(5),  // subgraph, reduce: OptSep
+			reduce(5),  // include, reduce: OptSep
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(5),  // {, reduce: OptSep
+			reduce(5),  // }, reduce: OptSep
+			nil,        // :
+			reduce(5),  // @let, reduce: OptSep
+			nil,        // =
+			reduce(5),  // @template, reduce: OptSep
+			nil,        // (
+			nil,        // )
+			reduce(5),  // @use, reduce: OptSep
+			reduce(5),  // @namespace, reduce: OptSep
+			reduce(5),  // !, reduce: OptSep
+			reduce(5),  // @graph, reduce: OptSep
+			reduce(5),  // @defaults, reduce: OptSep
+			reduce(5),  // @edge_defaults, reduce: OptSep
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S202
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			shift(290), // ;
+			reduce(5),  // id, reduce: OptSep
+			reduce(5),  // dotted_id, reduce: OptSep
+			reduce(5),  // quoted_string, reduce: OptSep
+			reduce(5),  // ns_prefix, reduce: OptSep
+			nil,        // [
+			nil,        // ]
+			reduce(5),  // _, reduce: OptSep
+			nil,        // ,
+			reduce(5),  // subgraph, reduce: OptSep
+			reduce(5),  // include, reduce: OptSep
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(5),  // {, reduce: OptSep
+			reduce(5),  // }, reduce: OptSep
+			nil,        // :
+			reduce(5),  // @let, reduce: OptSep
+			nil,        // =
+			reduce(5),  // @template, reduce: OptSep
+			nil,        // (
+			nil,        // )
+			reduce(5),  // @use, reduce: OptSep
+			reduce(5),  // @namespace, reduce: OptSep
+			reduce(5),  // !, reduce: OptSep
+			reduce(5),  // @graph, reduce: OptSep
+			reduce(5),  // @defaults, reduce: OptSep
+			reduce(5),  // @edge_defaults, reduce: OptSep
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S203
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			shift(290), // ;
+			reduce(5),  // id, reduce: OptSep
+			reduce(5),  // dotted_id, reduce: OptSep
+			reduce(5),  // quoted_string, reduce: OptSep
+			reduce(5),  // ns_prefix, reduce: OptSep
+			nil,        // [
+			nil,        // ]
+			reduce(5),  // _, reduce: OptSep
+			nil,        // ,
+			reduce(5),  // subgraph, reduce: OptSep
+			reduce(5),  // include, reduce: OptSep
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(5),  // {, reduce: OptSep
+			reduce(5),  // }, reduce: OptSep
+			nil,        // :
+			reduce(5),  // @let, reduce: OptSep
+			nil,        // =
+			reduce(5),  // @template, reduce: OptSep
+			nil,        // (
+			nil,        // )
+			reduce(5),  // @use, reduce: OptSep
+			reduce(5),  // @namespace, reduce: OptSep
+			reduce(5),  // !, reduce: OptSep
+			reduce(5),  // @graph, reduce: OptSep
+			reduce(5),  // @defaults, reduce: OptSep
+			reduce(5),  // @edge_defaults, reduce: OptSep
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S204
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			shift(290), // ;
+			reduce(5),  // id, reduce: OptSep
+			reduce(5),  // dotted_id, reduce: OptSep
+			reduce(5),  // quoted_string, reduce: OptSep
+			reduce(5),  // ns_prefix, reduce: OptSep
+			nil,        // [
+			nil,        // ]
+			reduce(5),  // _, reduce: OptSep
+			nil,        // ,
+			reduce(5),  // subgraph, reduce: OptSep
+			reduce(5),  // include, reduce: OptSep
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(5),  // {, reduce: OptSep
+			reduce(5),  // }, reduce: OptSep
+			nil,        // :
+			reduce(5),  // @let, reduce: OptSep
+			nil,        // =
+			reduce(5),  // @template, reduce: OptSep
+			nil,        // (
+			nil,        // )
+			reduce(5),  // @use, reduce: OptSep
+			reduce(5),  // @namespace, reduce: OptSep
+			reduce(5),  // !, reduce: OptSep
+			reduce(5),  // @graph, reduce: OptSep
+			reduce(5),  // @defaults, reduce: OptSep
+			reduce(5),  // @edge_defaults, reduce: OptSep
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S205
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			shift(290), // ;
+			reduce(5),  // id, reduce: OptSep
+			reduce(5),  // dotted_id, reduce: OptSep
+			reduce(5),  // quoted_string, reduce: OptSep
+			reduce(5),  // ns_prefix, reduce: OptSep
+			nil,        // [
+			nil,        // ]
+			reduce(5),  // _, reduce: OptSep
+			nil,        // ,
+			reduce(5),  // subgraph, reduce: OptSep
+			reduce(5),  // include, reduce: OptSep
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(5),  // {, reduce: OptSep
+			reduce(5),  // }, reduce: OptSep
+			nil,        // :
+			reduce(5),  // @let, reduce: OptSep
+			nil,        // =
+			reduce(5),  // @template, reduce: OptSep
+			nil,        // (
+			nil,        // )
+			reduce(5),  // @use, reduce: OptSep
+			reduce(5),  // @namespace, reduce: OptSep
+			reduce(5),  // !, reduce: OptSep
+			reduce(5),  // @graph, reduce: OptSep
+			reduce(5),  // @defaults, reduce: OptSep
+			reduce(5),  // @edge_defaults, reduce: OptSep
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S206
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			shift(290), // ;
+			reduce(5),  // id, reduce: OptSep
+			reduce(5),  // dotted_id, reduce: OptSep
+			reduce(5),  // quoted_string, reduce: OptSep
+			reduce(5),  // ns_prefix, reduce: OptSep
+			nil,        // [
+			nil,        // ]
+			reduce(5),  // _, reduce: OptSep
+			nil,        // ,
+			reduce(5),  // subgraph, reduce: OptSep
+			reduce(5),  // include, reduce: OptSep
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(5),  // {, reduce: OptSep
+			reduce(5),  // }, reduce: OptSep
+			nil,        // :
+			reduce(5),  // @let, reduce: OptSep
+			nil,        // =
+			reduce(5),  // @template, reduce: OptSep
+			nil,        // (
+			nil,        // )
+			reduce(5),  // @use, reduce: OptSep
+			reduce(5),  // @namespace, reduce: OptSep
+			reduce(5),  // !, reduce: OptSep
+			reduce(5),  // @graph, reduce: OptSep
+			reduce(5),  // @defaults, reduce: OptSep
+			reduce(5),  // @edge_defaults, reduce: OptSep
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S207
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			shift(290), // ;
+			reduce(5),  // id, reduce: OptSep
+			reduce(5),  // dotted_id, reduce: OptSep
+			reduce(5),  // quoted_string, reduce: OptSep
+			reduce(5),  // ns_prefix, reduce: OptSep
+			nil,        // [
+			nil,        // ]
+			reduce(5),  // _, reduce: OptSep
+			nil,        // ,
+			reduce(5),  // subgraph, reduce: OptSep
+			reduce(5),  // include, reduce: OptSep
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(5),  // {, reduce: OptSep
+			reduce(5),  // }, reduce: OptSep
+			nil,        // :
+			reduce(5),  // @let, reduce: OptSep
+			nil,        // =
+			reduce(5),  // @template, reduce: OptSep
+			nil,        // (
+			nil,        // )
+			reduce(5),  // @use, reduce: OptSep
+			reduce(5),  // @namespace, reduce: OptSep
+			reduce(5),  // !, reduce: OptSep
+			reduce(5),  // @graph, reduce: OptSep
+			reduce(5),  // @defaults, reduce: OptSep
+			reduce(5),  // @edge_defaults, reduce: OptSep
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S208
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			shift(308), // id
+			nil,        // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			nil,        // _
+			nil,        // ,
+			nil,        // subgraph
+			nil,        // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S209
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			shift(309), // id
+			nil,        // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			nil,        // _
+			nil,        // ,
+			nil,        // subgraph
+			nil,        // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S210
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			shift(311), // id
+			shift(81),  // dotted_id
+			shift(82),  // quoted_string
+			shift(83),  // ns_prefix
+			nil,        // [
+			nil,        // ]
+			nil,        // _
+			nil,        // ,
+			nil,        // subgraph
+			nil,        // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S211
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			nil,       // ␚
+			nil,       // empty
+			nil,       // ;
+			shift(85), // id
+			shift(86), // dotted_id
+			shift(87), // quoted_string
+			shift(88), // ns_prefix
+			nil,       // [
+			nil,       // ]
+			nil,       // _
+			nil,       // ,
+			nil,       // subgraph
+			nil,       // include
+			nil,       // edgearrow
+			nil,       // edgeline
+			nil,       // edgebiarrow
+			nil,       // edgebackarrow
+			nil,       // edge_attr_open
+			nil,       // edge_attr_open_head
+			nil,       // edge_attr_close
+			nil,       // edge_attr_close_nohead
+			nil,       // edge_key
+			nil,       // {
+			nil,       // }
+			nil,       // :
+			nil,       // @let
+			nil,       // =
+			nil,       // @template
+			nil,       // (
+			nil,       // )
+			nil,       // @use
+			nil,       // @namespace
+			nil,       // !
+			nil,       // @graph
+			nil,       // @defaults
+			nil,       // @edge_defaults
+			nil,       // numeric_literal
+			nil,       // raw_string
+			nil,       // param_ref
+		},
+	},
+	actionRow{ // S212
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			shift(187), // id
+			shift(188), // dotted_id
+			shift(189), // quoted_string
+			shift(190), // ns_prefix
+			nil,        // [
+			nil,        // ]
+			shift(92),  // _
+			nil,        // ,
+			nil,        // subgraph
+			nil,        // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			shift(16),  // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S213
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // id
+			nil,        // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			shift(315), // [
+			nil,        // ]
+			nil,        // _
+			nil,        // ,
+			nil,        // subgraph
+			nil,        // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S214
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			nil,       // ␚
+			nil,       // empty
+			nil,       // ;
+			shift(95), // id
+			nil,       // dotted_id
+			nil,       // quoted_string
+			nil,       // ns_prefix
+			nil,       // [
+			nil,       // ]
+			shift(96), // _
+			nil,       // ,
+			shift(98), // subgraph
+			shift(99), // include
+			nil,       // edgearrow
+			nil,       // edgeline
+			nil,       // edgebiarrow
+			nil,       // edgebackarrow
+			nil,       // edge_attr_open
+			nil,       // edge_attr_open_head
+			nil,       // edge_attr_close
+			nil,       // edge_attr_close_nohead
+			nil,       // edge_key
+			nil,       // {
+			nil,       // }
+			nil,       // :
+			nil,       // @let
+			nil,       // =
+			nil,       // @template
+			nil,       // (
+			nil,       // )
+			nil,       // @use
+			nil,       // @namespace
+			nil,       // !
+			nil,       // @graph
+			nil,       // @defaults
+			nil,       // @edge_defaults
+			nil,       // numeric_literal
+			nil,       // raw_string
+			nil,       // param_ref
+		},
+	},
+	actionRow{ // S215
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			nil,       // ␚
+			nil,       // empty
+			nil,       // ;
+			shift(95), // id
+			nil,       // dotted_id
+			nil,       // quoted_string
+			nil,       // ns_prefix
+			nil,       // [
+			nil,       // ]
+			shift(96), // _
+			nil,       // ,
+			shift(98), // subgraph
+			shift(99), // include
+			nil,       // edgearrow
+			nil,       // edgeline
+			nil,       // edgebiarrow
+			nil,       // edgebackarrow
+			nil,       // edge_attr_open
+			nil,       // edge_attr_open_head
+			nil,       // edge_attr_close
+			nil,       // edge_attr_close_nohead
+			nil,       // edge_key
+			nil,       // {
+			nil,       // }
+			nil,       // :
+			nil,       // @let
+			nil,       // =
+			nil,       // @template
+			nil,       // (
+			nil,       // )
+			nil,       // @use
+			nil,       // @namespace
+			nil,       // !
+			nil,       // @graph
+			nil,       // @defaults
+			nil,       // @edge_defaults
+			nil,       // numeric_literal
+			nil,       // raw_string
+			nil,       // param_ref
+		},
+	},
+	actionRow{ // S216
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			shift(319), // id
+			shift(320), // dotted_id
+			shift(321), // quoted_string
+			shift(322), // ns_prefix
+			nil,        // [
+			nil,        // ]
+			nil,        // _
+			nil,        // ,
+			nil,        // subgraph
+			nil,        // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S217
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			shift(57),  // id
+			shift(58),  // dotted_id
+			shift(59),  // quoted_string
+			shift(60),  // ns_prefix
+			nil,        // [
+			nil,        // ]
+			shift(63),  // _
+			shift(144), // ,
+			nil,        // subgraph
+			nil,        // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			shift(323), // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S218
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			shift(5),   // id
+			shift(6),   // dotted_id
+			shift(7),   // quoted_string
+			shift(8),   // ns_prefix
+			nil,        // [
+			nil,        // ]
+			shift(11),  // _
+			nil,        // ,
+			nil,        // subgraph
+			nil,        // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			shift(126), // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S219
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			reduce(84), // id, reduce: AttrItems
+			reduce(84), // dotted_id, reduce: AttrItems
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			reduce(84), // _, reduce: AttrItems
+			nil,        // ,
+			reduce(84), // subgraph, reduce: AttrItems
+			reduce(84), // include, reduce: AttrItems
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			reduce(84), // edge_attr_close, reduce: AttrItems
+			reduce(84), // edge_attr_close_nohead, reduce: AttrItems
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			reduce(84), // !, reduce: AttrItems
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S220
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(33), // ;, reduce: EdgeType
+			reduce(33), // id, reduce: EdgeType
+			reduce(33), // dotted_id, reduce: EdgeType
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			reduce(33), // _, reduce: EdgeType
+			nil,        // ,
+			reduce(33), // subgraph, reduce: EdgeType
+			reduce(33), // include, reduce: EdgeType
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			reduce(33), // edge_attr_close, reduce: EdgeType
+			reduce(33), // edge_attr_close_nohead, reduce: EdgeType
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			reduce(33), // !, reduce: EdgeType
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S221
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(36), // ␚, reduce: EdgeRHS
+			nil,        // empty
+			reduce(36), // ;, reduce: EdgeRHS
+			reduce(36), // id, reduce: EdgeRHS
+			reduce(36), // dotted_id, reduce: EdgeRHS
+			reduce(36), // quoted_string, reduce: EdgeRHS
+			reduce(36), // ns_prefix, reduce: EdgeRHS
+			nil,        // [
+			nil,        // ]
+			reduce(36), // _, reduce: EdgeRHS
+			nil,        // ,
+			reduce(36), // subgraph, reduce: EdgeRHS
+			reduce(36), // include, reduce: EdgeRHS
+			reduce(36), // edgearrow, reduce: EdgeRHS
+			reduce(36), // edgeline, reduce: EdgeRHS
+			reduce(36), // edgebiarrow, reduce: EdgeRHS
+			reduce(36), // edgebackarrow, reduce: EdgeRHS
+			reduce(36), // edge_attr_open, reduce: EdgeRHS
+			reduce(36), // edge_attr_open_head, reduce: EdgeRHS
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(36), // {, reduce: EdgeRHS
+			nil,        // }
+			nil,        // :
+			reduce(36), // @let, reduce: EdgeRHS
+			nil,        // =
+			reduce(36), // @template, reduce: EdgeRHS
+			nil,        // (
+			nil,        // )
+			reduce(36), // @use, reduce: EdgeRHS
+			reduce(36), // @namespace, reduce: EdgeRHS
+			reduce(36), // !, reduce: EdgeRHS
+			reduce(36), // @graph, reduce: EdgeRHS
+			reduce(36), // @defaults, reduce: EdgeRHS
+			reduce(36), // @edge_defaults, reduce: EdgeRHS
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S222
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			shift(155), // id
+			shift(107), // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			shift(157), // _
+			nil,        // ,
+			shift(159), // subgraph
+			shift(160), // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			shift(134), // edge_attr_close
+			shift(135), // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			shift(138), // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S223
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			nil,       // ␚
+			nil,       // empty
+			nil,       // ;
+			reduce(6), // id, reduce: OptSep
+			reduce(6), // dotted_id, reduce: OptSep
+			nil,       // quoted_string
+			nil,       // ns_prefix
+			nil,       // [
+			nil,       // ]
+			reduce(6), // _, reduce: OptSep
+			nil,       // ,
+			reduce(6), // subgraph, reduce: OptSep
+			reduce(6), // include, reduce: OptSep
+			nil,       // edgearrow
+			nil,       // edgeline
+			nil,       // edgebiarrow
+			nil,       // edgebackarrow
+			nil,       // edge_attr_open
+			nil,       // edge_attr_open_head
+			reduce(6), // edge_attr_close, reduce: OptSep
+			reduce(6), // edge_attr_close_nohead, reduce: OptSep
+			nil,       // edge_key
+			nil,       // {
+			nil,       // }
+			nil,       // :
+			nil,       // @let
+			nil,       // =
+			nil,       // @template
+			nil,       // (
+			nil,       // )
+			nil,       // @use
+			nil,       // @namespace
+			reduce(6), // !, reduce: OptSep
+			nil,       // @graph
+			nil,       // @defaults
+			nil,       // @edge_defaults
+			nil,       // numeric_literal
+			nil,       // raw_string
+			nil,       // param_ref
+		},
+	},
+	actionRow{ // S224
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			reduce(20), // id, reduce: Word
+			reduce(20), // dotted_id, reduce: Word
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			reduce(20), // _, reduce: Word
+			reduce(20), // ,, reduce: Word
+			reduce(20), // subgraph, reduce: Word
+			reduce(20), // include, reduce: Word
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			reduce(20), // edge_attr_close, reduce: Word
+			reduce(20), // edge_attr_close_nohead, reduce: Word
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			reduce(20), // !, reduce: Word
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S225
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			reduce(90), // id, reduce: AttrKey
+			reduce(90), // dotted_id, reduce: AttrKey
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			reduce(90), // _, reduce: AttrKey
+			reduce(90), // ,, reduce: AttrKey
+			reduce(90), // subgraph, reduce: AttrKey
+			reduce(90), // include, reduce: AttrKey
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			reduce(90), // edge_attr_close, reduce: AttrKey
+			reduce(90), // edge_attr_close_nohead, reduce: AttrKey
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			reduce(90), // !, reduce: AttrKey
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S226
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			reduce(23), // id, reduce: Word
+			reduce(23), // dotted_id, reduce: Word
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			reduce(23), // _, reduce: Word
+			reduce(23), // ,, reduce: Word
+			reduce(23), // subgraph, reduce: Word
+			reduce(23), // include, reduce: Word
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			reduce(23), // edge_attr_close, reduce: Word
+			reduce(23), // edge_attr_close_nohead, reduce: Word
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			reduce(23), // !, reduce: Word
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S227
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			reduce(89), // id, reduce: AttrKey
+			reduce(89), // dotted_id, reduce: AttrKey
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			reduce(89), // _, reduce: AttrKey
+			reduce(89), // ,, reduce: AttrKey
+			reduce(89), // subgraph, reduce: AttrKey
+			reduce(89), // include, reduce: AttrKey
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			reduce(89), // edge_attr_close, reduce: AttrKey
+			reduce(89), // edge_attr_close_nohead, reduce: AttrKey
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			reduce(89), // !, reduce: AttrKey
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S228
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			reduce(21), // id, reduce: Word
+			reduce(21), // dotted_id, reduce: Word
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			reduce(21), // _, reduce: Word
+			reduce(21), // ,, reduce: Word
+			reduce(21), // subgraph, reduce: Word
+			reduce(21), // include, reduce: Word
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			reduce(21), // edge_attr_close, reduce: Word
+			reduce(21), // edge_attr_close_nohead, reduce: Word
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			reduce(21), // !, reduce: Word
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S229
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			reduce(22), // id, reduce: Word
+			reduce(22), // dotted_id, reduce: Word
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			reduce(22), // _, reduce: Word
+			reduce(22), // ,, reduce: Word
+			reduce(22), // subgraph, reduce: Word
+			reduce(22), // include, reduce: Word
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			reduce(22), // edge_attr_close, reduce: Word
+			reduce(22), // edge_attr_close_nohead, reduce: Word
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			reduce(22), // !, reduce: Word
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S230
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			reduce(85), // id, reduce: OptAttrSep
+			reduce(85), // dotted_id, reduce: OptAttrSep
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			reduce(85), // _, reduce: OptAttrSep
+			shift(327), // ,
+			reduce(85), // subgraph, reduce: OptAttrSep
+			reduce(85), // include, reduce: OptAttrSep
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			reduce(85), // edge_attr_close, reduce: OptAttrSep
+			reduce(85), // edge_attr_close_nohead, reduce: OptAttrSep
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			reduce(85), // !, reduce: OptAttrSep
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S231
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			shift(224), // id
+			nil,        // dotted_id
+			shift(329), // quoted_string
+			nil,        // ns_prefix
+			shift(330), // [
+			nil,        // ]
+			shift(226), // _
+			nil,        // ,
+			shift(228), // subgraph
+			shift(229), // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			shift(334), // numeric_literal
+			shift(335), // raw_string
+			shift(336), // param_ref
+		},
+	},
+	actionRow{ // S232
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			reduce(43), // id, reduce: EdgeRef
+			reduce(43), // dotted_id, reduce: EdgeRef
+			reduce(43), // quoted_string, reduce: EdgeRef
+			reduce(43), // ns_prefix, reduce: EdgeRef
+			nil,        // [
+			nil,        // ]
+			reduce(43), // _, reduce: EdgeRef
+			reduce(43), // ,, reduce: EdgeRef
+			nil,        // subgraph
+			nil,        // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			reduce(43), // }, reduce: EdgeRef
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S233
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			nil,       // ␚
+			nil,       // empty
+			nil,       // ;
+			reduce(7), // id, reduce: NodeId
+			reduce(7), // dotted_id, reduce: NodeId
+			reduce(7), // quoted_string, reduce: NodeId
+			reduce(7), // ns_prefix, reduce: NodeId
+			nil,       // [
+			nil,       // ]
+			reduce(7), // _, reduce: NodeId
+			reduce(7), // ,, reduce: NodeId
+			nil,       // subgraph
+			nil,       // include
+			nil,       // edgearrow
+			nil,       // edgeline
+			nil,       // edgebiarrow
+			nil,       // edgebackarrow
+			nil,       // edge_attr_open
+			nil,       // edge_attr_open_head
+			nil,       // edge_attr_close
+			nil,       // edge_attr_close_nohead
+			nil,       // edge_key
+			nil,       // {
+			reduce(7), // }, reduce: NodeId
+			nil,       // :
+			nil,       // @let
+			nil,       // =
+			nil,       // @template
+			nil,       // (
+			nil,       // )
+			nil,       // @use
+			nil,       // @namespace
+			nil,       // !
+			nil,       // @graph
+			nil,       // @defaults
+			nil,       // @edge_defaults
+			nil,       // numeric_literal
+			nil,       // raw_string
+			nil,       // param_ref
+		},
+	},
+	actionRow{ // S234
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			nil,       // ␚
+			nil,       // empty
+			nil,       // ;
+			reduce(8), // id, reduce: NodeId
+			reduce(8), // dotted_id, reduce: NodeId
+			reduce(8), // quoted_string, reduce: NodeId
+			reduce(8), // ns_prefix, reduce: NodeId
+			nil,       // [
+			nil,       // ]
+			reduce(8), // _, reduce: NodeId
+			reduce(8), // ,, reduce: NodeId
+			nil,       // subgraph
+			nil,       // include
+			nil,       // edgearrow
+			nil,       // edgeline
+			nil,       // edgebiarrow
+			nil,       // edgebackarrow
+			nil,       // edge_attr_open
+			nil,       // edge_attr_open_head
+			nil,       // edge_attr_close
+			nil,       // edge_attr_close_nohead
+			nil,       // edge_key
+			nil,       // {
+			reduce(8), // }, reduce: NodeId
+			nil,       // :
+			nil,       // @let
+			nil,       // =
+			nil,       // @template
+			nil,       // (
+			nil,       // )
+			nil,       // @use
+			nil,       // @namespace
+			nil,       // !
+			nil,       // @graph
+			nil,       // @defaults
+			nil,       // @edge_defaults
+			nil,       // numeric_literal
+			nil,       // raw_string
+			nil,       // param_ref
+		},
+	},
+	actionRow{ // S235
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			nil,       // ␚
+			nil,       // empty
+			nil,       // ;
+			reduce(9), // id, reduce: NodeId
+			reduce(9), // dotted_id, reduce: NodeId
+			reduce(9), // quoted_string, reduce: NodeId
+			reduce(9), // ns_prefix, reduce: NodeId
+			nil,       // [
+			nil,       // ]
+			reduce(9), // _, reduce: NodeId
+			reduce(9), // ,, reduce: NodeId
+			nil,       // subgraph
+			nil,       // include
+			nil,       // edgearrow
+			nil,       // edgeline
+			nil,       // edgebiarrow
+			nil,       // edgebackarrow
+			nil,       // edge_attr_open
+			nil,       // edge_attr_open_head
+			nil,       // edge_attr_close
+			nil,       // edge_attr_close_nohead
+			nil,       // edge_key
+			nil,       // {
+			reduce(9), // }, reduce: NodeId
+			nil,       // :
+			nil,       // @let
+			nil,       // =
+			nil,       // @template
+			nil,       // (
+			nil,       // )
+			nil,       // @use
+			nil,       // @namespace
+			nil,       // !
+			nil,       // @graph
+			nil,       // @defaults
+			nil,       // @edge_defaults
+			nil,       // numeric_literal
+			nil,       // raw_string
+			nil,       // param_ref
+		},
+	},
+	actionRow{ // S236
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // id
+			nil,        // dotted_id
+			shift(337), // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			nil,        // _
+			nil,        // ,
+			nil,        // subgraph
+			nil,        // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S237
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			shift(155), // id
+			shift(107), // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			shift(338), // ]
+			shift(157), // _
+			nil,        // ,
+			shift(159), // subgraph
+			shift(160), // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			shift(115), // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S238
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			reduce(14), // id, reduce: NodeDecl
+			reduce(14), // dotted_id, reduce: NodeDecl
+			reduce(14), // quoted_string, reduce: NodeDecl
+			reduce(14), // ns_prefix, reduce: NodeDecl
+			nil,        // [
+			nil,        // ]
+			reduce(14), // _, reduce: NodeDecl
+			reduce(14), // ,, reduce: NodeDecl
+			nil,        // subgraph
+			nil,        // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			reduce(14), // }, reduce: NodeDecl
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S239
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			shift(167), // ;
+			reduce(5),  // id, reduce: OptSep
+			reduce(5),  // dotted_id, reduce: OptSep
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			reduce(5),  // ], reduce: OptSep
+			reduce(5),  // _, reduce: OptSep
+			shift(168), // ,
+			reduce(5),  // subgraph, reduce: OptSep
+			reduce(5),  // include, reduce: OptSep
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			reduce(5),  // !, reduce: OptSep
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S240
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			reduce(46), // id, reduce: EdgeRefList
+			reduce(46), // dotted_id, reduce: EdgeRefList
+			reduce(46), // quoted_string, reduce: EdgeRefList
+			reduce(46), // ns_prefix, reduce: EdgeRefList
+			nil,        // [
+			nil,        // ]
+			reduce(46), // _, reduce: EdgeRefList
+			reduce(46), // ,, reduce: EdgeRefList
+			nil,        // subgraph
+			nil,        // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			reduce(46), // }, reduce: EdgeRefList
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S241
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(20), // ␚, reduce: Word
+			nil,        // empty
+			reduce(20), // ;, reduce: Word
+			reduce(20), // id, reduce: Word
+			reduce(20), // dotted_id, reduce: Word
+			reduce(20), // quoted_string, reduce: Word
+			reduce(20), // ns_prefix, reduce: Word
+			nil,        // [
+			nil,        // ]
+			reduce(20), // _, reduce: Word
+			nil,        // ,
+			reduce(20), // subgraph, reduce: Word
+			reduce(20), // include, reduce: Word
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(20), // {, reduce: Word
+			nil,        // }
+			nil,        // :
+			reduce(20), // @let, reduce: Word
+			nil,        // =
+			reduce(20), // @template, reduce: Word
+			nil,        // (
+			nil,        // )
+			reduce(20), // @use, reduce: Word
+			reduce(20), // @namespace, reduce: Word
+			reduce(20), // !, reduce: Word
+			reduce(20), // @graph, reduce: Word
+			reduce(20), // @defaults, reduce: Word
+			reduce(20), // @edge_defaults, reduce: Word
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S242
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(96), // ␚, reduce: ScalarVal
+			nil,        // empty
+			reduce(96), // ;, reduce: ScalarVal
+			reduce(96), // id, reduce: ScalarVal
+			reduce(96), // dotted_id, reduce: ScalarVal
+			reduce(96), // quoted_string, reduce: ScalarVal
+			reduce(96), // ns_prefix, reduce: ScalarVal
+			nil,        // [
+			nil,        // ]
+			reduce(96), // _, reduce: ScalarVal
+			nil,        // ,
+			reduce(96), // subgraph, reduce: ScalarVal
+			reduce(96), // include, reduce: ScalarVal
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(96), // {, reduce: ScalarVal
+			nil,        // }
+			nil,        // :
+			reduce(96), // @let, reduce: ScalarVal
+			nil,        // =
+			reduce(96), // @template, reduce: ScalarVal
+			nil,        // (
+			nil,        // )
+			reduce(96), // @use, reduce: ScalarVal
+			reduce(96), // @namespace, reduce: ScalarVal
+			reduce(96), // !, reduce: ScalarVal
+			reduce(96), // @graph, reduce: ScalarVal
+			reduce(96), // @defaults, reduce: ScalarVal
+			reduce(96), // @edge_defaults, reduce: ScalarVal
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S243
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(23), // ␚, reduce: Word
+			nil,        // empty
+			reduce(23), // ;, reduce: Word
+			reduce(23), // id, reduce: Word
+			reduce(23), // dotted_id, reduce: Word
+			reduce(23), // quoted_string, reduce: Word
+			reduce(23), // ns_prefix, reduce: Word
+			nil,        // [
+			nil,        // ]
+			reduce(23), // _, reduce: Word
+			nil,        // ,
+			reduce(23), // subgraph, reduce: Word
+			reduce(23), // include, reduce: Word
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(23), // {, reduce: Word
+			nil,        // }
+			nil,        // :
+			reduce(23), // @let, reduce: Word
+			nil,        // =
+			reduce(23), // @template, reduce: Word
+			nil,        // (
+			nil,        // )
+			reduce(23), // @use, reduce: Word
+			reduce(23), // @namespace, reduce: Word
+			reduce(23), // !, reduce: Word
+			reduce(23), // @graph, reduce: Word
+			reduce(23), // @defaults, reduce: Word
+			reduce(23), // @edge_defaults, reduce: Word
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S244
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(94), // ␚, reduce: ScalarVal
+			nil,        // empty
+			reduce(94), // ;, reduce: ScalarVal
+			reduce(94), // id, reduce: ScalarVal
+			reduce(94), // dotted_id, reduce: ScalarVal
+			reduce(94), // quoted_string, reduce: ScalarVal
+			reduce(94), // ns_prefix, reduce: ScalarVal
+			nil,        // [
+			nil,        // ]
+			reduce(94), // _, reduce: ScalarVal
+			nil,        // ,
+			reduce(94), // subgraph, reduce: ScalarVal
+			reduce(94), // include, reduce: ScalarVal
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(94), // {, reduce: ScalarVal
+			nil,        // }
+			nil,        // :
+			reduce(94), // @let, reduce: ScalarVal
+			nil,        // =
+			reduce(94), // @template, reduce: ScalarVal
+			nil,        // (
+			nil,        // )
+			reduce(94), // @use, reduce: ScalarVal
+			reduce(94), // @namespace, reduce: ScalarVal
+			reduce(94), // !, reduce: ScalarVal
+			reduce(94), // @graph, reduce: ScalarVal
+			reduce(94), // @defaults, reduce: ScalarVal
+			reduce(94), // @edge_defaults, reduce: ScalarVal
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S245
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(21), // ␚, reduce: Word
+			nil,        // empty
+			reduce(21), // ;, reduce: Word
+			reduce(21), // id, reduce: Word
+			reduce(21), // dotted_id, reduce: Word
+			reduce(21), // quoted_string, reduce: Word
+			reduce(21), // ns_prefix, reduce: Word
+			nil,        // [
+			nil,        // ]
+			reduce(21), // _, reduce: Word
+			nil,        // ,
+			reduce(21), // subgraph, reduce: Word
+			reduce(21), // include, reduce: Word
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(21), // {, reduce: Word
+			nil,        // }
+			nil,        // :
+			reduce(21), // @let, reduce: Word
+			nil,        // =
+			reduce(21), // @template, reduce: Word
+			nil,        // (
+			nil,        // )
+			reduce(21), // @use, reduce: Word
+			reduce(21), // @namespace, reduce: Word
+			reduce(21), // !, reduce: Word
+			reduce(21), // @graph, reduce: Word
+			reduce(21), // @defaults, reduce: Word
+			reduce(21), // @edge_defaults, reduce: Word
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S246
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(22), // ␚, reduce: Word
+			nil,        // empty
+			reduce(22), // ;, reduce: Word
+			reduce(22), // id, reduce: Word
+			reduce(22), // dotted_id, reduce: Word
+			reduce(22), // quoted_string, reduce: Word
+			reduce(22), // ns_prefix, reduce: Word
+			nil,        // [
+			nil,        // ]
+			reduce(22), // _, reduce: Word
+			nil,        // ,
+			reduce(22), // subgraph, reduce: Word
+			reduce(22), // include, reduce: Word
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(22), // {, reduce: Word
+			nil,        // }
+			nil,        // :
+			reduce(22), // @let, reduce: Word
+			nil,        // =
+			reduce(22), // @template, reduce: Word
+			nil,        // (
+			nil,        // )
+			reduce(22), // @use, reduce: Word
+			reduce(22), // @namespace, reduce: Word
+			reduce(22), // !, reduce: Word
+			reduce(22), // @graph, reduce: Word
+			reduce(22), // @defaults, reduce: Word
+			reduce(22), // @edge_defaults, reduce: Word
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S247
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(60), // ␚, reduce: LetDecl
+			nil,        // empty
+			reduce(60), // ;, reduce: LetDecl
+			reduce(60), // id, reduce: LetDecl
+			reduce(60), // dotted_id, reduce: LetDecl
+			reduce(60), // quoted_string, reduce: LetDecl
+			reduce(60), // ns_prefix, reduce: LetDecl
+			nil,        // [
+			nil,        // ]
+			reduce(60), // _, reduce: LetDecl
+			nil,        // ,
+			reduce(60), // subgraph, reduce: LetDecl
+			reduce(60), // include, reduce: LetDecl
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(60), // {, reduce: LetDecl
+			nil,        // }
+			nil,        // :
+			reduce(60), // @let, reduce: LetDecl
+			nil,        // =
+			reduce(60), // @template, reduce: LetDecl
+			nil,        // (
+			nil,        // )
+			reduce(60), // @use, reduce: LetDecl
+			reduce(60), // @namespace, reduce: LetDecl
+			reduce(60), // !, reduce: LetDecl
+			reduce(60), // @graph, reduce: LetDecl
+			reduce(60), // @defaults, reduce: LetDecl
+			reduce(60), // @edge_defaults, reduce: LetDecl
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S248
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(95), // ␚, reduce: ScalarVal
+			nil,        // empty
+			reduce(95), // ;, reduce: ScalarVal
+			reduce(95), // id, reduce: ScalarVal
+			reduce(95), // dotted_id, reduce: ScalarVal
+			reduce(95), // quoted_string, reduce: ScalarVal
+			reduce(95), // ns_prefix, reduce: ScalarVal
+			nil,        // [
+			nil,        // ]
+			reduce(95), // _, reduce: ScalarVal
+			nil,        // ,
+			reduce(95), // subgraph, reduce: ScalarVal
+			reduce(95), // include, reduce: ScalarVal
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(95), // {, reduce: ScalarVal
+			nil,        // }
+			nil,        // :
+			reduce(95), // @let, reduce: ScalarVal
+			nil,        // =
+			reduce(95), // @template, reduce: ScalarVal
+			nil,        // (
+			nil,        // )
+			reduce(95), // @use, reduce: ScalarVal
+			reduce(95), // @namespace, reduce: ScalarVal
+			reduce(95), // !, reduce: ScalarVal
+			reduce(95), // @graph, reduce: ScalarVal
+			reduce(95), // @defaults, reduce: ScalarVal
+			reduce(95), // @edge_defaults, reduce: ScalarVal
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S249
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(97), // ␚, reduce: ScalarVal
+			nil,        // empty
+			reduce(97), // ;, reduce: ScalarVal
+			reduce(97), // id, reduce: ScalarVal
+			reduce(97), // dotted_id, reduce: ScalarVal
+			reduce(97), // quoted_string, reduce: ScalarVal
+			reduce(97), // ns_prefix, reduce: ScalarVal
+			nil,        // [
+			nil,        // ]
+			reduce(97), // _, reduce: ScalarVal
+			nil,        // ,
+			reduce(97), // subgraph, reduce: ScalarVal
+			reduce(97), // include, reduce: ScalarVal
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(97), // {, reduce: ScalarVal
+			nil,        // }
+			nil,        // :
+			reduce(97), // @let, reduce: ScalarVal
+			nil,        // =
+			reduce(97), // @template, reduce: ScalarVal
+			nil,        // (
+			nil,        // )
+			reduce(97), // @use, reduce: ScalarVal
+			reduce(97), // @namespace, reduce: ScalarVal
+			reduce(97), // !, reduce: ScalarVal
+			reduce(97), // @graph, reduce: ScalarVal
+			reduce(97), // @defaults, reduce: ScalarVal
+			reduce(97), // @edge_defaults, reduce: ScalarVal
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S250
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(98), // ␚, reduce: ScalarVal
+			nil,        // empty
+			reduce(98), // ;, reduce: ScalarVal
+			reduce(98), // id, reduce: ScalarVal
+			reduce(98), // dotted_id, reduce: ScalarVal
+			reduce(98), // quoted_string, reduce: ScalarVal
+			reduce(98), // ns_prefix, reduce: ScalarVal
+			nil,        // [
+			nil,        // ]
+			reduce(98), // _, reduce: ScalarVal
+			nil,        // ,
+			reduce(98), // subgraph, reduce: ScalarVal
+			reduce(98), // include, reduce: ScalarVal
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(98), // {, reduce: ScalarVal
+			nil,        // }
+			nil,        // :
+			reduce(98), // @let, reduce: ScalarVal
+			nil,        // =
+			reduce(98), // @template, reduce: ScalarVal
+			nil,        // (
+			nil,        // )
+			reduce(98), // @use, reduce: ScalarVal
+			reduce(98), // @namespace, reduce: ScalarVal
+			reduce(98), // !, reduce: ScalarVal
+			reduce(98), // @graph, reduce: ScalarVal
+			reduce(98), // @defaults, reduce: ScalarVal
+			reduce(98), // @edge_defaults, reduce: ScalarVal
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S251
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // id
+			nil,        // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			nil,        // _
+			reduce(63), // ,, reduce: ParamList
+			nil,        // subgraph
+			nil,        // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			reduce(63), // ), reduce: ParamList
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S252
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // id
+			nil,        // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			nil,        // _
+			nil,        // ,
+			nil,        // subgraph
+			nil,        // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			shift(119), // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S253
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // id
+			nil,        // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			nil,        // _
+			shift(341), // ,
+			nil,        // subgraph
+			nil,        // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			shift(342), // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S254
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // id
+			nil,        // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			nil,        // _
+			nil,        // ,
+			nil,        // subgraph
+			nil,        // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			shift(343), // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S255
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			shift(155), // id
+			shift(107), // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			shift(157), // _
+			nil,        // ,
+			shift(159), // subgraph
+			shift(160), // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			shift(344), // )
+			nil,        // @use
+			nil,        // @namespace
+			shift(257), // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S256
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(65), // ␚, reduce: TemplateUseDecl
+			nil,        // empty
+			reduce(65), // ;, reduce: TemplateUseDecl
+			reduce(65), // id, reduce: TemplateUseDecl
+			reduce(65), // dotted_id, reduce: TemplateUseDecl
+			reduce(65), // quoted_string, reduce: TemplateUseDecl
+			reduce(65), // ns_prefix, reduce: TemplateUseDecl
+			nil,        // [
+			nil,        // ]
+			reduce(65), // _, reduce: TemplateUseDecl
+			nil,        // ,
+			reduce(65), // subgraph, reduce: TemplateUseDecl
+			reduce(65), // include, reduce: TemplateUseDecl
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(65), // {, reduce: TemplateUseDecl
+			nil,        // }
+			nil,        // :
+			reduce(65), // @let, reduce: TemplateUseDecl
+			nil,        // =
+			reduce(65), // @template, reduce: TemplateUseDecl
+			nil,        // (
+			nil,        // )
+			reduce(65), // @use, reduce: TemplateUseDecl
+			reduce(65), // @namespace, reduce: TemplateUseDecl
+			reduce(65), // !, reduce: TemplateUseDecl
+			reduce(65), // @graph, reduce: TemplateUseDecl
+			reduce(65), // @defaults, reduce: TemplateUseDecl
+			reduce(65), // @edge_defaults, reduce: TemplateUseDecl
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S257
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			shift(346), // id
+			shift(347), // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			shift(348), // _
+			nil,        // ,
+			shift(350), // subgraph
+			shift(351), // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S258
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			reduce(83), // id, reduce: AttrItems
+			reduce(83), // dotted_id, reduce: AttrItems
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			reduce(83), // _, reduce: AttrItems
+			nil,        // ,
+			reduce(83), // subgraph, reduce: AttrItems
+			reduce(83), // include, reduce: AttrItems
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			reduce(83), // ), reduce: AttrItems
+			nil,        // @use
+			nil,        // @namespace
+			reduce(83), // !, reduce: AttrItems
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S259
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // id
+			nil,        // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			nil,        // _
+			nil,        // ,
+			nil,        // subgraph
+			nil,        // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			shift(353), // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S260
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			shift(155), // id
+			shift(107), // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			shift(354), // ]
+			shift(157), // _
+			nil,        // ,
+			shift(159), // subgraph
+			shift(160), // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			shift(115), // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S261
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // id
+			nil,        // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			nil,        // _
+			nil,        // ,
+			nil,        // subgraph
+			nil,        // include
+			reduce(14), // edgearrow, reduce: NodeDecl
+			reduce(14), // edgeline, reduce: NodeDecl
+			reduce(14), // edgebiarrow, reduce: NodeDecl
+			reduce(14), // edgebackarrow, reduce: NodeDecl
+			reduce(14), // edge_attr_open, reduce: NodeDecl
+			reduce(14), // edge_attr_open_head, reduce: NodeDecl
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S262
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			shift(167), // ;
+			reduce(5),  // id, reduce: OptSep
+			reduce(5),  // dotted_id, reduce: OptSep
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			reduce(5),  // ], reduce: OptSep
+			reduce(5),  // _, reduce: OptSep
+			shift(168), // ,
+			reduce(5),  // subgraph, reduce: OptSep
+			reduce(5),  // include, reduce: OptSep
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			reduce(5),  // !, reduce: OptSep
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S263
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(72), // ␚, reduce: GraphAttrsDecl
+			nil,        // empty
+			reduce(72), // ;, reduce: GraphAttrsDecl
+			reduce(72), // id, reduce: GraphAttrsDecl
+			reduce(72), // dotted_id, reduce: GraphAttrsDecl
+			reduce(72), // quoted_string, reduce: GraphAttrsDecl
+			reduce(72), // ns_prefix, reduce: GraphAttrsDecl
+			nil,        // [
+			nil,        // ]
+			reduce(72), // _, reduce: GraphAttrsDecl
+			nil,        // ,
+			reduce(72), // subgraph, reduce: GraphAttrsDecl
+			reduce(72), // include, reduce: GraphAttrsDecl
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(72), // {, reduce: GraphAttrsDecl
+			nil,        // }
+			nil,        // :
+			reduce(72), // @let, reduce: GraphAttrsDecl
+			nil,        // =
+			reduce(72), // @template, reduce: GraphAttrsDecl
+			nil,        // (
+			nil,        // )
+			reduce(72), // @use, reduce: GraphAttrsDecl
+			reduce(72), // @namespace, reduce: GraphAttrsDecl
+			reduce(72), // !, reduce: GraphAttrsDecl
+			reduce(72), // @graph, reduce: GraphAttrsDecl
+			reduce(72), // @defaults, reduce: GraphAttrsDecl
+			reduce(72), // @edge_defaults, reduce: GraphAttrsDecl
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S264
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			shift(155), // id
+			shift(107), // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			shift(356), // ]
+			shift(157), // _
+			nil,        // ,
+			shift(159), // subgraph
+			shift(160), // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			shift(115), // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S265
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			shift(155), // id
+			shift(107), // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			shift(357), // ]
+			shift(157), // _
+			nil,        // ,
+			shift(159), // subgraph
+			shift(160), // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			shift(115), // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S266
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			shift(155), // id
+			shift(107), // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			shift(358), // ]
+			shift(157), // _
+			nil,        // ,
+			shift(159), // subgraph
+			shift(160), // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			shift(115), // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S267
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(13), // ␚, reduce: NodeDecl
+			nil,        // empty
+			reduce(13), // ;, reduce: NodeDecl
+			reduce(13), // id, reduce: NodeDecl
+			reduce(13), // dotted_id, reduce: NodeDecl
+			reduce(13), // quoted_string, reduce: NodeDecl
+			reduce(13), // ns_prefix, reduce: NodeDecl
+			nil,        // [
+			nil,        // ]
+			reduce(13), // _, reduce: NodeDecl
+			nil,        // ,
+			reduce(13), // subgraph, reduce: NodeDecl
+			reduce(13), // include, reduce: NodeDecl
+			reduce(13), // edgearrow, reduce: NodeDecl
+			reduce(13), // edgeline, reduce: NodeDecl
+			reduce(13), // edgebiarrow, reduce: NodeDecl
+			reduce(13), // edgebackarrow, reduce: NodeDecl
+			reduce(13), // edge_attr_open, reduce: NodeDecl
+			reduce(13), // edge_attr_open_head, reduce: NodeDecl
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(13), // {, reduce: NodeDecl
+			nil,        // }
+			nil,        // :
+			reduce(13), // @let, reduce: NodeDecl
+			nil,        // =
+			reduce(13), // @template, reduce: NodeDecl
+			nil,        // (
+			nil,        // )
+			reduce(13), // @use, reduce: NodeDecl
+			reduce(13), // @namespace, reduce: NodeDecl
+			reduce(13), // !, reduce: NodeDecl
+			reduce(13), // @graph, reduce: NodeDecl
+			reduce(13), // @defaults, reduce: NodeDecl
+			reduce(13), // @edge_defaults, reduce: NodeDecl
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S268
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(20), // ;, reduce: Word
+			reduce(20), // id, reduce: Word
+			reduce(20), // dotted_id, reduce: Word
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			reduce(20), // ], reduce: Word
+			reduce(20), // _, reduce: Word
+			reduce(20), // ,, reduce: Word
+			reduce(20), // subgraph, reduce: Word
+			reduce(20), // include, reduce: Word
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			reduce(20), // !, reduce: Word
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S269
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(23), // ;, reduce: Word
+			reduce(23), // id, reduce: Word
+			reduce(23), // dotted_id, reduce: Word
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			reduce(23), // ], reduce: Word
+			reduce(23), // _, reduce: Word
+			reduce(23), // ,, reduce: Word
+			reduce(23), // subgraph, reduce: Word
+			reduce(23), // include, reduce: Word
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			reduce(23), // !, reduce: Word
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S270
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(19), // ;, reduce: TypeList
+			reduce(19), // id, reduce: TypeList
+			reduce(19), // dotted_id, reduce: TypeList
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			reduce(19), // ], reduce: TypeList
+			reduce(19), // _, reduce: TypeList
+			reduce(19), // ,, reduce: TypeList
+			reduce(19), // subgraph, reduce: TypeList
+			reduce(19), // include, reduce: TypeList
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			reduce(19), // !, reduce: TypeList
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S271
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(21), // ;, reduce: Word
+			reduce(21), // id, reduce: Word
+			reduce(21), // dotted_id, reduce: Word
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			reduce(21), // ], reduce: Word
+			reduce(21), // _, reduce: Word
+			reduce(21), // ,, reduce: Word
+			reduce(21), // subgraph, reduce: Word
+			reduce(21), // include, reduce: Word
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			reduce(21), // !, reduce: Word
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S272
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(22), // ;, reduce: Word
+			reduce(22), // id, reduce: Word
+			reduce(22), // dotted_id, reduce: Word
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			reduce(22), // ], reduce: Word
+			reduce(22), // _, reduce: Word
+			reduce(22), // ,, reduce: Word
+			reduce(22), // subgraph, reduce: Word
+			reduce(22), // include, reduce: Word
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			reduce(22), // !, reduce: Word
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S273
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			reduce(86), // id, reduce: OptAttrSep
+			reduce(86), // dotted_id, reduce: OptAttrSep
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			reduce(86), // ], reduce: OptAttrSep
+			reduce(86), // _, reduce: OptAttrSep
+			nil,        // ,
+			reduce(86), // subgraph, reduce: OptAttrSep
+			reduce(86), // include, reduce: OptAttrSep
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			reduce(86), // !, reduce: OptAttrSep
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S274
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			reduce(88), // id, reduce: Attr
+			reduce(88), // dotted_id, reduce: Attr
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			reduce(88), // ], reduce: Attr
+			reduce(88), // _, reduce: Attr
+			nil,        // ,
+			reduce(88), // subgraph, reduce: Attr
+			reduce(88), // include, reduce: Attr
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			reduce(88), // !, reduce: Attr
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S275
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			reduce(96), // id, reduce: ScalarVal
+			reduce(96), // dotted_id, reduce: ScalarVal
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			reduce(96), // ], reduce: ScalarVal
+			reduce(96), // _, reduce: ScalarVal
+			reduce(96), // ,, reduce: ScalarVal
+			reduce(96), // subgraph, reduce: ScalarVal
+			reduce(96), // include, reduce: ScalarVal
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			reduce(96), // !, reduce: ScalarVal
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S276
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			shift(359), // id
+			nil,        // dotted_id
+			shift(360), // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			shift(361), // ]
+			shift(362), // _
+			nil,        // ,
+			shift(364), // subgraph
+			shift(365), // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			shift(368), // numeric_literal
+			shift(369), // raw_string
+			shift(370), // param_ref
+		},
+	},
+	actionRow{ // S277
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			reduce(94), // id, reduce: ScalarVal
+			reduce(94), // dotted_id, reduce: ScalarVal
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			reduce(94), // ], reduce: ScalarVal
+			reduce(94), // _, reduce: ScalarVal
+			reduce(94), // ,, reduce: ScalarVal
+			reduce(94), // subgraph, reduce: ScalarVal
+			reduce(94), // include, reduce: ScalarVal
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			reduce(94), // !, reduce: ScalarVal
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S278
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			reduce(91), // id, reduce: AttrVal
+			reduce(91), // dotted_id, reduce: AttrVal
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			reduce(91), // ], reduce: AttrVal
+			reduce(91), // _, reduce: AttrVal
+			reduce(91), // ,, reduce: AttrVal
+			reduce(91), // subgraph, reduce: AttrVal
+			reduce(91), // include, reduce: AttrVal
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			reduce(91), // !, reduce: AttrVal
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S279
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			reduce(85), // id, reduce: OptAttrSep
+			reduce(85), // dotted_id, reduce: OptAttrSep
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			reduce(85), // ], reduce: OptAttrSep
+			reduce(85), // _, reduce: OptAttrSep
+			shift(273), // ,
+			reduce(85), // subgraph, reduce: OptAttrSep
+			reduce(85), // include, reduce: OptAttrSep
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			reduce(85), // !, reduce: OptAttrSep
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S280
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			reduce(95), // id, reduce: ScalarVal
+			reduce(95), // dotted_id, reduce: ScalarVal
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			reduce(95), // ], reduce: ScalarVal
+			reduce(95), // _, reduce: ScalarVal
+			reduce(95), // ,, reduce: ScalarVal
+			reduce(95), // subgraph, reduce: ScalarVal
+			reduce(95), // include, reduce: ScalarVal
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			reduce(95), // !, reduce: ScalarVal
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S281
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			reduce(97), // id, reduce: ScalarVal
+			reduce(97), // dotted_id, reduce: ScalarVal
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			reduce(97), // ], reduce: ScalarVal
+			reduce(97), // _, reduce: ScalarVal
+			reduce(97), // ,, reduce: ScalarVal
+			reduce(97), // subgraph, reduce: ScalarVal
+			reduce(97), // include, reduce: ScalarVal
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			reduce(97), // !, reduce: ScalarVal
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S282
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			reduce(98), // id, reduce: ScalarVal
+			reduce(98), // dotted_id, reduce: ScalarVal
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			reduce(98), // ], reduce: ScalarVal
+			reduce(98), // _, reduce: ScalarVal
+			reduce(98), // ,, reduce: ScalarVal
+			reduce(98), // subgraph, reduce: ScalarVal
+			reduce(98), // include, reduce: ScalarVal
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			reduce(98), // !, reduce: ScalarVal
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S283
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // id
+			nil,        // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			nil,        // _
+			nil,        // ,
+			nil,        // subgraph
+			nil,        // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			shift(119), // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S284
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(80), // ␚, reduce: GroupDecl
+			nil,        // empty
+			reduce(80), // ;, reduce: GroupDecl
+			reduce(80), // id, reduce: GroupDecl
+			reduce(80), // dotted_id, reduce: GroupDecl
+			reduce(80), // quoted_string, reduce: GroupDecl
+			reduce(80), // ns_prefix, reduce: GroupDecl
+			nil,        // [
+			nil,        // ]
+			reduce(80), // _, reduce: GroupDecl
+			nil,        // ,
+			reduce(80), // subgraph, reduce: GroupDecl
+			reduce(80), // include, reduce: GroupDecl
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(80), // {, reduce: GroupDecl
+			nil,        // }
+			nil,        // :
+			reduce(80), // @let, reduce: GroupDecl
+			nil,        // =
+			reduce(80), // @template, reduce: GroupDecl
+			nil,        // (
+			nil,        // )
+			reduce(80), // @use, reduce: GroupDecl
+			reduce(80), // @namespace, reduce: GroupDecl
+			reduce(80), // !, reduce: GroupDecl
+			reduce(80), // @graph, reduce: GroupDecl
+			reduce(80), // @defaults, reduce: GroupDecl
+			reduce(80), // @edge_defaults, reduce: GroupDecl
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S285
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			shift(155), // id
+			shift(107), // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			shift(374), // ]
+			shift(157), // _
+			nil,        // ,
+			shift(159), // subgraph
+			shift(160), // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			shift(115), // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S286
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			nil,       // ␚
+			nil,       // empty
+			nil,       // ;
+			reduce(4), // id, reduce: TopLevelDeclList
+			reduce(4), // dotted_id, reduce: TopLevelDeclList
+			reduce(4), // quoted_string, reduce: TopLevelDeclList
+			reduce(4), // ns_prefix, reduce: TopLevelDeclList
+			nil,       // [
+			nil,       // ]
+			reduce(4), // _, reduce: TopLevelDeclList
+			nil,       // ,
+			reduce(4), // subgraph, reduce: TopLevelDeclList
+			reduce(4), // include, reduce: TopLevelDeclList
+			nil,       // edgearrow
+			nil,       // edgeline
+			nil,       // edgebiarrow
+			nil,       // edgebackarrow
+			nil,       // edge_attr_open
+			nil,       // edge_attr_open_head
+			nil,       // edge_attr_close
+			nil,       // edge_attr_close_nohead
+			nil,       // edge_key
+			reduce(4), // {, reduce: TopLevelDeclList
+			reduce(4), // }, reduce: TopLevelDeclList
+			nil,       // :
+			reduce(4), // @let, reduce: TopLevelDeclList
+			nil,       // =
+			reduce(4), // @template, reduce: TopLevelDeclList
+			nil,       // (
+			nil,       // )
+			reduce(4), // @use, reduce: TopLevelDeclList
+			reduce(4), // @namespace, reduce: TopLevelDeclList
+			reduce(4), // !, reduce: TopLevelDeclList
+			reduce(4), // @graph, reduce: TopLevelDeclList
+			reduce(4), // @defaults, reduce: TopLevelDeclList
+			reduce(4), // @edge_defaults, reduce: TopLevelDeclList
+			nil,       // numeric_literal
+			nil,       // raw_string
+			nil,       // param_ref
+		},
+	},
+	actionRow{ // S287
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(82), // ␚, reduce: GroupBody
+			nil,        // empty
+			reduce(82), // ;, reduce: GroupBody
+			reduce(82), // id, reduce: GroupBody
+			reduce(82), // dotted_id, reduce: GroupBody
+			reduce(82), // quoted_string, reduce: GroupBody
+			reduce(82), // ns_prefix, reduce: GroupBody
+			nil,        // [
+			nil,        // ]
+			reduce(82), // _, reduce: GroupBody
+			nil,        // ,
+			reduce(82), // subgraph, reduce: GroupBody
+			reduce(82), // include, reduce: GroupBody
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(82), // {, reduce: GroupBody
+			nil,        // }
+			nil,        // :
+			reduce(82), // @let, reduce: GroupBody
+			nil,        // =
+			reduce(82), // @template, reduce: GroupBody
+			nil,        // (
+			nil,        // )
+			reduce(82), // @use, reduce: GroupBody
+			reduce(82), // @namespace, reduce: GroupBody
+			reduce(82), // !, reduce: GroupBody
+			reduce(82), // @graph, reduce: GroupBody
+			reduce(82), // @defaults, reduce: GroupBody
+			reduce(82), // @edge_defaults, reduce: GroupBody
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S288
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(10), // ;, reduce: NodeId
+			reduce(10), // id, reduce: NodeId
+			reduce(10), // dotted_id, reduce: NodeId
+			reduce(10), // quoted_string, reduce: NodeId
+			reduce(10), // ns_prefix, reduce: NodeId
+			reduce(10), // [, reduce: NodeId
+			nil,        // ]
+			reduce(10), // _, reduce: NodeId
+			nil,        // ,
+			reduce(10), // subgraph, reduce: NodeId
+			reduce(10), // include, reduce: NodeId
+			reduce(10), // edgearrow, reduce: NodeId
+			reduce(10), // edgeline, reduce: NodeId
+			reduce(10), // edgebiarrow, reduce: NodeId
+			reduce(10), // edgebackarrow, reduce: NodeId
+			reduce(10), // edge_attr_open, reduce: NodeId
+			reduce(10), // edge_attr_open_head, reduce: NodeId
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(10), // {, reduce: NodeId
+			reduce(10), // }, reduce: NodeId
+			reduce(10), // :, reduce: NodeId
+			reduce(10), // @let, reduce: NodeId
+			nil,        // =
+			reduce(10), // @template, reduce: NodeId
+			nil,        // (
+			nil,        // )
+			reduce(10), // @use, reduce: NodeId
+			reduce(10), // @namespace, reduce: NodeId
+			reduce(10), // !, reduce: NodeId
+			reduce(10), // @graph, reduce: NodeId
+			reduce(10), // @defaults, reduce: NodeId
+			reduce(10), // @edge_defaults, reduce: NodeId
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S289
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			reduce(50), // id, reduce: TopLevelStmt
+			reduce(50), // dotted_id, reduce: TopLevelStmt
+			reduce(50), // quoted_string, reduce: TopLevelStmt
+			reduce(50), // ns_prefix, reduce: TopLevelStmt
+			nil,        // [
+			nil,        // ]
+			reduce(50), // _, reduce: TopLevelStmt
+			nil,        // ,
+			reduce(50), // subgraph, reduce: TopLevelStmt
+			reduce(50), // include, reduce: TopLevelStmt
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(50), // {, reduce: TopLevelStmt
+			reduce(50), // }, reduce: TopLevelStmt
+			nil,        // :
+			reduce(50), // @let, reduce: TopLevelStmt
+			nil,        // =
+			reduce(50), // @template, reduce: TopLevelStmt
+			nil,        // (
+			nil,        // )
+			reduce(50), // @use, reduce: TopLevelStmt
+			reduce(50), // @namespace, reduce: TopLevelStmt
+			reduce(50), // !, reduce: TopLevelStmt
+			reduce(50), // @graph, reduce: TopLevelStmt
+			reduce(50), // @defaults, reduce: TopLevelStmt
+			reduce(50), // @edge_defaults, reduce: TopLevelStmt
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S290
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			nil,       // ␚
+			nil,       // empty
+			nil,       // ;
+			reduce(6), // id, reduce: OptSep
+			reduce(6), // dotted_id, reduce: OptSep
+			reduce(6), // quoted_string, reduce: OptSep
+			reduce(6), // ns_prefix, reduce: OptSep
+			nil,       // [
+			nil,       // ]
+			reduce(6), // _, reduce: OptSep
+			nil,       // ,
+			reduce(6), // subgraph, reduce: OptSep
+			reduce(6), // include, reduce: OptSep
+			nil,       // edgearrow
+			nil,       // edgeline
+			nil,       // edgebiarrow
+			nil,       // edgebackarrow
+			nil,       // edge_attr_open
+			nil,       // edge_attr_open_head
+			nil,       // edge_attr_close
+			nil,       // edge_attr_close_nohead
+			nil,       // edge_key
+			reduce(6), // {, reduce: OptSep
+			reduce(6), // }, reduce: OptSep
+			nil,       // :
+			reduce(6), // @let, reduce: OptSep
+			nil,       // =
+			reduce(6), // @template, reduce: OptSep
+			nil,       // (
+			nil,       // )
+			reduce(6), // @use, reduce: OptSep
+			reduce(6), // @namespace, reduce: OptSep
+			reduce(6), // !, reduce: OptSep
+			reduce(6), // @graph, reduce: OptSep
+			reduce(6), // @defaults, reduce: OptSep
+			reduce(6), // @edge_defaults, reduce: OptSep
+			nil,       // numeric_literal
+			nil,       // raw_string
+			nil,       // param_ref
+		},
+	},
+	actionRow{ // S291
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			shift(106), // id
+			shift(107), // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			shift(376), // ]
+			shift(111), // _
+			nil,        // ,
+			shift(113), // subgraph
+			shift(114), // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			shift(115), // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S292
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // id
+			nil,        // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			shift(378), // [
+			nil,        // ]
+			nil,        // _
+			nil,        // ,
+			nil,        // subgraph
+			nil,        // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			shift(379), // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S293
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(75), // ;, reduce: IncludeDecl
+			reduce(75), // id, reduce: IncludeDecl
+			reduce(75), // dotted_id, reduce: IncludeDecl
+			reduce(75), // quoted_string, reduce: IncludeDecl
+			reduce(75), // ns_prefix, reduce: IncludeDecl
+			nil,        // [
+			nil,        // ]
+			reduce(75), // _, reduce: IncludeDecl
+			nil,        // ,
+			reduce(75), // subgraph, reduce: IncludeDecl
+			reduce(75), // include, reduce: IncludeDecl
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(75), // {, reduce: IncludeDecl
+			reduce(75), // }, reduce: IncludeDecl
+			nil,        // :
+			reduce(75), // @let, reduce: IncludeDecl
+			nil,        // =
+			reduce(75), // @template, reduce: IncludeDecl
+			nil,        // (
+			nil,        // )
+			reduce(75), // @use, reduce: IncludeDecl
+			reduce(75), // @namespace, reduce: IncludeDecl
+			reduce(75), // !, reduce: IncludeDecl
+			reduce(75), // @graph, reduce: IncludeDecl
+			reduce(75), // @defaults, reduce: IncludeDecl
+			reduce(75), // @edge_defaults, reduce: IncludeDecl
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S294
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			shift(187), // id
+			shift(188), // dotted_id
+			shift(189), // quoted_string
+			shift(190), // ns_prefix
+			nil,        // [
+			nil,        // ]
+			shift(193), // _
+			nil,        // ,
+			nil,        // subgraph
+			nil,        // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			shift(385), // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S295
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			shift(127), // id
+			shift(107), // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			shift(129), // _
+			nil,        // ,
+			shift(131), // subgraph
+			shift(132), // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			shift(134), // edge_attr_close
+			shift(135), // edge_attr_close_nohead
+			shift(137), // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			shift(138), // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S296
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(47), // ;, reduce: EdgeDecl
+			reduce(47), // id, reduce: EdgeDecl
+			reduce(47), // dotted_id, reduce: EdgeDecl
+			reduce(47), // quoted_string, reduce: EdgeDecl
+			reduce(47), // ns_prefix, reduce: EdgeDecl
+			nil,        // [
+			nil,        // ]
+			reduce(47), // _, reduce: EdgeDecl
+			nil,        // ,
+			reduce(47), // subgraph, reduce: EdgeDecl
+			reduce(47), // include, reduce: EdgeDecl
+			reduce(47), // edgearrow, reduce: EdgeDecl
+			reduce(47), // edgeline, reduce: EdgeDecl
+			reduce(47), // edgebiarrow, reduce: EdgeDecl
+			reduce(47), // edgebackarrow, reduce: EdgeDecl
+			reduce(47), // edge_attr_open, reduce: EdgeDecl
+			reduce(47), // edge_attr_open_head, reduce: EdgeDecl
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(47), // {, reduce: EdgeDecl
+			reduce(47), // }, reduce: EdgeDecl
+			nil,        // :
+			reduce(47), // @let, reduce: EdgeDecl
+			nil,        // =
+			reduce(47), // @template, reduce: EdgeDecl
+			nil,        // (
+			nil,        // )
+			reduce(47), // @use, reduce: EdgeDecl
+			reduce(47), // @namespace, reduce: EdgeDecl
+			reduce(47), // !, reduce: EdgeDecl
+			reduce(47), // @graph, reduce: EdgeDecl
+			reduce(47), // @defaults, reduce: EdgeDecl
+			reduce(47), // @edge_defaults, reduce: EdgeDecl
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S297
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			reduce(49), // id, reduce: TopLevelStmt
+			reduce(49), // dotted_id, reduce: TopLevelStmt
+			reduce(49), // quoted_string, reduce: TopLevelStmt
+			reduce(49), // ns_prefix, reduce: TopLevelStmt
+			nil,        // [
+			nil,        // ]
+			reduce(49), // _, reduce: TopLevelStmt
+			nil,        // ,
+			reduce(49), // subgraph, reduce: TopLevelStmt
+			reduce(49), // include, reduce: TopLevelStmt
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(49), // {, reduce: TopLevelStmt
+			reduce(49), // }, reduce: TopLevelStmt
+			nil,        // :
+			reduce(49), // @let, reduce: TopLevelStmt
+			nil,        // =
+			reduce(49), // @template, reduce: TopLevelStmt
+			nil,        // (
+			nil,        // )
+			reduce(49), // @use, reduce: TopLevelStmt
+			reduce(49), // @namespace, reduce: TopLevelStmt
+			reduce(49), // !, reduce: TopLevelStmt
+			reduce(49), // @graph, reduce: TopLevelStmt
+			reduce(49), // @defaults, reduce: TopLevelStmt
+			reduce(49), // @edge_defaults, reduce: TopLevelStmt
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S298
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(48), // ;, reduce: EdgeDecl
+			reduce(48), // id, reduce: EdgeDecl
+			reduce(48), // dotted_id, reduce: EdgeDecl
+			reduce(48), // quoted_string, reduce: EdgeDecl
+			reduce(48), // ns_prefix, reduce: EdgeDecl
+			nil,        // [
+			nil,        // ]
+			reduce(48), // _, reduce: EdgeDecl
+			nil,        // ,
+			reduce(48), // subgraph, reduce: EdgeDecl
+			reduce(48), // include, reduce: EdgeDecl
+			reduce(48), // edgearrow, reduce: EdgeDecl
+			reduce(48), // edgeline, reduce: EdgeDecl
+			reduce(48), // edgebiarrow, reduce: EdgeDecl
+			reduce(48), // edgebackarrow, reduce: EdgeDecl
+			reduce(48), // edge_attr_open, reduce: EdgeDecl
+			reduce(48), // edge_attr_open_head, reduce: EdgeDecl
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(48), // {, reduce: EdgeDecl
+			reduce(48), // }, reduce: EdgeDecl
+			nil,        // :
+			reduce(48), // @let, reduce: EdgeDecl
+			nil,        // =
+			reduce(48), // @template, reduce: EdgeDecl
+			nil,        // (
+			nil,        // )
+			reduce(48), // @use, reduce: EdgeDecl
+			reduce(48), // @namespace, reduce: EdgeDecl
+			reduce(48), // !, reduce: EdgeDecl
+			reduce(48), // @graph, reduce: EdgeDecl
+			reduce(48), // @defaults, reduce: EdgeDecl
+			reduce(48), // @edge_defaults, reduce: EdgeDecl
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S299
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			reduce(51), // id, reduce: TopLevelStmt
+			reduce(51), // dotted_id, reduce: TopLevelStmt
+			reduce(51), // quoted_string, reduce: TopLevelStmt
+			reduce(51), // ns_prefix, reduce: TopLevelStmt
+			nil,        // [
+			nil,        // ]
+			reduce(51), // _, reduce: TopLevelStmt
+			nil,        // ,
+			reduce(51), // subgraph, reduce: TopLevelStmt
+			reduce(51), // include, reduce: TopLevelStmt
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(51), // {, reduce: TopLevelStmt
+			reduce(51), // }, reduce: TopLevelStmt
+			nil,        // :
+			reduce(51), // @let, reduce: TopLevelStmt
+			nil,        // =
+			reduce(51), // @template, reduce: TopLevelStmt
+			nil,        // (
+			nil,        // )
+			reduce(51), // @use, reduce: TopLevelStmt
+			reduce(51), // @namespace, reduce: TopLevelStmt
+			reduce(51), // !, reduce: TopLevelStmt
+			reduce(51), // @graph, reduce: TopLevelStmt
+			reduce(51), // @defaults, reduce: TopLevelStmt
+			reduce(51), // @edge_defaults, reduce: TopLevelStmt
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S300
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			reduce(52), // id, reduce: TopLevelStmt
+			reduce(52), // dotted_id, reduce: TopLevelStmt
+			reduce(52), // quoted_string, reduce: TopLevelStmt
+			reduce(52), // ns_prefix, reduce: TopLevelStmt
+			nil,        // [
+			nil,        // ]
+			reduce(52), // _, reduce: TopLevelStmt
+			nil,        // ,
+			reduce(52), // subgraph, reduce: TopLevelStmt
+			reduce(52), // include, reduce: TopLevelStmt
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(52), // {, reduce: TopLevelStmt
+			reduce(52), // }, reduce: TopLevelStmt
+			nil,        // :
+			reduce(52), // @let, reduce: TopLevelStmt
+			nil,        // =
+			reduce(52), // @template, reduce: TopLevelStmt
+			nil,        // (
+			nil,        // )
+			reduce(52), // @use, reduce: TopLevelStmt
+			reduce(52), // @namespace, reduce: TopLevelStmt
+			reduce(52), // !, reduce: TopLevelStmt
+			reduce(52), // @graph, reduce: TopLevelStmt
+			reduce(52), // @defaults, reduce: TopLevelStmt
+			reduce(52), // @edge_defaults, reduce: TopLevelStmt
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S301
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			reduce(53), // id, reduce: TopLevelStmt
+			reduce(53), // dotted_id, reduce: TopLevelStmt
+			reduce(53), // quoted_string, reduce: TopLevelStmt
+			reduce(53), // ns_prefix, reduce: TopLevelStmt
+			nil,        // [
+			nil,        // ]
+			reduce(53), // _, reduce: TopLevelStmt
+			nil,        // ,
+			reduce(53), // subgraph, reduce: TopLevelStmt
+			reduce(53), // include, reduce: TopLevelStmt
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(53), // {, reduce: TopLevelStmt
+			reduce(53), // }, reduce: TopLevelStmt
+			nil,        // :
+			reduce(53), // @let, reduce: TopLevelStmt
+			nil,        // =
+			reduce(53), // @template, reduce: TopLevelStmt
+			nil,        // (
+			nil,        // )
+			reduce(53), // @use, reduce: TopLevelStmt
+			reduce(53), // @namespace, reduce: TopLevelStmt
+			reduce(53), // !, reduce: TopLevelStmt
+			reduce(53), // @graph, reduce: TopLevelStmt
+			reduce(53), // @defaults, reduce: TopLevelStmt
+			reduce(53), // @edge_defaults, reduce: TopLevelStmt
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S302
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			reduce(54), // id, reduce: TopLevelStmt
+			reduce(54), // dotted_id, reduce: TopLevelStmt
+			reduce(54), // quoted_string, reduce: TopLevelStmt
+			reduce(54), // ns_prefix, reduce: TopLevelStmt
+			nil,        // [
+			nil,        // ]
+			reduce(54), // _, reduce: TopLevelStmt
+			nil,        // ,
+			reduce(54), // subgraph, reduce: TopLevelStmt
+			reduce(54), // include, reduce: TopLevelStmt
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(54), // {, reduce: TopLevelStmt
+			reduce(54), // }, reduce: TopLevelStmt
+			nil,        // :
+			reduce(54), // @let, reduce: TopLevelStmt
+			nil,        // =
+			reduce(54), // @template, reduce: TopLevelStmt
+			nil,        // (
+			nil,        // )
+			reduce(54), // @use, reduce: TopLevelStmt
+			reduce(54), // @namespace, reduce: TopLevelStmt
+			reduce(54), // !, reduce: TopLevelStmt
+			reduce(54), // @graph, reduce: TopLevelStmt
+			reduce(54), // @defaults, reduce: TopLevelStmt
+			reduce(54), // @edge_defaults, reduce: TopLevelStmt
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S303
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			reduce(55), // id, reduce: TopLevelStmt
+			reduce(55), // dotted_id, reduce: TopLevelStmt
+			reduce(55), // quoted_string, reduce: TopLevelStmt
+			reduce(55), // ns_prefix, reduce: TopLevelStmt
+			nil,        // [
+			nil,        // ]
+			reduce(55), // _, reduce: TopLevelStmt
+			nil,        // ,
+			reduce(55), // subgraph, reduce: TopLevelStmt
+			reduce(55), // include, reduce: TopLevelStmt
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(55), // {, reduce: TopLevelStmt
+			reduce(55), // }, reduce: TopLevelStmt
+			nil,        // :
+			reduce(55), // @let, reduce: TopLevelStmt
+			nil,        // =
+			reduce(55), // @template, reduce: TopLevelStmt
+			nil,        // (
+			nil,        // )
+			reduce(55), // @use, reduce: TopLevelStmt
+			reduce(55), // @namespace, reduce: TopLevelStmt
+			reduce(55), // !, reduce: TopLevelStmt
+			reduce(55), // @graph, reduce: TopLevelStmt
+			reduce(55), // @defaults, reduce: TopLevelStmt
+			reduce(55), // @edge_defaults, reduce: TopLevelStmt
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S304
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			reduce(56), // id, reduce: TopLevelStmt
+			reduce(56), // dotted_id, reduce: TopLevelStmt
+			reduce(56), // quoted_string, reduce: TopLevelStmt
+			reduce(56), // ns_prefix, reduce: TopLevelStmt
+			nil,        // [
+			nil,        // ]
+			reduce(56), // _, reduce: TopLevelStmt
+			nil,        // ,
+			reduce(56), // subgraph, reduce: TopLevelStmt
+			reduce(56), // include, reduce: TopLevelStmt
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(56), // {, reduce: TopLevelStmt
+			reduce(56), // }, reduce: TopLevelStmt
+			nil,        // :
+			reduce(56), // @let, reduce: TopLevelStmt
+			nil,        // =
+			reduce(56), // @template, reduce: TopLevelStmt
+			nil,        // (
+			nil,        // )
+			reduce(56), // @use, reduce: TopLevelStmt
+			reduce(56), // @namespace, reduce: TopLevelStmt
+			reduce(56), // !, reduce: TopLevelStmt
+			reduce(56), // @graph, reduce: TopLevelStmt
+			reduce(56), // @defaults, reduce: TopLevelStmt
+			reduce(56), // @edge_defaults, reduce: TopLevelStmt
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S305
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			reduce(57), // id, reduce: TopLevelStmt
+			reduce(57), // dotted_id, reduce: TopLevelStmt
+			reduce(57), // quoted_string, reduce: TopLevelStmt
+			reduce(57), // ns_prefix, reduce: TopLevelStmt
+			nil,        // [
+			nil,        // ]
+			reduce(57), // _, reduce: TopLevelStmt
+			nil,        // ,
+			reduce(57), // subgraph, reduce: TopLevelStmt
+			reduce(57), // include, reduce: TopLevelStmt
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(57), // {, reduce: TopLevelStmt
+			reduce(57), // }, reduce: TopLevelStmt
+			nil,        // :
+			reduce(57), // @let, reduce: TopLevelStmt
+			nil,        // =
+			reduce(57), // @template, reduce: TopLevelStmt
+			nil,        // (
+			nil,        // )
+			reduce(57), // @use, reduce: TopLevelStmt
+			reduce(57), // @namespace, reduce: TopLevelStmt
+			reduce(57), // !, reduce: TopLevelStmt
+			reduce(57), // @graph, reduce: TopLevelStmt
+			reduce(57), // @defaults, reduce: TopLevelStmt
+			reduce(57), // @edge_defaults, reduce: TopLevelStmt
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S306
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			reduce(58), // id, reduce: TopLevelStmt
+			reduce(58), // dotted_id, reduce: TopLevelStmt
+			reduce(58), // quoted_string, reduce: TopLevelStmt
+			reduce(58), // ns_prefix, reduce: TopLevelStmt
+			nil,        // [
+			nil,        // ]
+			reduce(58), // _, reduce: TopLevelStmt
+			nil,        // ,
+			reduce(58), // subgraph, reduce: TopLevelStmt
+			reduce(58), // include, reduce: TopLevelStmt
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(58), // {, reduce: TopLevelStmt
+			reduce(58), // }, reduce: TopLevelStmt
+			nil,        // :
+			reduce(58), // @let, reduce: TopLevelStmt
+			nil,        // =
+			reduce(58), // @template, reduce: TopLevelStmt
+			nil,        // (
+			nil,        // )
+			reduce(58), // @use, reduce: TopLevelStmt
+			reduce(58), // @namespace, reduce: TopLevelStmt
+			reduce(58), // !, reduce: TopLevelStmt
+			reduce(58), // @graph, reduce: TopLevelStmt
+			reduce(58), // @defaults, reduce: TopLevelStmt
+			reduce(58), // @edge_defaults, reduce: TopLevelStmt
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S307
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			reduce(59), // id, reduce: TopLevelStmt
+			reduce(59), // dotted_id, reduce: TopLevelStmt
+			reduce(59), // quoted_string, reduce: TopLevelStmt
+			reduce(59), // ns_prefix, reduce: TopLevelStmt
+			nil,        // [
+			nil,        // ]
+			reduce(59), // _, reduce: TopLevelStmt
+			nil,        // ,
+			reduce(59), // subgraph, reduce: TopLevelStmt
+			reduce(59), // include, reduce: TopLevelStmt
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(59), // {, reduce: TopLevelStmt
+			reduce(59), // }, reduce: TopLevelStmt
+			nil,        // :
+			reduce(59), // @let, reduce: TopLevelStmt
+			nil,        // =
+			reduce(59), // @template, reduce: TopLevelStmt
+			nil,        // (
+			nil,        // )
+			reduce(59), // @use, reduce: TopLevelStmt
+			reduce(59), // @namespace, reduce: TopLevelStmt
+			reduce(59), // !, reduce: TopLevelStmt
+			reduce(59), // @graph, reduce: TopLevelStmt
+			reduce(59), // @defaults, reduce: TopLevelStmt
+			reduce(59), // @edge_defaults, reduce: TopLevelStmt
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S308
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // id
+			nil,        // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			nil,        // _
+			nil,        // ,
+			nil,        // subgraph
+			nil,        // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			shift(389), // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S309
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // id
+			nil,        // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			nil,        // _
+			nil,        // ,
+			nil,        // subgraph
+			nil,        // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			shift(390), // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S310
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // id
+			nil,        // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			nil,        // _
+			nil,        // ,
+			nil,        // subgraph
+			nil,        // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			shift(391), // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S311
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // id
+			nil,        // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			nil,        // _
+			nil,        // ,
+			nil,        // subgraph
+			nil,        // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			reduce(7),  // =, reduce: NodeId
+			nil,        // @template
+			shift(392), // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S312
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // id
+			nil,        // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			nil,        // _
+			nil,        // ,
+			nil,        // subgraph
+			nil,        // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			shift(379), // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S313
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(70), // ;, reduce: DeleteDecl
+			reduce(70), // id, reduce: DeleteDecl
+			reduce(70), // dotted_id, reduce: DeleteDecl
+			reduce(70), // quoted_string, reduce: DeleteDecl
+			reduce(70), // ns_prefix, reduce: DeleteDecl
+			reduce(16), // [, reduce: NodeRef
+			nil,        // ]
+			reduce(70), // _, reduce: DeleteDecl
+			nil,        // ,
+			reduce(70), // subgraph, reduce: DeleteDecl
+			reduce(70), // include, reduce: DeleteDecl
+			reduce(16), // edgearrow, reduce: NodeRef
+			reduce(16), // edgeline, reduce: NodeRef
+			reduce(16), // edgebiarrow, reduce: NodeRef
+			reduce(16), // edgebackarrow, reduce: NodeRef
+			reduce(16), // edge_attr_open, reduce: NodeRef
+			reduce(16), // edge_attr_open_head, reduce: NodeRef
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(70), // {, reduce: DeleteDecl
+			reduce(70), // }, reduce: DeleteDecl
+			shift(36),  // :
+			reduce(70), // @let, reduce: DeleteDecl
+			nil,        // =
+			reduce(70), // @template, reduce: DeleteDecl
+			nil,        // (
+			nil,        // )
+			reduce(70), // @use, reduce: DeleteDecl
+			reduce(70), // @namespace, reduce: DeleteDecl
+			reduce(70), // !, reduce: DeleteDecl
+			reduce(70), // @graph, reduce: DeleteDecl
+			reduce(70), // @defaults, reduce: DeleteDecl
+			reduce(70), // @edge_defaults, reduce: DeleteDecl
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S314
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(71), // ;, reduce: DeleteDecl
+			reduce(71), // id, reduce: DeleteDecl
+			reduce(71), // dotted_id, reduce: DeleteDecl
+			reduce(71), // quoted_string, reduce: DeleteDecl
+			reduce(71), // ns_prefix, reduce: DeleteDecl
+			nil,        // [
+			nil,        // ]
+			reduce(71), // _, reduce: DeleteDecl
+			nil,        // ,
+			reduce(71), // subgraph, reduce: DeleteDecl
+			reduce(71), // include, reduce: DeleteDecl
+			shift(48),  // edgearrow
+			shift(49),  // edgeline
+			shift(50),  // edgebiarrow
+			shift(51),  // edgebackarrow
+			shift(53),  // edge_attr_open
+			shift(54),  // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(71), // {, reduce: DeleteDecl
+			reduce(71), // }, reduce: DeleteDecl
+			nil,        // :
+			reduce(71), // @let, reduce: DeleteDecl
+			nil,        // =
+			reduce(71), // @template, reduce: DeleteDecl
+			nil,        // (
+			nil,        // )
+			reduce(71), // @use, reduce: DeleteDecl
+			reduce(71), // @namespace, reduce: DeleteDecl
+			reduce(71), // !, reduce: DeleteDecl
+			reduce(71), // @graph, reduce: DeleteDecl
+			reduce(71), // @defaults, reduce: DeleteDecl
+			reduce(71), // @edge_defaults, reduce: DeleteDecl
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S315
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			shift(155), // id
+			shift(107), // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			shift(157), // _
+			nil,        // ,
+			shift(159), // subgraph
+			shift(160), // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			shift(115), // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S316
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // id
+			nil,        // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			shift(395), // [
+			nil,        // ]
+			nil,        // _
+			nil,        // ,
+			nil,        // subgraph
+			nil,        // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S317
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // id
+			nil,        // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			shift(396), // [
+			nil,        // ]
+			nil,        // _
+			nil,        // ,
+			nil,        // subgraph
+			nil,        // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S318
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(43), // ␚, reduce: EdgeRef
+			nil,        // empty
+			reduce(43), // ;, reduce: EdgeRef
+			reduce(43), // id, reduce: EdgeRef
+			reduce(43), // dotted_id, reduce: EdgeRef
+			reduce(43), // quoted_string, reduce: EdgeRef
+			reduce(43), // ns_prefix, reduce: EdgeRef
+			nil,        // [
+			nil,        // ]
+			reduce(43), // _, reduce: EdgeRef
+			nil,        // ,
+			reduce(43), // subgraph, reduce: EdgeRef
+			reduce(43), // include, reduce: EdgeRef
+			reduce(43), // edgearrow, reduce: EdgeRef
+			reduce(43), // edgeline, reduce: EdgeRef
+			reduce(43), // edgebiarrow, reduce: EdgeRef
+			reduce(43), // edgebackarrow, reduce: EdgeRef
+			reduce(43), // edge_attr_open, reduce: EdgeRef
+			reduce(43), // edge_attr_open_head, reduce: EdgeRef
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(43), // {, reduce: EdgeRef
+			nil,        // }
+			nil,        // :
+			reduce(43), // @let, reduce: EdgeRef
+			nil,        // =
+			reduce(43), // @template, reduce: EdgeRef
+			nil,        // (
+			nil,        // )
+			reduce(43), // @use, reduce: EdgeRef
+			reduce(43), // @namespace, reduce: EdgeRef
+			reduce(43), // !, reduce: EdgeRef
+			reduce(43), // @graph, reduce: EdgeRef
+			reduce(43), // @defaults, reduce: EdgeRef
+			reduce(43), // @edge_defaults, reduce: EdgeRef
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S319
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			reduce(7), // ␚, reduce: NodeId
+			nil,       // empty
+			reduce(7), // ;, reduce: NodeId
+			reduce(7), // id, reduce: NodeId
+			reduce(7), // dotted_id, reduce: NodeId
+			reduce(7), // quoted_string, reduce: NodeId
+			reduce(7), // ns_prefix, reduce: NodeId
+			nil,       // [
+			nil,       // ]
+			reduce(7), // _, reduce: NodeId
+			nil,       // ,
+			reduce(7), // subgraph, reduce: NodeId
+			reduce(7), // include, reduce: NodeId
+			reduce(7), // edgearrow, reduce: NodeId
+			reduce(7), // edgeline, reduce: NodeId
+			reduce(7), // edgebiarrow, reduce: NodeId
+			reduce(7), // edgebackarrow, reduce: NodeId
+			reduce(7), // edge_attr_open, reduce: NodeId
+			reduce(7), // edge_attr_open_head, reduce: NodeId
+			nil,       // edge_attr_close
+			nil,       // edge_attr_close_nohead
+			nil,       // edge_key
+			reduce(7), // {, reduce: NodeId
+			nil,       // }
+			nil,       // :
+			reduce(7), // @let, reduce: NodeId
+			nil,       // =
+			reduce(7), // @template, reduce: NodeId
+			nil,       // (
+			nil,       // )
+			reduce(7), // @use, reduce: NodeId
+			reduce(7), // @namespace, reduce: NodeId
+			reduce(7), // !, reduce: NodeId
+			reduce(7), // @graph, reduce: NodeId
+			reduce(7), // @defaults, reduce: NodeId
+			reduce(7), // @edge_defaults, reduce: NodeId
+			nil,       // numeric_literal
+			nil,       // raw_string
+			nil,       // param_ref
+		},
+	},
+	actionRow{ // S320
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			reduce(8), // ␚, reduce: NodeId
+			nil,       // empty
+			reduce(8), // ;, reduce: NodeId
+			reduce(8), // id, reduce: NodeId
+			reduce(8), // dotted_id, reduce: NodeId
+			reduce(8), // quoted_string, reduce: NodeId
+			reduce(8), // ns_prefix, reduce: NodeId
+			nil,       // [
+			nil,       // ]
+			reduce(8), // _, reduce: NodeId
+			nil,       // ,
+			reduce(8), // subgraph, reduce: NodeId
+			reduce(8), // include, reduce: NodeId
+			reduce(8), // edgearrow, reduce: NodeId
+			reduce(8), // edgeline, reduce: NodeId
+			reduce(8), // edgebiarrow, reduce: NodeId
+			reduce(8), // edgebackarrow, reduce: NodeId
+			reduce(8), // edge_attr_open, reduce: NodeId
+			reduce(8), // edge_attr_open_head, reduce: NodeId
+			nil,       // edge_attr_close
+			nil,       // edge_attr_close_nohead
+			nil,       // edge_key
+			reduce(8), // {, reduce: NodeId
+			nil,       // }
+			nil,       // :
+			reduce(8), // @let, reduce: NodeId
+			nil,       // =
+			reduce(8), // @template, reduce: NodeId
+			nil,       // (
+			nil,       // )
+			reduce(8), // @use, reduce: NodeId
+			reduce(8), // @namespace, reduce: NodeId
+			reduce(8), // !, reduce: NodeId
+			reduce(8), // @graph, reduce: NodeId
+			reduce(8), // @defaults, reduce: NodeId
+			reduce(8), // @edge_defaults, reduce: NodeId
+			nil,       // numeric_literal
+			nil,       // raw_string
+			nil,       // param_ref
+		},
+	},
+	actionRow{ // S321
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			reduce(9), // ␚, reduce: NodeId
+			nil,       // empty
+			reduce(9), // ;, reduce: NodeId
+			reduce(9), // id, reduce: NodeId
+			reduce(9), // dotted_id, reduce: NodeId
+			reduce(9), // quoted_string, reduce: NodeId
+			reduce(9), // ns_prefix, reduce: NodeId
+			nil,       // [
+			nil,       // ]
+			reduce(9), // _, reduce: NodeId
+			nil,       // ,
+			reduce(9), // subgraph, reduce: NodeId
+			reduce(9), // include, reduce: NodeId
+			reduce(9), // edgearrow, reduce: NodeId
+			reduce(9), // edgeline, reduce: NodeId
+			reduce(9), // edgebiarrow, reduce: NodeId
+			reduce(9), // edgebackarrow, reduce: NodeId
+			reduce(9), // edge_attr_open, reduce: NodeId
+			reduce(9), // edge_attr_open_head, reduce: NodeId
+			nil,       // edge_attr_close
+			nil,       // edge_attr_close_nohead
+			nil,       // edge_key
+			reduce(9), // {, reduce: NodeId
+			nil,       // }
+			nil,       // :
+			reduce(9), // @let, reduce: NodeId
+			nil,       // =
+			reduce(9), // @template, reduce: NodeId
+			nil,       // (
+			nil,       // )
+			reduce(9), // @use, reduce: NodeId
+			reduce(9), // @namespace, reduce: NodeId
+			reduce(9), // !, reduce: NodeId
+			reduce(9), // @graph, reduce: NodeId
+			reduce(9), // @defaults, reduce: NodeId
+			reduce(9), // @edge_defaults, reduce: NodeId
+			nil,       // numeric_literal
+			nil,       // raw_string
+			nil,       // param_ref
+		},
+	},
+	actionRow{ // S322
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // id
+			nil,        // dotted_id
+			shift(397), // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			nil,        // _
+			nil,        // ,
+			nil,        // subgraph
+			nil,        // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S323
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(41), // ␚, reduce: EdgeEnd
+			nil,        // empty
+			reduce(41), // ;, reduce: EdgeEnd
+			reduce(41), // id, reduce: EdgeEnd
+			reduce(41), // dotted_id, reduce: EdgeEnd
+			reduce(41), // quoted_string, reduce: EdgeEnd
+			reduce(41), // ns_prefix, reduce: EdgeEnd
+			nil,        // [
+			nil,        // ]
+			reduce(41), // _, reduce: EdgeEnd
+			nil,        // ,
+			reduce(41), // subgraph, reduce: EdgeEnd
+			reduce(41), // include, reduce: EdgeEnd
+			reduce(41), // edgearrow, reduce: EdgeEnd
+			reduce(41), // edgeline, reduce: EdgeEnd
+			reduce(41), // edgebiarrow, reduce: EdgeEnd
+			reduce(41), // edgebackarrow, reduce: EdgeEnd
+			reduce(41), // edge_attr_open, reduce: EdgeEnd
+			reduce(41), // edge_attr_open_head, reduce: EdgeEnd
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(41), // {, reduce: EdgeEnd
+			nil,        // }
+			nil,        // :
+			reduce(41), // @let, reduce: EdgeEnd
+			nil,        // =
+			reduce(41), // @template, reduce: EdgeEnd
+			nil,        // (
+			nil,        // )
+			reduce(41), // @use, reduce: EdgeEnd
+			reduce(41), // @namespace, reduce: EdgeEnd
+			reduce(41), // !, reduce: EdgeEnd
+			reduce(41), // @graph, reduce: EdgeEnd
+			reduce(41), // @defaults, reduce: EdgeEnd
+			reduce(41), // @edge_defaults, reduce: EdgeEnd
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S324
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(37), // ␚, reduce: EdgeRHS
+			nil,        // empty
+			reduce(37), // ;, reduce: EdgeRHS
+			reduce(37), // id, reduce: EdgeRHS
+			reduce(37), // dotted_id, reduce: EdgeRHS
+			reduce(37), // quoted_string, reduce: EdgeRHS
+			reduce(37), // ns_prefix, reduce: EdgeRHS
+			nil,        // [
+			nil,        // ]
+			reduce(37), // _, reduce: EdgeRHS
+			nil,        // ,
+			reduce(37), // subgraph, reduce: EdgeRHS
+			reduce(37), // include, reduce: EdgeRHS
+			reduce(37), // edgearrow, reduce: EdgeRHS
+			reduce(37), // edgeline, reduce: EdgeRHS
+			reduce(37), // edgebiarrow, reduce: EdgeRHS
+			reduce(37), // edgebackarrow, reduce: EdgeRHS
+			reduce(37), // edge_attr_open, reduce: EdgeRHS
+			reduce(37), // edge_attr_open_head, reduce: EdgeRHS
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(37), // {, reduce: EdgeRHS
+			nil,        // }
+			nil,        // :
+			reduce(37), // @let, reduce: EdgeRHS
+			nil,        // =
+			reduce(37), // @template, reduce: EdgeRHS
+			nil,        // (
+			nil,        // )
+			reduce(37), // @use, reduce: EdgeRHS
+			reduce(37), // @namespace, reduce: EdgeRHS
+			reduce(37), // !, reduce: EdgeRHS
+			reduce(37), // @graph, reduce: EdgeRHS
+			reduce(37), // @defaults, reduce: EdgeRHS
+			reduce(37), // @edge_defaults, reduce: EdgeRHS
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S325
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			shift(155), // id
+			shift(107), // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			shift(157), // _
+			nil,        // ,
+			shift(159), // subgraph
+			shift(160), // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			shift(134), // edge_attr_close
+			shift(135), // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			shift(138), // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S326
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			shift(5),   // id
+			shift(6),   // dotted_id
+			shift(7),   // quoted_string
+			shift(8),   // ns_prefix
+			nil,        // [
+			nil,        // ]
+			shift(11),  // _
+			nil,        // ,
+			nil,        // subgraph
+			nil,        // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			shift(126), // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S327
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			reduce(86), // id, reduce: OptAttrSep
+			reduce(86), // dotted_id, reduce: OptAttrSep
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			reduce(86), // _, reduce: OptAttrSep
+			nil,        // ,
+			reduce(86), // subgraph, reduce: OptAttrSep
+			reduce(86), // include, reduce: OptAttrSep
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			reduce(86), // edge_attr_close, reduce: OptAttrSep
+			reduce(86), // edge_attr_close_nohead, reduce: OptAttrSep
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			reduce(86), // !, reduce: OptAttrSep
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S328
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			reduce(88), // id, reduce: Attr
+			reduce(88), // dotted_id, reduce: Attr
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			reduce(88), // _, reduce: Attr
+			nil,        // ,
+			reduce(88), // subgraph, reduce: Attr
+			reduce(88), // include, reduce: Attr
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			reduce(88), // edge_attr_close, reduce: Attr
+			reduce(88), // edge_attr_close_nohead, reduce: Attr
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			reduce(88), // !, reduce: Attr
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S329
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			reduce(96), // id, reduce: ScalarVal
+			reduce(96), // dotted_id, reduce: ScalarVal
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			reduce(96), // _, reduce: ScalarVal
+			reduce(96), // ,, reduce: ScalarVal
+			reduce(96), // subgraph, reduce: ScalarVal
+			reduce(96), // include, reduce: ScalarVal
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			reduce(96), // edge_attr_close, reduce: ScalarVal
+			reduce(96), // edge_attr_close_nohead, reduce: ScalarVal
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			reduce(96), // !, reduce: ScalarVal
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S330
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			shift(359), // id
+			nil,        // dotted_id
+			shift(360), // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			shift(400), // ]
+			shift(362), // _
+			nil,        // ,
+			shift(364), // subgraph
+			shift(365), // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			shift(368), // numeric_literal
+			shift(369), // raw_string
+			shift(370), // param_ref
+		},
+	},
+	actionRow{ // S331
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			reduce(94), // id, reduce: ScalarVal
+			reduce(94), // dotted_id, reduce: ScalarVal
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			reduce(94), // _, reduce: ScalarVal
+			reduce(94), // ,, reduce: ScalarVal
+			reduce(94), // subgraph, reduce: ScalarVal
+			reduce(94), // include, reduce: ScalarVal
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			reduce(94), // edge_attr_close, reduce: ScalarVal
+			reduce(94), // edge_attr_close_nohead, reduce: ScalarVal
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			reduce(94), // !, reduce: ScalarVal
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S332
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			reduce(91), // id, reduce: AttrVal
+			reduce(91), // dotted_id, reduce: AttrVal
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			reduce(91), // _, reduce: AttrVal
+			reduce(91), // ,, reduce: AttrVal
+			reduce(91), // subgraph, reduce: AttrVal
+			reduce(91), // include, reduce: AttrVal
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			reduce(91), // edge_attr_close, reduce: AttrVal
+			reduce(91), // edge_attr_close_nohead, reduce: AttrVal
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			reduce(91), // !, reduce: AttrVal
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S333
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			reduce(85), // id, reduce: OptAttrSep
+			reduce(85), // dotted_id, reduce: OptAttrSep
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			reduce(85), // _, reduce: OptAttrSep
+			shift(327), // ,
+			reduce(85), // subgraph, reduce: OptAttrSep
+			reduce(85), // include, reduce: OptAttrSep
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			reduce(85), // edge_attr_close, reduce: OptAttrSep
+			reduce(85), // edge_attr_close_nohead, reduce: OptAttrSep
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			reduce(85), // !, reduce: OptAttrSep
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S334
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			reduce(95), // id, reduce: ScalarVal
+			reduce(95), // dotted_id, reduce: ScalarVal
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			reduce(95), // _, reduce: ScalarVal
+			reduce(95), // ,, reduce: ScalarVal
+			reduce(95), // subgraph, reduce: ScalarVal
+			reduce(95), // include, reduce: ScalarVal
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			reduce(95), // edge_attr_close, reduce: ScalarVal
+			reduce(95), // edge_attr_close_nohead, reduce: ScalarVal
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			reduce(95), // !, reduce: ScalarVal
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S335
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			reduce(97), // id, reduce: ScalarVal
+			reduce(97), // dotted_id, reduce: ScalarVal
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			reduce(97), // _, reduce: ScalarVal
+			reduce(97), // ,, reduce: ScalarVal
+			reduce(97), // subgraph, reduce: ScalarVal
+			reduce(97), // include, reduce: ScalarVal
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			reduce(97), // edge_attr_close, reduce: ScalarVal
+			reduce(97), // edge_attr_close_nohead, reduce: ScalarVal
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			reduce(97), // !, reduce: ScalarVal
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S336
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			reduce(98), // id, reduce: ScalarVal
+			reduce(98), // dotted_id, reduce: ScalarVal
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			reduce(98), // _, reduce: ScalarVal
+			reduce(98), // ,, reduce: ScalarVal
+			reduce(98), // subgraph, reduce: ScalarVal
+			reduce(98), // include, reduce: ScalarVal
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			reduce(98), // edge_attr_close, reduce: ScalarVal
+			reduce(98), // edge_attr_close_nohead, reduce: ScalarVal
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			reduce(98), // !, reduce: ScalarVal
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S337
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			reduce(10), // id, reduce: NodeId
+			reduce(10), // dotted_id, reduce: NodeId
+			reduce(10), // quoted_string, reduce: NodeId
+			reduce(10), // ns_prefix, reduce: NodeId
+			nil,        // [
+			nil,        // ]
+			reduce(10), // _, reduce: NodeId
+			reduce(10), // ,, reduce: NodeId
+			nil,        // subgraph
+			nil,        // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			reduce(10), // }, reduce: NodeId
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S338
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			reduce(11), // id, reduce: NodeDecl
+			reduce(11), // dotted_id, reduce: NodeDecl
+			reduce(11), // quoted_string, reduce: NodeDecl
+			reduce(11), // ns_prefix, reduce: NodeDecl
+			nil,        // [
+			nil,        // ]
+			reduce(11), // _, reduce: NodeDecl
+			reduce(11), // ,, reduce: NodeDecl
+			nil,        // subgraph
+			nil,        // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			reduce(11), // }, reduce: NodeDecl
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S339
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			shift(155), // id
+			shift(107), // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			shift(404), // ]
+			shift(157), // _
+			nil,        // ,
+			shift(159), // subgraph
+			shift(160), // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			shift(115), // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S340
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(61), // ␚, reduce: TemplateDecl
+			nil,        // empty
+			reduce(61), // ;, reduce: TemplateDecl
+			reduce(61), // id, reduce: TemplateDecl
+			reduce(61), // dotted_id, reduce: TemplateDecl
+			reduce(61), // quoted_string, reduce: TemplateDecl
+			reduce(61), // ns_prefix, reduce: TemplateDecl
+			nil,        // [
+			nil,        // ]
+			reduce(61), // _, reduce: TemplateDecl
+			nil,        // ,
+			reduce(61), // subgraph, reduce: TemplateDecl
+			reduce(61), // include, reduce: TemplateDecl
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(61), // {, reduce: TemplateDecl
+			nil,        // }
+			nil,        // :
+			reduce(61), // @let, reduce: TemplateDecl
+			nil,        // =
+			reduce(61), // @template, reduce: TemplateDecl
+			nil,        // (
+			nil,        // )
+			reduce(61), // @use, reduce: TemplateDecl
+			reduce(61), // @namespace, reduce: TemplateDecl
+			reduce(61), // !, reduce: TemplateDecl
+			reduce(61), // @graph, reduce: TemplateDecl
+			reduce(61), // @defaults, reduce: TemplateDecl
+			reduce(61), // @edge_defaults, reduce: TemplateDecl
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S341
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			shift(405), // id
+			nil,        // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			nil,        // _
+			nil,        // ,
+			nil,        // subgraph
+			nil,        // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S342
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // id
+			nil,        // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			nil,        // _
+			nil,        // ,
+			nil,        // subgraph
+			nil,        // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			shift(119), // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S343
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			shift(155), // id
+			shift(107), // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			shift(157), // _
+			nil,        // ,
+			shift(159), // subgraph
+			shift(160), // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			shift(408), // )
+			nil,        // @use
+			nil,        // @namespace
+			shift(257), // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S344
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(66), // ␚, reduce: TemplateUseDecl
+			nil,        // empty
+			reduce(66), // ;, reduce: TemplateUseDecl
+			reduce(66), // id, reduce: TemplateUseDecl
+			reduce(66), // dotted_id, reduce: TemplateUseDecl
+			reduce(66), // quoted_string, reduce: TemplateUseDecl
+			reduce(66), // ns_prefix, reduce: TemplateUseDecl
+			nil,        // [
+			nil,        // ]
+			reduce(66), // _, reduce: TemplateUseDecl
+			nil,        // ,
+			reduce(66), // subgraph, reduce: TemplateUseDecl
+			reduce(66), // include, reduce: TemplateUseDecl
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(66), // {, reduce: TemplateUseDecl
+			nil,        // }
+			nil,        // :
+			reduce(66), // @let, reduce: TemplateUseDecl
+			nil,        // =
+			reduce(66), // @template, reduce: TemplateUseDecl
+			nil,        // (
+			nil,        // )
+			reduce(66), // @use, reduce: TemplateUseDecl
+			reduce(66), // @namespace, reduce: TemplateUseDecl
+			reduce(66), // !, reduce: TemplateUseDecl
+			reduce(66), // @graph, reduce: TemplateUseDecl
+			reduce(66), // @defaults, reduce: TemplateUseDecl
+			reduce(66), // @edge_defaults, reduce: TemplateUseDecl
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S345
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			reduce(84), // id, reduce: AttrItems
+			reduce(84), // dotted_id, reduce: AttrItems
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			reduce(84), // _, reduce: AttrItems
+			nil,        // ,
+			reduce(84), // subgraph, reduce: AttrItems
+			reduce(84), // include, reduce: AttrItems
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			reduce(84), // ), reduce: AttrItems
+			nil,        // @use
+			nil,        // @namespace
+			reduce(84), // !, reduce: AttrItems
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S346
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			reduce(20), // id, reduce: Word
+			reduce(20), // dotted_id, reduce: Word
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			reduce(20), // _, reduce: Word
+			reduce(20), // ,, reduce: Word
+			reduce(20), // subgraph, reduce: Word
+			reduce(20), // include, reduce: Word
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			reduce(20), // ), reduce: Word
+			nil,        // @use
+			nil,        // @namespace
+			reduce(20), // !, reduce: Word
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S347
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			reduce(90), // id, reduce: AttrKey
+			reduce(90), // dotted_id, reduce: AttrKey
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			reduce(90), // _, reduce: AttrKey
+			reduce(90), // ,, reduce: AttrKey
+			reduce(90), // subgraph, reduce: AttrKey
+			reduce(90), // include, reduce: AttrKey
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			reduce(90), // ), reduce: AttrKey
+			nil,        // @use
+			nil,        // @namespace
+			reduce(90), // !, reduce: AttrKey
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S348
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			reduce(23), // id, reduce: Word
+			reduce(23), // dotted_id, reduce: Word
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			reduce(23), // _, reduce: Word
+			reduce(23), // ,, reduce: Word
+			reduce(23), // subgraph, reduce: Word
+			reduce(23), // include, reduce: Word
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			reduce(23), // ), reduce: Word
+			nil,        // @use
+			nil,        // @namespace
+			reduce(23), // !, reduce: Word
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S349
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			reduce(89), // id, reduce: AttrKey
+			reduce(89), // dotted_id, reduce: AttrKey
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			reduce(89), // _, reduce: AttrKey
+			reduce(89), // ,, reduce: AttrKey
+			reduce(89), // subgraph, reduce: AttrKey
+			reduce(89), // include, reduce: AttrKey
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			reduce(89), // ), reduce: AttrKey
+			nil,        // @use
+			nil,        // @namespace
+			reduce(89), // !, reduce: AttrKey
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S350
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			reduce(21), // id, reduce: Word
+			reduce(21), // dotted_id, reduce: Word
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			reduce(21), // _, reduce: Word
+			reduce(21), // ,, reduce: Word
+			reduce(21), // subgraph, reduce: Word
+			reduce(21), // include, reduce: Word
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			reduce(21), // ), reduce: Word
+			nil,        // @use
+			nil,        // @namespace
+			reduce(21), // !, reduce: Word
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S351
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			reduce(22), // id, reduce: Word
+			reduce(22), // dotted_id, reduce: Word
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			reduce(22), // _, reduce: Word
+			reduce(22), // ,, reduce: Word
+			reduce(22), // subgraph, reduce: Word
+			reduce(22), // include, reduce: Word
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			reduce(22), // ), reduce: Word
+			nil,        // @use
+			nil,        // @namespace
+			reduce(22), // !, reduce: Word
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S352
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			reduce(85), // id, reduce: OptAttrSep
+			reduce(85), // dotted_id, reduce: OptAttrSep
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			reduce(85), // _, reduce: OptAttrSep
+			shift(409), // ,
+			reduce(85), // subgraph, reduce: OptAttrSep
+			reduce(85), // include, reduce: OptAttrSep
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			reduce(85), // ), reduce: OptAttrSep
+			nil,        // @use
+			nil,        // @namespace
+			reduce(85), // !, reduce: OptAttrSep
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S353
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			shift(346), // id
+			nil,        // dotted_id
+			shift(411), // quoted_string
+			nil,        // ns_prefix
+			shift(412), // [
+			nil,        // ]
+			shift(348), // _
+			nil,        // ,
+			shift(350), // subgraph
+			shift(351), // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			shift(416), // numeric_literal
+			shift(417), // raw_string
+			shift(418), // param_ref
+		},
+	},
+	actionRow{ // S354
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // id
+			nil,        // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			nil,        // _
+			nil,        // ,
+			nil,        // subgraph
+			nil,        // include
+			reduce(11), // edgearrow, reduce: NodeDecl
+			reduce(11), // edgeline, reduce: NodeDecl
+			reduce(11), // edgebiarrow, reduce: NodeDecl
+			reduce(11), // edgebackarrow, reduce: NodeDecl
+			reduce(11), // edge_attr_open, reduce: NodeDecl
+			reduce(11), // edge_attr_open_head, reduce: NodeDecl
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S355
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			shift(155), // id
+			shift(107), // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			shift(420), // ]
+			shift(157), // _
+			nil,        // ,
+			shift(159), // subgraph
+			shift(160), // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			shift(115), // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S356
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(73), // ␚, reduce: DefaultsDecl
+			nil,        // empty
+			reduce(73), // ;, reduce: DefaultsDecl
+			reduce(73), // id, reduce: DefaultsDecl
+			reduce(73), // dotted_id, reduce: DefaultsDecl
+			reduce(73), // quoted_string, reduce: DefaultsDecl
+			reduce(73), // ns_prefix, reduce: DefaultsDecl
+			nil,        // [
+			nil,        // ]
+			reduce(73), // _, reduce: DefaultsDecl
+			nil,        // ,
+			reduce(73), // subgraph, reduce: DefaultsDecl
+			reduce(73), // include, reduce: DefaultsDecl
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(73), // {, reduce: DefaultsDecl
+			nil,        // }
+			nil,        // :
+			reduce(73), // @let, reduce: DefaultsDecl
+			nil,        // =
+			reduce(73), // @template, reduce: DefaultsDecl
+			nil,        // (
+			nil,        // )
+			reduce(73), // @use, reduce: DefaultsDecl
+			reduce(73), // @namespace, reduce: DefaultsDecl
+			reduce(73), // !, reduce: DefaultsDecl
+			reduce(73), // @graph, reduce: DefaultsDecl
+			reduce(73), // @defaults, reduce: DefaultsDecl
+			reduce(73), // @edge_defaults, reduce: DefaultsDecl
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S357
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(74), // ␚, reduce: DefaultsDecl
+			nil,        // empty
+			reduce(74), // ;, reduce: DefaultsDecl
+			reduce(74), // id, reduce: DefaultsDecl
+			reduce(74), // dotted_id, reduce: DefaultsDecl
+			reduce(74), // quoted_string, reduce: DefaultsDecl
+			reduce(74), // ns_prefix, reduce: DefaultsDecl
+			nil,        // [
+			nil,        // ]
+			reduce(74), // _, reduce: DefaultsDecl
+			nil,        // ,
+			reduce(74), // subgraph, reduce: DefaultsDecl
+			reduce(74), // include, reduce: DefaultsDecl
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(74), // {, reduce: DefaultsDecl
+			nil,        // }
+			nil,        // :
+			reduce(74), // @let, reduce: DefaultsDecl
+			nil,        // =
+			reduce(74), // @template, reduce: DefaultsDecl
+			nil,        // (
+			nil,        // )
+			reduce(74), // @use, reduce: DefaultsDecl
+			reduce(74), // @namespace, reduce: DefaultsDecl
+			reduce(74), // !, reduce: DefaultsDecl
+			reduce(74), // @graph, reduce: DefaultsDecl
+			reduce(74), // @defaults, reduce: DefaultsDecl
+			reduce(74), // @edge_defaults, reduce: DefaultsDecl
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S358
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(12), // ␚, reduce: NodeDecl
+			nil,        // empty
+			reduce(12), // ;, reduce: NodeDecl
+			reduce(12), // id, reduce: NodeDecl
+			reduce(12), // dotted_id, reduce: NodeDecl
+			reduce(12), // quoted_string, reduce: NodeDecl
+			reduce(12), // ns_prefix, reduce: NodeDecl
+			nil,        // [
+			nil,        // ]
+			reduce(12), // _, reduce: NodeDecl
+			nil,        // ,
+			reduce(12), // subgraph, reduce: NodeDecl
+			reduce(12), // include, reduce: NodeDecl
+			reduce(12), // edgearrow, reduce: NodeDecl
+			reduce(12), // edgeline, reduce: NodeDecl
+			reduce(12), // edgebiarrow, reduce: NodeDecl
+			reduce(12), // edgebackarrow, reduce: NodeDecl
+			reduce(12), // edge_attr_open, reduce: NodeDecl
+			reduce(12), // edge_attr_open_head, reduce: NodeDecl
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(12), // {, reduce: NodeDecl
+			nil,        // }
+			nil,        // :
+			reduce(12), // @let, reduce: NodeDecl
+			nil,        // =
+			reduce(12), // @template, reduce: NodeDecl
+			nil,        // (
+			nil,        // )
+			reduce(12), // @use, reduce: NodeDecl
+			reduce(12), // @namespace, reduce: NodeDecl
+			reduce(12), // !, reduce: NodeDecl
+			reduce(12), // @graph, reduce: NodeDecl
+			reduce(12), // @defaults, reduce: NodeDecl
+			reduce(12), // @edge_defaults, reduce: NodeDecl
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S359
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			reduce(20), // id, reduce: Word
+			nil,        // dotted_id
+			reduce(20), // quoted_string, reduce: Word
+			nil,        // ns_prefix
+			nil,        // [
+			reduce(20), // ], reduce: Word
+			reduce(20), // _, reduce: Word
+			reduce(20), // ,, reduce: Word
+			reduce(20), // subgraph, reduce: Word
+			reduce(20), // include, reduce: Word
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			reduce(20), // numeric_literal, reduce: Word
+			reduce(20), // raw_string, reduce: Word
+			reduce(20), // param_ref, reduce: Word
+		},
+	},
+	actionRow{ // S360
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			reduce(96), // id, reduce: ScalarVal
+			nil,        // dotted_id
+			reduce(96), // quoted_string, reduce: ScalarVal
+			nil,        // ns_prefix
+			nil,        // [
+			reduce(96), // ], reduce: ScalarVal
+			reduce(96), // _, reduce: ScalarVal
+			reduce(96), // ,, reduce: ScalarVal
+			reduce(96), // subgraph, reduce: ScalarVal
+			reduce(96), // include, reduce: ScalarVal
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			reduce(96), // numeric_literal, reduce: ScalarVal
+			reduce(96), // raw_string, reduce: ScalarVal
+			reduce(96), // param_ref, reduce: ScalarVal
+		},
+	},
+	actionRow{ // S361
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			reduce(92), // id, reduce: AttrVal
+			reduce(92), // dotted_id, reduce: AttrVal
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			reduce(92), // ], reduce: AttrVal
+			reduce(92), // _, reduce: AttrVal
+			reduce(92), // ,, reduce: AttrVal
+			reduce(92), // subgraph, reduce: AttrVal
+			reduce(92), // include, reduce: AttrVal
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			reduce(92), // !, reduce: AttrVal
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S362
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			reduce(23), // id, reduce: Word
+			nil,        // dotted_id
+			reduce(23), // quoted_string, reduce: Word
+			nil,        // ns_prefix
+			nil,        // [
+			reduce(23), // ], reduce: Word
+			reduce(23), // _, reduce: Word
+			reduce(23), // ,, reduce: Word
+			reduce(23), // subgraph, reduce: Word
+			reduce(23), // include, reduce: Word
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			reduce(23), // numeric_literal, reduce: Word
+			reduce(23), // raw_string, reduce: Word
+			reduce(23), // param_ref, reduce: Word
+		},
+	},
+	actionRow{ // S363
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			reduce(94), // id, reduce: ScalarVal
+			nil,        // dotted_id
+			reduce(94), // quoted_string, reduce: ScalarVal
+			nil,        // ns_prefix
+			nil,        // [
+			reduce(94), // ], reduce: ScalarVal
+			reduce(94), // _, reduce: ScalarVal
+			reduce(94), // ,, reduce: ScalarVal
+			reduce(94), // subgraph, reduce: ScalarVal
+			reduce(94), // include, reduce: ScalarVal
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			reduce(94), // numeric_literal, reduce: ScalarVal
+			reduce(94), // raw_string, reduce: ScalarVal
+			reduce(94), // param_ref, reduce: ScalarVal
+		},
+	},
+	actionRow{ // S364
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			reduce(21), // id, reduce: Word
+			nil,        // dotted_id
+			reduce(21), // quoted_string, reduce: Word
+			nil,        // ns_prefix
+			nil,        // [
+			reduce(21), // ], reduce: Word
+			reduce(21), // _, reduce: Word
+			reduce(21), // ,, reduce: Word
+			reduce(21), // subgraph, reduce: Word
+			reduce(21), // include, reduce: Word
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			reduce(21), // numeric_literal, reduce: Word
+			reduce(21), // raw_string, reduce: Word
+			reduce(21), // param_ref, reduce: Word
+		},
+	},
+	actionRow{ // S365
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			reduce(22), // id, reduce: Word
+			nil,        // dotted_id
+			reduce(22), // quoted_string, reduce: Word
+			nil,        // ns_prefix
+			nil,        // [
+			reduce(22), // ], reduce: Word
+			reduce(22), // _, reduce: Word
+			reduce(22), // ,, reduce: Word
+			reduce(22), // subgraph, reduce: Word
+			reduce(22), // include, reduce: Word
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			reduce(22), // numeric_literal, reduce: Word
+			reduce(22), // raw_string, reduce: Word
+			reduce(22), // param_ref, reduce: Word
+		},
+	},
+	actionRow{ // S366
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			reduce(85), // id, reduce: OptAttrSep
+			nil,        // dotted_id
+			reduce(85), // quoted_string, reduce: OptAttrSep
+			nil,        // ns_prefix
+			nil,        // [
+			reduce(85), // ], reduce: OptAttrSep
+			reduce(85), // _, reduce: OptAttrSep
+			shift(421), // ,
+			reduce(85), // subgraph, reduce: OptAttrSep
+			reduce(85), // include, reduce: OptAttrSep
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			reduce(85), // numeric_literal, reduce: OptAttrSep
+			reduce(85), // raw_string, reduce: OptAttrSep
+			reduce(85), // param_ref, reduce: OptAttrSep
+		},
+	},
+	actionRow{ // S367
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			shift(359), // id
+			nil,        // dotted_id
+			shift(360), // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			shift(423), // ]
+			shift(362), // _
+			nil,        // ,
+			shift(364), // subgraph
+			shift(365), // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			shift(368), // numeric_literal
+			shift(369), // raw_string
+			shift(370), // param_ref
+		},
+	},
+	actionRow{ // S368
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			reduce(95), // id, reduce: ScalarVal
+			nil,        // dotted_id
+			reduce(95), // quoted_string, reduce: ScalarVal
+			nil,        // ns_prefix
+			nil,        // [
+			reduce(95), // ], reduce: ScalarVal
+			reduce(95), // _, reduce: ScalarVal
+			reduce(95), // ,, reduce: ScalarVal
+			reduce(95), // subgraph, reduce: ScalarVal
+			reduce(95), // include, reduce: ScalarVal
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			reduce(95), // numeric_literal, reduce: ScalarVal
+			reduce(95), // raw_string, reduce: ScalarVal
+			reduce(95), // param_ref, reduce: ScalarVal
+		},
+	},
+	actionRow{ // S369
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			reduce(97), // id, reduce: ScalarVal
+			nil,        // dotted_id
+			reduce(97), // quoted_string, reduce: ScalarVal
+			nil,        // ns_prefix
+			nil,        // [
+			reduce(97), // ], reduce: ScalarVal
+			reduce(97), // _, reduce: ScalarVal
+			reduce(97), // ,, reduce: ScalarVal
+			reduce(97), // subgraph, reduce: ScalarVal
+			reduce(97), // include, reduce: ScalarVal
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			reduce(97), // numeric_literal, reduce: ScalarVal
+			reduce(97), // raw_string, reduce: ScalarVal
+			reduce(97), // param_ref, reduce: ScalarVal
+		},
+	},
+	actionRow{ // S370
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			reduce(98), // id, reduce: ScalarVal
+			nil,        // dotted_id
+			reduce(98), // quoted_string, reduce: ScalarVal
+			nil,        // ns_prefix
+			nil,        // [
+			reduce(98), // ], reduce: ScalarVal
+			reduce(98), // _, reduce: ScalarVal
+			reduce(98), // ,, reduce: ScalarVal
+			reduce(98), // subgraph, reduce: ScalarVal
+			reduce(98), // include, reduce: ScalarVal
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			reduce(98), // numeric_literal, reduce: ScalarVal
+			reduce(98), // raw_string, reduce: ScalarVal
+			reduce(98), // param_ref, reduce: ScalarVal
+		},
+	},
+	actionRow{ // S371
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			reduce(87), // id, reduce: Attr
+			reduce(87), // dotted_id, reduce: Attr
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			reduce(87), // ], reduce: Attr
+			reduce(87), // _, reduce: Attr
+			nil,        // ,
+			reduce(87), // subgraph, reduce: Attr
+			reduce(87), // include, reduce: Attr
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			reduce(87), // !, reduce: Attr
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S372
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(77), // ␚, reduce: GroupDecl
+			nil,        // empty
+			reduce(77), // ;, reduce: GroupDecl
+			reduce(77), // id, reduce: GroupDecl
+			reduce(77), // dotted_id, reduce: GroupDecl
+			reduce(77), // quoted_string, reduce: GroupDecl
+			reduce(77), // ns_prefix, reduce: GroupDecl
+			nil,        // [
+			nil,        // ]
+			reduce(77), // _, reduce: GroupDecl
+			nil,        // ,
+			reduce(77), // subgraph, reduce: GroupDecl
+			reduce(77), // include, reduce: GroupDecl
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(77), // {, reduce: GroupDecl
+			nil,        // }
+			nil,        // :
+			reduce(77), // @let, reduce: GroupDecl
+			nil,        // =
+			reduce(77), // @template, reduce: GroupDecl
+			nil,        // (
+			nil,        // )
+			reduce(77), // @use, reduce: GroupDecl
+			reduce(77), // @namespace, reduce: GroupDecl
+			reduce(77), // !, reduce: GroupDecl
+			reduce(77), // @graph, reduce: GroupDecl
+			reduce(77), // @defaults, reduce: GroupDecl
+			reduce(77), // @edge_defaults, reduce: GroupDecl
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S373
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			shift(155), // id
+			shift(107), // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			shift(425), // ]
+			shift(157), // _
+			nil,        // ,
+			shift(159), // subgraph
+			shift(160), // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			shift(115), // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S374
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // id
+			nil,        // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			nil,        // _
+			nil,        // ,
+			nil,        // subgraph
+			nil,        // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			shift(119), // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S375
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			shift(155), // id
+			shift(107), // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			shift(427), // ]
+			shift(157), // _
+			nil,        // ,
+			shift(159), // subgraph
+			shift(160), // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			shift(115), // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S376
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(14), // ;, reduce: NodeDecl
+			reduce(14), // id, reduce: NodeDecl
+			reduce(14), // dotted_id, reduce: NodeDecl
+			reduce(14), // quoted_string, reduce: NodeDecl
+			reduce(14), // ns_prefix, reduce: NodeDecl
+			nil,        // [
+			nil,        // ]
+			reduce(14), // _, reduce: NodeDecl
+			nil,        // ,
+			reduce(14), // subgraph, reduce: NodeDecl
+			reduce(14), // include, reduce: NodeDecl
+			reduce(14), // edgearrow, reduce: NodeDecl
+			reduce(14), // edgeline, reduce: NodeDecl
+			reduce(14), // edgebiarrow, reduce: NodeDecl
+			reduce(14), // edgebackarrow, reduce: NodeDecl
+			reduce(14), // edge_attr_open, reduce: NodeDecl
+			reduce(14), // edge_attr_open_head, reduce: NodeDecl
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(14), // {, reduce: NodeDecl
+			reduce(14), // }, reduce: NodeDecl
+			nil,        // :
+			reduce(14), // @let, reduce: NodeDecl
+			nil,        // =
+			reduce(14), // @template, reduce: NodeDecl
+			nil,        // (
+			nil,        // )
+			reduce(14), // @use, reduce: NodeDecl
+			reduce(14), // @namespace, reduce: NodeDecl
+			reduce(14), // !, reduce: NodeDecl
+			reduce(14), // @graph, reduce: NodeDecl
+			reduce(14), // @defaults, reduce: NodeDecl
+			reduce(14), // @edge_defaults, reduce: NodeDecl
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S377
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			shift(167), // ;
+			reduce(5),  // id, reduce: OptSep
+			reduce(5),  // dotted_id, reduce: OptSep
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			reduce(5),  // ], reduce: OptSep
+			reduce(5),  // _, reduce: OptSep
+			shift(168), // ,
+			reduce(5),  // subgraph, reduce: OptSep
+			reduce(5),  // include, reduce: OptSep
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			reduce(5),  // !, reduce: OptSep
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S378
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			shift(177), // id
+			shift(107), // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			shift(430), // ]
+			shift(180), // _
+			nil,        // ,
+			shift(182), // subgraph
+			shift(183), // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			shift(115), // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S379
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			shift(187), // id
+			shift(188), // dotted_id
+			shift(189), // quoted_string
+			shift(190), // ns_prefix
+			nil,        // [
+			nil,        // ]
+			shift(193), // _
+			nil,        // ,
+			shift(194), // subgraph
+			shift(195), // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			shift(16),  // {
+			shift(433), // }
+			nil,        // :
+			shift(208), // @let
+			nil,        // =
+			shift(209), // @template
+			nil,        // (
+			nil,        // )
+			shift(210), // @use
+			shift(211), // @namespace
+			shift(212), // !
+			shift(213), // @graph
+			shift(214), // @defaults
+			shift(215), // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S380
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(76), // ;, reduce: GroupDecl
+			reduce(76), // id, reduce: GroupDecl
+			reduce(76), // dotted_id, reduce: GroupDecl
+			reduce(76), // quoted_string, reduce: GroupDecl
+			reduce(76), // ns_prefix, reduce: GroupDecl
+			nil,        // [
+			nil,        // ]
+			reduce(76), // _, reduce: GroupDecl
+			nil,        // ,
+			reduce(76), // subgraph, reduce: GroupDecl
+			reduce(76), // include, reduce: GroupDecl
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(76), // {, reduce: GroupDecl
+			reduce(76), // }, reduce: GroupDecl
+			nil,        // :
+			reduce(76), // @let, reduce: GroupDecl
+			nil,        // =
+			reduce(76), // @template, reduce: GroupDecl
+			nil,        // (
+			nil,        // )
+			reduce(76), // @use, reduce: GroupDecl
+			reduce(76), // @namespace, reduce: GroupDecl
+			reduce(76), // !, reduce: GroupDecl
+			reduce(76), // @graph, reduce: GroupDecl
+			reduce(76), // @defaults, reduce: GroupDecl
+			reduce(76), // @edge_defaults, reduce: GroupDecl
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S381
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(16), // ;, reduce: NodeRef
+			reduce(16), // id, reduce: NodeRef
+			reduce(16), // dotted_id, reduce: NodeRef
+			reduce(16), // quoted_string, reduce: NodeRef
+			reduce(16), // ns_prefix, reduce: NodeRef
+			reduce(16), // [, reduce: NodeRef
+			nil,        // ]
+			reduce(16), // _, reduce: NodeRef
+			nil,        // ,
+			reduce(16), // subgraph, reduce: NodeRef
+			reduce(16), // include, reduce: NodeRef
+			reduce(16), // edgearrow, reduce: NodeRef
+			reduce(16), // edgeline, reduce: NodeRef
+			reduce(16), // edgebiarrow, reduce: NodeRef
+			reduce(16), // edgebackarrow, reduce: NodeRef
+			reduce(16), // edge_attr_open, reduce: NodeRef
+			reduce(16), // edge_attr_open_head, reduce: NodeRef
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(16), // {, reduce: NodeRef
+			reduce(16), // }, reduce: NodeRef
+			shift(434), // :
+			reduce(16), // @let, reduce: NodeRef
+			nil,        // =
+			reduce(16), // @template, reduce: NodeRef
+			nil,        // (
+			nil,        // )
+			reduce(16), // @use, reduce: NodeRef
+			reduce(16), // @namespace, reduce: NodeRef
+			reduce(16), // !, reduce: NodeRef
+			reduce(16), // @graph, reduce: NodeRef
+			reduce(16), // @defaults, reduce: NodeRef
+			reduce(16), // @edge_defaults, reduce: NodeRef
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S382
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(42), // ;, reduce: EdgeRef
+			reduce(42), // id, reduce: EdgeRef
+			reduce(42), // dotted_id, reduce: EdgeRef
+			reduce(42), // quoted_string, reduce: EdgeRef
+			reduce(42), // ns_prefix, reduce: EdgeRef
+			nil,        // [
+			nil,        // ]
+			reduce(42), // _, reduce: EdgeRef
+			nil,        // ,
+			reduce(42), // subgraph, reduce: EdgeRef
+			reduce(42), // include, reduce: EdgeRef
+			reduce(42), // edgearrow, reduce: EdgeRef
+			reduce(42), // edgeline, reduce: EdgeRef
+			reduce(42), // edgebiarrow, reduce: EdgeRef
+			reduce(42), // edgebackarrow, reduce: EdgeRef
+			reduce(42), // edge_attr_open, reduce: EdgeRef
+			reduce(42), // edge_attr_open_head, reduce: EdgeRef
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(42), // {, reduce: EdgeRef
+			reduce(42), // }, reduce: EdgeRef
+			nil,        // :
+			reduce(42), // @let, reduce: EdgeRef
+			nil,        // =
+			reduce(42), // @template, reduce: EdgeRef
+			nil,        // (
+			nil,        // )
+			reduce(42), // @use, reduce: EdgeRef
+			reduce(42), // @namespace, reduce: EdgeRef
+			reduce(42), // !, reduce: EdgeRef
+			reduce(42), // @graph, reduce: EdgeRef
+			reduce(42), // @defaults, reduce: EdgeRef
+			reduce(42), // @edge_defaults, reduce: EdgeRef
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S383
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(35), // ;, reduce: EdgeRHS
+			reduce(35), // id, reduce: EdgeRHS
+			reduce(35), // dotted_id, reduce: EdgeRHS
+			reduce(35), // quoted_string, reduce: EdgeRHS
+			reduce(35), // ns_prefix, reduce: EdgeRHS
+			nil,        // [
+			nil,        // ]
+			reduce(35), // _, reduce: EdgeRHS
+			nil,        // ,
+			reduce(35), // subgraph, reduce: EdgeRHS
+			reduce(35), // include, reduce: EdgeRHS
+			reduce(35), // edgearrow, reduce: EdgeRHS
+			reduce(35), // edgeline, reduce: EdgeRHS
+			reduce(35), // edgebiarrow, reduce: EdgeRHS
+			reduce(35), // edgebackarrow, reduce: EdgeRHS
+			reduce(35), // edge_attr_open, reduce: EdgeRHS
+			reduce(35), // edge_attr_open_head, reduce: EdgeRHS
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(35), // {, reduce: EdgeRHS
+			reduce(35), // }, reduce: EdgeRHS
+			nil,        // :
+			reduce(35), // @let, reduce: EdgeRHS
+			nil,        // =
+			reduce(35), // @template, reduce: EdgeRHS
+			nil,        // (
+			nil,        // )
+			reduce(35), // @use, reduce: EdgeRHS
+			reduce(35), // @namespace, reduce: EdgeRHS
+			reduce(35), // !, reduce: EdgeRHS
+			reduce(35), // @graph, reduce: EdgeRHS
+			reduce(35), // @defaults, reduce: EdgeRHS
+			reduce(35), // @edge_defaults, reduce: EdgeRHS
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S384
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(40), // ;, reduce: EdgeEnd
+			reduce(40), // id, reduce: EdgeEnd
+			reduce(40), // dotted_id, reduce: EdgeEnd
+			reduce(40), // quoted_string, reduce: EdgeEnd
+			reduce(40), // ns_prefix, reduce: EdgeEnd
+			nil,        // [
+			nil,        // ]
+			reduce(40), // _, reduce: EdgeEnd
+			nil,        // ,
+			reduce(40), // subgraph, reduce: EdgeEnd
+			reduce(40), // include, reduce: EdgeEnd
+			reduce(40), // edgearrow, reduce: EdgeEnd
+			reduce(40), // edgeline, reduce: EdgeEnd
+			reduce(40), // edgebiarrow, reduce: EdgeEnd
+			reduce(40), // edgebackarrow, reduce: EdgeEnd
+			reduce(40), // edge_attr_open, reduce: EdgeEnd
+			reduce(40), // edge_attr_open_head, reduce: EdgeEnd
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(40), // {, reduce: EdgeEnd
+			reduce(40), // }, reduce: EdgeEnd
+			nil,        // :
+			reduce(40), // @let, reduce: EdgeEnd
+			nil,        // =
+			reduce(40), // @template, reduce: EdgeEnd
+			nil,        // (
+			nil,        // )
+			reduce(40), // @use, reduce: EdgeEnd
+			reduce(40), // @namespace, reduce: EdgeEnd
+			reduce(40), // !, reduce: EdgeEnd
+			reduce(40), // @graph, reduce: EdgeEnd
+			reduce(40), // @defaults, reduce: EdgeEnd
+			reduce(40), // @edge_defaults, reduce: EdgeEnd
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S385
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			nil,       // ␚
+			nil,       // empty
+			nil,       // ;
+			shift(57), // id
+			shift(58), // dotted_id
+			shift(59), // quoted_string
+			shift(60), // ns_prefix
+			nil,       // [
+			nil,       // ]
+			shift(63), // _
+			nil,       // ,
+			nil,       // subgraph
+			nil,       // include
+			nil,       // edgearrow
+			nil,       // edgeline
+			nil,       // edgebiarrow
+			nil,       // edgebackarrow
+			nil,       // edge_attr_open
+			nil,       // edge_attr_open_head
+			nil,       // edge_attr_close
+			nil,       // edge_attr_close_nohead
+			nil,       // edge_key
+			nil,       // {
+			nil,       // }
+			nil,       // :
+			nil,       // @let
+			nil,       // =
+			nil,       // @template
+			nil,       // (
+			nil,       // )
+			nil,       // @use
+			nil,       // @namespace
+			nil,       // !
+			nil,       // @graph
+			nil,       // @defaults
+			nil,       // @edge_defaults
+			nil,       // numeric_literal
+			nil,       // raw_string
+			nil,       // param_ref
+		},
+	},
+	actionRow{ // S386
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			shift(155), // id
+			shift(107), // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			shift(157), // _
+			nil,        // ,
+			shift(159), // subgraph
+			shift(160), // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			shift(134), // edge_attr_close
+			shift(135), // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			shift(138), // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S387
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			shift(187), // id
+			shift(188), // dotted_id
+			shift(189), // quoted_string
+			shift(190), // ns_prefix
+			nil,        // [
+			nil,        // ]
+			shift(193), // _
+			nil,        // ,
+			nil,        // subgraph
+			nil,        // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			shift(385), // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S388
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			shift(223), // ;
+			reduce(5),  // id, reduce: OptSep
+			reduce(5),  // dotted_id, reduce: OptSep
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			reduce(5),  // _, reduce: OptSep
+			nil,        // ,
+			reduce(5),  // subgraph, reduce: OptSep
+			reduce(5),  // include, reduce: OptSep
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			reduce(5),  // edge_attr_close, reduce: OptSep
+			reduce(5),  // edge_attr_close_nohead, reduce: OptSep
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			reduce(5),  // !, reduce: OptSep
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S389
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			shift(439), // id
+			nil,        // dotted_id
+			shift(440), // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			shift(441), // _
+			nil,        // ,
+			shift(443), // subgraph
+			shift(444), // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			shift(446), // numeric_literal
+			shift(447), // raw_string
+			shift(448), // param_ref
+		},
+	},
+	actionRow{ // S390
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			shift(251), // id
+			nil,        // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			nil,        // _
+			nil,        // ,
+			nil,        // subgraph
+			nil,        // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			shift(449), // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S391
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			shift(451), // id
+			nil,        // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			nil,        // _
+			nil,        // ,
+			nil,        // subgraph
+			nil,        // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S392
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			shift(155), // id
+			shift(107), // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			shift(157), // _
+			nil,        // ,
+			shift(159), // subgraph
+			shift(160), // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			shift(453), // )
+			nil,        // @use
+			nil,        // @namespace
+			shift(257), // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S393
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(69), // ;, reduce: NamespaceDecl
+			reduce(69), // id, reduce: NamespaceDecl
+			reduce(69), // dotted_id, reduce: NamespaceDecl
+			reduce(69), // quoted_string, reduce: NamespaceDecl
+			reduce(69), // ns_prefix, reduce: NamespaceDecl
+			nil,        // [
+			nil,        // ]
+			reduce(69), // _, reduce: NamespaceDecl
+			nil,        // ,
+			reduce(69), // subgraph, reduce: NamespaceDecl
+			reduce(69), // include, reduce: NamespaceDecl
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(69), // {, reduce: NamespaceDecl
+			reduce(69), // }, reduce: NamespaceDecl
+			nil,        // :
+			reduce(69), // @let, reduce: NamespaceDecl
+			nil,        // =
+			reduce(69), // @template, reduce: NamespaceDecl
+			nil,        // (
+			nil,        // )
+			reduce(69), // @use, reduce: NamespaceDecl
+			reduce(69), // @namespace, reduce: NamespaceDecl
+			reduce(69), // !, reduce: NamespaceDecl
+			reduce(69), // @graph, reduce: NamespaceDecl
+			reduce(69), // @defaults, reduce: NamespaceDecl
+			reduce(69), // @edge_defaults, reduce: NamespaceDecl
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S394
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			shift(155), // id
+			shift(107), // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			shift(454), // ]
+			shift(157), // _
+			nil,        // ,
+			shift(159), // subgraph
+			shift(160), // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			shift(115), // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S395
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			shift(155), // id
+			shift(107), // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			shift(157), // _
+			nil,        // ,
+			shift(159), // subgraph
+			shift(160), // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			shift(115), // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S396
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			shift(155), // id
+			shift(107), // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			shift(157), // _
+			nil,        // ,
+			shift(159), // subgraph
+			shift(160), // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			shift(115), // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S397
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(10), // ␚, reduce: NodeId
+			nil,        // empty
+			reduce(10), // ;, reduce: NodeId
+			reduce(10), // id, reduce: NodeId
+			reduce(10), // dotted_id, reduce: NodeId
+			reduce(10), // quoted_string, reduce: NodeId
+			reduce(10), // ns_prefix, reduce: NodeId
+			nil,        // [
+			nil,        // ]
+			reduce(10), // _, reduce: NodeId
+			nil,        // ,
+			reduce(10), // subgraph, reduce: NodeId
+			reduce(10), // include, reduce: NodeId
+			reduce(10), // edgearrow, reduce: NodeId
+			reduce(10), // edgeline, reduce: NodeId
+			reduce(10), // edgebiarrow, reduce: NodeId
+			reduce(10), // edgebackarrow, reduce: NodeId
+			reduce(10), // edge_attr_open, reduce: NodeId
+			reduce(10), // edge_attr_open_head, reduce: NodeId
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(10), // {, reduce: NodeId
+			nil,        // }
+			nil,        // :
+			reduce(10), // @let, reduce: NodeId
+			nil,        // =
+			reduce(10), // @template, reduce: NodeId
+			nil,        // (
+			nil,        // )
+			reduce(10), // @use, reduce: NodeId
+			reduce(10), // @namespace, reduce: NodeId
+			reduce(10), // !, reduce: NodeId
+			reduce(10), // @graph, reduce: NodeId
+			reduce(10), // @defaults, reduce: NodeId
+			reduce(10), // @edge_defaults, reduce: NodeId
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S398
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			shift(5),   // id
+			shift(6),   // dotted_id
+			shift(7),   // quoted_string
+			shift(8),   // ns_prefix
+			nil,        // [
+			nil,        // ]
+			shift(11),  // _
+			nil,        // ,
+			nil,        // subgraph
+			nil,        // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			shift(126), // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S399
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(38), // ␚, reduce: EdgeRHS
+			nil,        // empty
+			reduce(38), // ;, reduce: EdgeRHS
+			reduce(38), // id, reduce: EdgeRHS
+			reduce(38), // dotted_id, reduce: EdgeRHS
+			reduce(38), // quoted_string, reduce: EdgeRHS
+			reduce(38), // ns_prefix, reduce: EdgeRHS
+			nil,        // [
+			nil,        // ]
+			reduce(38), // _, reduce: EdgeRHS
+			nil,        // ,
+			reduce(38), // subgraph, reduce: EdgeRHS
+			reduce(38), // include, reduce: EdgeRHS
+			reduce(38), // edgearrow, reduce: EdgeRHS
+			reduce(38), // edgeline, reduce: EdgeRHS
+			reduce(38), // edgebiarrow, reduce: EdgeRHS
+			reduce(38), // edgebackarrow, reduce: EdgeRHS
+			reduce(38), // edge_attr_open, reduce: EdgeRHS
+			reduce(38), // edge_attr_open_head, reduce: EdgeRHS
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(38), // {, reduce: EdgeRHS
+			nil,        // }
+			nil,        // :
+			reduce(38), // @let, reduce: EdgeRHS
+			nil,        // =
+			reduce(38), // @template, reduce: EdgeRHS
+			nil,        // (
+			nil,        // )
+			reduce(38), // @use, reduce: EdgeRHS
+			reduce(38), // @namespace, reduce: EdgeRHS
+			reduce(38), // !, reduce: EdgeRHS
+			reduce(38), // @graph, reduce: EdgeRHS
+			reduce(38), // @defaults, reduce: EdgeRHS
+			reduce(38), // @edge_defaults, reduce: EdgeRHS
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S400
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			reduce(92), // id, reduce: AttrVal
+			reduce(92), // dotted_id, reduce: AttrVal
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			reduce(92), // _, reduce: AttrVal
+			reduce(92), // ,, reduce: AttrVal
+			reduce(92), // subgraph, reduce: AttrVal
+			reduce(92), // include, reduce: AttrVal
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			reduce(92), // edge_attr_close, reduce: AttrVal
+			reduce(92), // edge_attr_close_nohead, reduce: AttrVal
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			reduce(92), // !, reduce: AttrVal
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S401
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			shift(359), // id
+			nil,        // dotted_id
+			shift(360), // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			shift(458), // ]
+			shift(362), // _
+			nil,        // ,
+			shift(364), // subgraph
+			shift(365), // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			shift(368), // numeric_literal
+			shift(369), // raw_string
+			shift(370), // param_ref
+		},
+	},
+	actionRow{ // S402
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			reduce(87), // id, reduce: Attr
+			reduce(87), // dotted_id, reduce: Attr
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			reduce(87), // _, reduce: Attr
+			nil,        // ,
+			reduce(87), // subgraph, reduce: Attr
+			reduce(87), // include, reduce: Attr
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			reduce(87), // edge_attr_close, reduce: Attr
+			reduce(87), // edge_attr_close_nohead, reduce: Attr
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			reduce(87), // !, reduce: Attr
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S403
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			shift(155), // id
+			shift(107), // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			shift(459), // ]
+			shift(157), // _
+			nil,        // ,
+			shift(159), // subgraph
+			shift(160), // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			shift(115), // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S404
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			reduce(13), // id, reduce: NodeDecl
+			reduce(13), // dotted_id, reduce: NodeDecl
+			reduce(13), // quoted_string, reduce: NodeDecl
+			reduce(13), // ns_prefix, reduce: NodeDecl
+			nil,        // [
+			nil,        // ]
+			reduce(13), // _, reduce: NodeDecl
+			reduce(13), // ,, reduce: NodeDecl
+			nil,        // subgraph
+			nil,        // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			reduce(13), // }, reduce: NodeDecl
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S405
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // id
+			nil,        // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			nil,        // _
+			reduce(64), // ,, reduce: ParamList
+			nil,        // subgraph
+			nil,        // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			reduce(64), // ), reduce: ParamList
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S406
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(62), // ␚, reduce: TemplateDecl
+			nil,        // empty
+			reduce(62), // ;, reduce: TemplateDecl
+			reduce(62), // id, reduce: TemplateDecl
+			reduce(62), // dotted_id, reduce: TemplateDecl
+			reduce(62), // quoted_string, reduce: TemplateDecl
+			reduce(62), // ns_prefix, reduce: TemplateDecl
+			nil,        // [
+			nil,        // ]
+			reduce(62), // _, reduce: TemplateDecl
+			nil,        // ,
+			reduce(62), // subgraph, reduce: TemplateDecl
+			reduce(62), // include, reduce: TemplateDecl
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(62), // {, reduce: TemplateDecl
+			nil,        // }
+			nil,        // :
+			reduce(62), // @let, reduce: TemplateDecl
+			nil,        // =
+			reduce(62), // @template, reduce: TemplateDecl
+			nil,        // (
+			nil,        // )
+			reduce(62), // @use, reduce: TemplateDecl
+			reduce(62), // @namespace, reduce: TemplateDecl
+			reduce(62), // !, reduce: TemplateDecl
+			reduce(62), // @graph, reduce: TemplateDecl
+			reduce(62), // @defaults, reduce: TemplateDecl
+			reduce(62), // @edge_defaults, reduce: TemplateDecl
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S407
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			shift(155), // id
+			shift(107), // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			shift(157), // _
+			nil,        // ,
+			shift(159), // subgraph
+			shift(160), // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			shift(460), // )
+			nil,        // @use
+			nil,        // @namespace
+			shift(257), // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S408
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(67), // ␚, reduce: TemplateUseDecl
+			nil,        // empty
+			reduce(67), // ;, reduce: TemplateUseDecl
+			reduce(67), // id, reduce: TemplateUseDecl
+			reduce(67), // dotted_id, reduce: TemplateUseDecl
+			reduce(67), // quoted_string, reduce: TemplateUseDecl
+			reduce(67), // ns_prefix, reduce: TemplateUseDecl
+			nil,        // [
+			nil,        // ]
+			reduce(67), // _, reduce: TemplateUseDecl
+			nil,        // ,
+			reduce(67), // subgraph, reduce: TemplateUseDecl
+			reduce(67), // include, reduce: TemplateUseDecl
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(67), // {, reduce: TemplateUseDecl
+			nil,        // }
+			nil,        // :
+			reduce(67), // @let, reduce: TemplateUseDecl
+			nil,        // =
+			reduce(67), // @template, reduce: TemplateUseDecl
+			nil,        // (
+			nil,        // )
+			reduce(67), // @use, reduce: TemplateUseDecl
+			reduce(67), // @namespace, reduce: TemplateUseDecl
+			reduce(67), // !, reduce: TemplateUseDecl
+			reduce(67), // @graph, reduce: TemplateUseDecl
+			reduce(67), // @defaults, reduce: TemplateUseDecl
+			reduce(67), // @edge_defaults, reduce: TemplateUseDecl
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S409
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			reduce(86), // id, reduce: OptAttrSep
+			reduce(86), // dotted_id, reduce: OptAttrSep
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			reduce(86), // _, reduce: OptAttrSep
+			nil,        // ,
+			reduce(86), // subgraph, reduce: OptAttrSep
+			reduce(86), // include, reduce: OptAttrSep
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			reduce(86), // ), reduce: OptAttrSep
+			nil,        // @use
+			nil,        // @namespace
+			reduce(86), // !, reduce: OptAttrSep
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S410
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			reduce(88), // id, reduce: Attr
+			reduce(88), // dotted_id, reduce: Attr
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			reduce(88), // _, reduce: Attr
+			nil,        // ,
+			reduce(88), // subgraph, reduce: Attr
+			reduce(88), // include, reduce: Attr
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			reduce(88), // ), reduce: Attr
+			nil,        // @use
+			nil,        // @namespace
+			reduce(88), // !, reduce: Attr
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S411
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			reduce(96), // id, reduce: ScalarVal
+			reduce(96), // dotted_id, reduce: ScalarVal
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			reduce(96), // _, reduce: ScalarVal
+			reduce(96), // ,, reduce: ScalarVal
+			reduce(96), // subgraph, reduce: ScalarVal
+			reduce(96), // include, reduce: ScalarVal
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			reduce(96), // ), reduce: ScalarVal
+			nil,        // @use
+			nil,        // @namespace
+			reduce(96), // !, reduce: ScalarVal
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S412
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			shift(359), // id
+			nil,        // dotted_id
+			shift(360), // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			shift(461), // ]
+			shift(362), // _
+			nil,        // ,
+			shift(364), // subgraph
+			shift(365), // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			shift(368), // numeric_literal
+			shift(369), // raw_string
+			shift(370), // param_ref
+		},
+	},
+	actionRow{ // S413
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			reduce(94), // id, reduce: ScalarVal
+			reduce(94), // dotted_id, reduce: ScalarVal
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			reduce(94), // _, reduce: ScalarVal
+			reduce(94), // ,, reduce: ScalarVal
+			reduce(94), // subgraph, reduce: ScalarVal
+			reduce(94), // include, reduce: ScalarVal
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			reduce(94), // ), reduce: ScalarVal
+			nil,        // @use
+			nil,        // @namespace
+			reduce(94), // !, reduce: ScalarVal
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S414
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			reduce(91), // id, reduce: AttrVal
+			reduce(91), // dotted_id, reduce: AttrVal
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			reduce(91), // _, reduce: AttrVal
+			reduce(91), // ,, reduce: AttrVal
+			reduce(91), // subgraph, reduce: AttrVal
+			reduce(91), // include, reduce: AttrVal
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			reduce(91), // ), reduce: AttrVal
+			nil,        // @use
+			nil,        // @namespace
+			reduce(91), // !, reduce: AttrVal
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S415
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			reduce(85), // id, reduce: OptAttrSep
+			reduce(85), // dotted_id, reduce: OptAttrSep
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			reduce(85), // _, reduce: OptAttrSep
+			shift(409), // ,
+			reduce(85), // subgraph, reduce: OptAttrSep
+			reduce(85), // include, reduce: OptAttrSep
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			reduce(85), // ), reduce: OptAttrSep
+			nil,        // @use
+			nil,        // @namespace
+			reduce(85), // !, reduce: OptAttrSep
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S416
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			reduce(95), // id, reduce: ScalarVal
+			reduce(95), // dotted_id, reduce: ScalarVal
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			reduce(95), // _, reduce: ScalarVal
+			reduce(95), // ,, reduce: ScalarVal
+			reduce(95), // subgraph, reduce: ScalarVal
+			reduce(95), // include, reduce: ScalarVal
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			reduce(95), // ), reduce: ScalarVal
+			nil,        // @use
+			nil,        // @namespace
+			reduce(95), // !, reduce: ScalarVal
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S417
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			reduce(97), // id, reduce: ScalarVal
+			reduce(97), // dotted_id, reduce: ScalarVal
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			reduce(97), // _, reduce: ScalarVal
+			reduce(97), // ,, reduce: ScalarVal
+			reduce(97), // subgraph, reduce: ScalarVal
+			reduce(97), // include, reduce: ScalarVal
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			reduce(97), // ), reduce: ScalarVal
+			nil,        // @use
+			nil,        // @namespace
+			reduce(97), // !, reduce: ScalarVal
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S418
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			reduce(98), // id, reduce: ScalarVal
+			reduce(98), // dotted_id, reduce: ScalarVal
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			reduce(98), // _, reduce: ScalarVal
+			reduce(98), // ,, reduce: ScalarVal
+			reduce(98), // subgraph, reduce: ScalarVal
+			reduce(98), // include, reduce: ScalarVal
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			reduce(98), // ), reduce: ScalarVal
+			nil,        // @use
+			nil,        // @namespace
+			reduce(98), // !, reduce: ScalarVal
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S419
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			shift(155), // id
+			shift(107), // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			shift(464), // ]
+			shift(157), // _
+			nil,        // ,
+			shift(159), // subgraph
+			shift(160), // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			shift(115), // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S420
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // id
+			nil,        // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			nil,        // _
+			nil,        // ,
+			nil,        // subgraph
+			nil,        // include
+			reduce(13), // edgearrow, reduce: NodeDecl
+			reduce(13), // edgeline, reduce: NodeDecl
+			reduce(13), // edgebiarrow, reduce: NodeDecl
+			reduce(13), // edgebackarrow, reduce: NodeDecl
+			reduce(13), // edge_attr_open, reduce: NodeDecl
+			reduce(13), // edge_attr_open_head, reduce: NodeDecl
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S421
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			reduce(86), // id, reduce: OptAttrSep
+			nil,        // dotted_id
+			reduce(86), // quoted_string, reduce: OptAttrSep
+			nil,        // ns_prefix
+			nil,        // [
+			reduce(86), // ], reduce: OptAttrSep
+			reduce(86), // _, reduce: OptAttrSep
+			nil,        // ,
+			reduce(86), // subgraph, reduce: OptAttrSep
+			reduce(86), // include, reduce: OptAttrSep
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			reduce(86), // numeric_literal, reduce: OptAttrSep
+			reduce(86), // raw_string, reduce: OptAttrSep
+			reduce(86), // param_ref, reduce: OptAttrSep
+		},
+	},
+	actionRow{ // S422
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			reduce(99), // id, reduce: ListItems
+			nil,        // dotted_id
+			reduce(99), // quoted_string, reduce: ListItems
+			nil,        // ns_prefix
+			nil,        // [
+			reduce(99), // ], reduce: ListItems
+			reduce(99), // _, reduce: ListItems
+			nil,        // ,
+			reduce(99), // subgraph, reduce: ListItems
+			reduce(99), // include, reduce: ListItems
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			reduce(99), // numeric_literal, reduce: ListItems
+			reduce(99), // raw_string, reduce: ListItems
+			reduce(99), // param_ref, reduce: ListItems
+		},
+	},
+	actionRow{ // S423
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			reduce(93), // id, reduce: AttrVal
+			reduce(93), // dotted_id, reduce: AttrVal
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			reduce(93), // ], reduce: AttrVal
+			reduce(93), // _, reduce: AttrVal
+			reduce(93), // ,, reduce: AttrVal
+			reduce(93), // subgraph, reduce: AttrVal
+			reduce(93), // include, reduce: AttrVal
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			reduce(93), // !, reduce: AttrVal
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S424
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			reduce(85), // id, reduce: OptAttrSep
+			nil,        // dotted_id
+			reduce(85), // quoted_string, reduce: OptAttrSep
+			nil,        // ns_prefix
+			nil,        // [
+			reduce(85), // ], reduce: OptAttrSep
+			reduce(85), // _, reduce: OptAttrSep
+			shift(421), // ,
+			reduce(85), // subgraph, reduce: OptAttrSep
+			reduce(85), // include, reduce: OptAttrSep
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			reduce(85), // numeric_literal, reduce: OptAttrSep
+			reduce(85), // raw_string, reduce: OptAttrSep
+			reduce(85), // param_ref, reduce: OptAttrSep
+		},
+	},
+	actionRow{ // S425
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // id
+			nil,        // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			nil,        // _
+			nil,        // ,
+			nil,        // subgraph
+			nil,        // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			shift(119), // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S426
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(79), // ␚, reduce: GroupDecl
+			nil,        // empty
+			reduce(79), // ;, reduce: GroupDecl
+			reduce(79), // id, reduce: GroupDecl
+			reduce(79), // dotted_id, reduce: GroupDecl
+			reduce(79), // quoted_string, reduce: GroupDecl
+			reduce(79), // ns_prefix, reduce: GroupDecl
+			nil,        // [
+			nil,        // ]
+			reduce(79), // _, reduce: GroupDecl
+			nil,        // ,
+			reduce(79), // subgraph, reduce: GroupDecl
+			reduce(79), // include, reduce: GroupDecl
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(79), // {, reduce: GroupDecl
+			nil,        // }
+			nil,        // :
+			reduce(79), // @let, reduce: GroupDecl
+			nil,        // =
+			reduce(79), // @template, reduce: GroupDecl
+			nil,        // (
+			nil,        // )
+			reduce(79), // @use, reduce: GroupDecl
+			reduce(79), // @namespace, reduce: GroupDecl
+			reduce(79), // !, reduce: GroupDecl
+			reduce(79), // @graph, reduce: GroupDecl
+			reduce(79), // @defaults, reduce: GroupDecl
+			reduce(79), // @edge_defaults, reduce: GroupDecl
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S427
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(11), // ;, reduce: NodeDecl
+			reduce(11), // id, reduce: NodeDecl
+			reduce(11), // dotted_id, reduce: NodeDecl
+			reduce(11), // quoted_string, reduce: NodeDecl
+			reduce(11), // ns_prefix, reduce: NodeDecl
+			nil,        // [
+			nil,        // ]
+			reduce(11), // _, reduce: NodeDecl
+			nil,        // ,
+			reduce(11), // subgraph, reduce: NodeDecl
+			reduce(11), // include, reduce: NodeDecl
+			reduce(11), // edgearrow, reduce: NodeDecl
+			reduce(11), // edgeline, reduce: NodeDecl
+			reduce(11), // edgebiarrow, reduce: NodeDecl
+			reduce(11), // edgebackarrow, reduce: NodeDecl
+			reduce(11), // edge_attr_open, reduce: NodeDecl
+			reduce(11), // edge_attr_open_head, reduce: NodeDecl
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(11), // {, reduce: NodeDecl
+			reduce(11), // }, reduce: NodeDecl
+			nil,        // :
+			reduce(11), // @let, reduce: NodeDecl
+			nil,        // =
+			reduce(11), // @template, reduce: NodeDecl
+			nil,        // (
+			nil,        // )
+			reduce(11), // @use, reduce: NodeDecl
+			reduce(11), // @namespace, reduce: NodeDecl
+			reduce(11), // !, reduce: NodeDecl
+			reduce(11), // @graph, reduce: NodeDecl
+			reduce(11), // @defaults, reduce: NodeDecl
+			reduce(11), // @edge_defaults, reduce: NodeDecl
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S428
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			shift(155), // id
+			shift(107), // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			shift(468), // ]
+			shift(157), // _
+			nil,        // ,
+			shift(159), // subgraph
+			shift(160), // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			shift(115), // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S429
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			shift(155), // id
+			shift(107), // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			shift(469), // ]
+			shift(157), // _
+			nil,        // ,
+			shift(159), // subgraph
+			shift(160), // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			shift(115), // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S430
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // id
+			nil,        // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			nil,        // _
+			nil,        // ,
+			nil,        // subgraph
+			nil,        // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			shift(379), // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S431
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			shift(167), // ;
+			reduce(5),  // id, reduce: OptSep
+			reduce(5),  // dotted_id, reduce: OptSep
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			reduce(5),  // ], reduce: OptSep
+			reduce(5),  // _, reduce: OptSep
+			nil,        // ,
+			reduce(5),  // subgraph, reduce: OptSep
+			reduce(5),  // include, reduce: OptSep
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			reduce(89), // =, reduce: AttrKey
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			reduce(5),  // !, reduce: OptSep
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S432
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			shift(187), // id
+			shift(188), // dotted_id
+			shift(189), // quoted_string
+			shift(190), // ns_prefix
+			nil,        // [
+			nil,        // ]
+			shift(193), // _
+			nil,        // ,
+			shift(194), // subgraph
+			shift(195), // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			shift(16),  // {
+			shift(472), // }
+			nil,        // :
+			shift(208), // @let
+			nil,        // =
+			shift(209), // @template
+			nil,        // (
+			nil,        // )
+			shift(210), // @use
+			shift(211), // @namespace
+			shift(212), // !
+			shift(213), // @graph
+			shift(214), // @defaults
+			shift(215), // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S433
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(81), // ;, reduce: GroupBody
+			reduce(81), // id, reduce: GroupBody
+			reduce(81), // dotted_id, reduce: GroupBody
+			reduce(81), // quoted_string, reduce: GroupBody
+			reduce(81), // ns_prefix, reduce: GroupBody
+			nil,        // [
+			nil,        // ]
+			reduce(81), // _, reduce: GroupBody
+			nil,        // ,
+			reduce(81), // subgraph, reduce: GroupBody
+			reduce(81), // include, reduce: GroupBody
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(81), // {, reduce: GroupBody
+			reduce(81), // }, reduce: GroupBody
+			nil,        // :
+			reduce(81), // @let, reduce: GroupBody
+			nil,        // =
+			reduce(81), // @template, reduce: GroupBody
+			nil,        // (
+			nil,        // )
+			reduce(81), // @use, reduce: GroupBody
+			reduce(81), // @namespace, reduce: GroupBody
+			reduce(81), // !, reduce: GroupBody
+			reduce(81), // @graph, reduce: GroupBody
+			reduce(81), // @defaults, reduce: GroupBody
+			reduce(81), // @edge_defaults, reduce: GroupBody
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S434
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			shift(474), // id
+			shift(475), // dotted_id
+			shift(476), // quoted_string
+			shift(477), // ns_prefix
+			nil,        // [
+			nil,        // ]
+			nil,        // _
+			nil,        // ,
+			nil,        // subgraph
+			nil,        // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S435
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			shift(57),  // id
+			shift(58),  // dotted_id
+			shift(59),  // quoted_string
+			shift(60),  // ns_prefix
+			nil,        // [
+			nil,        // ]
+			shift(63),  // _
+			shift(144), // ,
+			nil,        // subgraph
+			nil,        // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			shift(478), // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S436
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			shift(187), // id
+			shift(188), // dotted_id
+			shift(189), // quoted_string
+			shift(190), // ns_prefix
+			nil,        // [
+			nil,        // ]
+			shift(193), // _
+			nil,        // ,
+			nil,        // subgraph
+			nil,        // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			shift(385), // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S437
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(36), // ;, reduce: EdgeRHS
+			reduce(36), // id, reduce: EdgeRHS
+			reduce(36), // dotted_id, reduce: EdgeRHS
+			reduce(36), // quoted_string, reduce: EdgeRHS
+			reduce(36), // ns_prefix, reduce: EdgeRHS
+			nil,        // [
+			nil,        // ]
+			reduce(36), // _, reduce: EdgeRHS
+			nil,        // ,
+			reduce(36), // subgraph, reduce: EdgeRHS
+			reduce(36), // include, reduce: EdgeRHS
+			reduce(36), // edgearrow, reduce: EdgeRHS
+			reduce(36), // edgeline, reduce: EdgeRHS
+			reduce(36), // edgebiarrow, reduce: EdgeRHS
+			reduce(36), // edgebackarrow, reduce: EdgeRHS
+			reduce(36), // edge_attr_open, reduce: EdgeRHS
+			reduce(36), // edge_attr_open_head, reduce: EdgeRHS
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(36), // {, reduce: EdgeRHS
+			reduce(36), // }, reduce: EdgeRHS
+			nil,        // :
+			reduce(36), // @let, reduce: EdgeRHS
+			nil,        // =
+			reduce(36), // @template, reduce: EdgeRHS
+			nil,        // (
+			nil,        // )
+			reduce(36), // @use, reduce: EdgeRHS
+			reduce(36), // @namespace, reduce: EdgeRHS
+			reduce(36), // !, reduce: EdgeRHS
+			reduce(36), // @graph, reduce: EdgeRHS
+			reduce(36), // @defaults, reduce: EdgeRHS
+			reduce(36), // @edge_defaults, reduce: EdgeRHS
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S438
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			shift(155), // id
+			shift(107), // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			shift(157), // _
+			nil,        // ,
+			shift(159), // subgraph
+			shift(160), // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			shift(134), // edge_attr_close
+			shift(135), // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			shift(138), // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S439
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(20), // ;, reduce: Word
+			reduce(20), // id, reduce: Word
+			reduce(20), // dotted_id, reduce: Word
+			reduce(20), // quoted_string, reduce: Word
+			reduce(20), // ns_prefix, reduce: Word
+			nil,        // [
+			nil,        // ]
+			reduce(20), // _, reduce: Word
+			nil,        // ,
+			reduce(20), // subgraph, reduce: Word
+			reduce(20), // include, reduce: Word
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(20), // {, reduce: Word
+			reduce(20), // }, reduce: Word
+			nil,        // :
+			reduce(20), // @let, reduce: Word
+			nil,        // =
+			reduce(20), // @template, reduce: Word
+			nil,        // (
+			nil,        // )
+			reduce(20), // @use, reduce: Word
+			reduce(20), // @namespace, reduce: Word
+			reduce(20), // !, reduce: Word
+			reduce(20), // @graph, reduce: Word
+			reduce(20), // @defaults, reduce: Word
+			reduce(20), // @edge_defaults, reduce: Word
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S440
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(96), // ;, reduce: ScalarVal
+			reduce(96), // id, reduce: ScalarVal
+			reduce(96), // dotted_id, reduce: ScalarVal
+			reduce(96), // quoted_string, reduce: ScalarVal
+			reduce(96), // ns_prefix, reduce: ScalarVal
+			nil,        // [
+			nil,        // ]
+			reduce(96), // _, reduce: ScalarVal
+			nil,        // ,
+			reduce(96), // subgraph, reduce: ScalarVal
+			reduce(96), // include, reduce: ScalarVal
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(96), // {, reduce: ScalarVal
+			reduce(96), // }, reduce: ScalarVal
+			nil,        // :
+			reduce(96), // @let, reduce: ScalarVal
+			nil,        // =
+			reduce(96), // @template, reduce: ScalarVal
+			nil,        // (
+			nil,        // )
+			reduce(96), // @use, reduce: ScalarVal
+			reduce(96), // @namespace, reduce: ScalarVal
+			reduce(96), // !, reduce: ScalarVal
+			reduce(96), // @graph, reduce: ScalarVal
+			reduce(96), // @defaults, reduce: ScalarVal
+			reduce(96), // @edge_defaults, reduce: ScalarVal
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S441
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(23), // ;, reduce: Word
+			reduce(23), // id, reduce: Word
+			reduce(23), // dotted_id, reduce: Word
+			reduce(23), // quoted_string, reduce: Word
+			reduce(23), // ns_prefix, reduce: Word
+			nil,        // [
+			nil,        // ]
+			reduce(23), // _, reduce: Word
+			nil,        // ,
+			reduce(23), // subgraph, reduce: Word
+			reduce(23), // include, reduce: Word
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(23), // {, reduce: Word
+			reduce(23), // }, reduce: Word
+			nil,        // :
+			reduce(23), // @let, reduce: Word
+			nil,        // =
+			reduce(23), // @template, reduce: Word
+			nil,        // (
+			nil,        // )
+			reduce(23), // @use, reduce: Word
+			reduce(23), // @namespace, reduce: Word
+			reduce(23), // !, reduce: Word
+			reduce(23), // @graph, reduce: Word
+			reduce(23), // @defaults, reduce: Word
+			reduce(23), // @edge_defaults, reduce: Word
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S442
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(94), // ;, reduce: ScalarVal
+			reduce(94), // id, reduce: ScalarVal
+			reduce(94), // dotted_id, reduce: ScalarVal
+			reduce(94), // quoted_string, reduce: ScalarVal
+			reduce(94), // ns_prefix, reduce: ScalarVal
+			nil,        // [
+			nil,        // ]
+			reduce(94), // _, reduce: ScalarVal
+			nil,        // ,
+			reduce(94), // subgraph, reduce: ScalarVal
+			reduce(94), // include, reduce: ScalarVal
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(94), // {, reduce: ScalarVal
+			reduce(94), // }, reduce: ScalarVal
+			nil,        // :
+			reduce(94), // @let, reduce: ScalarVal
+			nil,        // =
+			reduce(94), // @template, reduce: ScalarVal
+			nil,        // (
+			nil,        // )
+			reduce(94), // @use, reduce: ScalarVal
+			reduce(94), // @namespace, reduce: ScalarVal
+			reduce(94), // !, reduce: ScalarVal
+			reduce(94), // @graph, reduce: ScalarVal
+			reduce(94), // @defaults, reduce: ScalarVal
+			reduce(94), // @edge_defaults, reduce: ScalarVal
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S443
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(21), // ;, reduce: Word
+			reduce(21), // id, reduce: Word
+			reduce(21), // dotted_id, reduce: Word
+			reduce(21), // quoted_string, reduce: Word
+			reduce(21), // ns_prefix, reduce: Word
+			nil,        // [
+			nil,        // ]
+			reduce(21), // _, reduce: Word
+			nil,        // ,
+			reduce(21), // subgraph, reduce: Word
+			reduce(21), // include, reduce: Word
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(21), // {, reduce: Word
+			reduce(21), // }, reduce: Word
+			nil,        // :
+			reduce(21), // @let, reduce: Word
+			nil,        // =
+			reduce(21), // @template, reduce: Word
+			nil,        // (
+			nil,        // )
+			reduce(21), // @use, reduce: Word
+			reduce(21), // @namespace, reduce: Word
+			reduce(21), // !, reduce: Word
+			reduce(21), // @graph, reduce: Word
+			reduce(21), // @defaults, reduce: Word
+			reduce(21), // @edge_defaults, reduce: Word
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S444
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(22), // ;, reduce: Word
+			reduce(22), // id, reduce: Word
+			reduce(22), // dotted_id, reduce: Word
+			reduce(22), // quoted_string, reduce: Word
+			reduce(22), // ns_prefix, reduce: Word
+			nil,        // [
+			nil,        // ]
+			reduce(22), // _, reduce: Word
+			nil,        // ,
+			reduce(22), // subgraph, reduce: Word
+			reduce(22), // include, reduce: Word
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(22), // {, reduce: Word
+			reduce(22), // }, reduce: Word
+			nil,        // :
+			reduce(22), // @let, reduce: Word
+			nil,        // =
+			reduce(22), // @template, reduce: Word
+			nil,        // (
+			nil,        // )
+			reduce(22), // @use, reduce: Word
+			reduce(22), // @namespace, reduce: Word
+			reduce(22), // !, reduce: Word
+			reduce(22), // @graph, reduce: Word
+			reduce(22), // @defaults, reduce: Word
+			reduce(22), // @edge_defaults, reduce: Word
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S445
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(60), // ;, reduce: LetDecl
+			reduce(60), // id, reduce: LetDecl
+			reduce(60), // dotted_id, reduce: LetDecl
+			reduce(60), // quoted_string, reduce: LetDecl
+			reduce(60), // ns_prefix, reduce: LetDecl
+			nil,        // [
+			nil,        // ]
+			reduce(60), // _, reduce: LetDecl
+			nil,        // ,
+			reduce(60), // subgraph, reduce: LetDecl
+			reduce(60), // include, reduce: LetDecl
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(60), // {, reduce: LetDecl
+			reduce(60), // }, reduce: LetDecl
+			nil,        // :
+			reduce(60), // @let, reduce: LetDecl
+			nil,        // =
+			reduce(60), // @template, reduce: LetDecl
+			nil,        // (
+			nil,        // )
+			reduce(60), // @use, reduce: LetDecl
+			reduce(60), // @namespace, reduce: LetDecl
+			reduce(60), // !, reduce: LetDecl
+			reduce(60), // @graph, reduce: LetDecl
+			reduce(60), // @defaults, reduce: LetDecl
+			reduce(60), // @edge_defaults, reduce: LetDecl
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S446
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(95), // ;, reduce: ScalarVal
+			reduce(95), // id, reduce: ScalarVal
+			reduce(95), // dotted_id, reduce: ScalarVal
+			reduce(95), // quoted_string, reduce: ScalarVal
+			reduce(95), // ns_prefix, reduce: ScalarVal
+			nil,        // [
+			nil,        // ]
+			reduce(95), // _, reduce: ScalarVal
+			nil,        // ,
+			reduce(95), // subgraph, reduce: ScalarVal
+			reduce(95), // include, reduce: ScalarVal
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(95), // {, reduce: ScalarVal
+			reduce(95), // }, reduce: ScalarVal
+			nil,        // :
+			reduce(95), // @let, reduce: ScalarVal
+			nil,        // =
+			reduce(95), // @template, reduce: ScalarVal
+			nil,        // (
+			nil,        // )
+			reduce(95), // @use, reduce: ScalarVal
+			reduce(95), // @namespace, reduce: ScalarVal
+			reduce(95), // !, reduce: ScalarVal
+			reduce(95), // @graph, reduce: ScalarVal
+			reduce(95), // @defaults, reduce: ScalarVal
+			reduce(95), // @edge_defaults, reduce: ScalarVal
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S447
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(97), // ;, reduce: ScalarVal
+			reduce(97), // id, reduce: ScalarVal
+			reduce(97), // dotted_id, reduce: ScalarVal
+			reduce(97), // quoted_string, reduce: ScalarVal
+			reduce(97), // ns_prefix, reduce: ScalarVal
+			nil,        // [
+			nil,        // ]
+			reduce(97), // _, reduce: ScalarVal
+			nil,        // ,
+			reduce(97), // subgraph, reduce: ScalarVal
+			reduce(97), // include, reduce: ScalarVal
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(97), // {, reduce: ScalarVal
+			reduce(97), // }, reduce: ScalarVal
+			nil,        // :
+			reduce(97), // @let, reduce: ScalarVal
+			nil,        // =
+			reduce(97), // @template, reduce: ScalarVal
+			nil,        // (
+			nil,        // )
+			reduce(97), // @use, reduce: ScalarVal
+			reduce(97), // @namespace, reduce: ScalarVal
+			reduce(97), // !, reduce: ScalarVal
+			reduce(97), // @graph, reduce: ScalarVal
+			reduce(97), // @defaults, reduce: ScalarVal
+			reduce(97), // @edge_defaults, reduce: ScalarVal
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S448
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(98), // ;, reduce: ScalarVal
+			reduce(98), // id, reduce: ScalarVal
+			reduce(98), // dotted_id, reduce: ScalarVal
+			reduce(98), // quoted_string, reduce: ScalarVal
+			reduce(98), // ns_prefix, reduce: ScalarVal
+			nil,        // [
+			nil,        // ]
+			reduce(98), // _, reduce: ScalarVal
+			nil,        // ,
+			reduce(98), // subgraph, reduce: ScalarVal
+			reduce(98), // include, reduce: ScalarVal
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(98), // {, reduce: ScalarVal
+			reduce(98), // }, reduce: ScalarVal
+			nil,        // :
+			reduce(98), // @let, reduce: ScalarVal
+			nil,        // =
+			reduce(98), // @template, reduce: ScalarVal
+			nil,        // (
+			nil,        // )
+			reduce(98), // @use, reduce: ScalarVal
+			reduce(98), // @namespace, reduce: ScalarVal
+			reduce(98), // !, reduce: ScalarVal
+			reduce(98), // @graph, reduce: ScalarVal
+			reduce(98), // @defaults, reduce: ScalarVal
+			reduce(98), // @edge_defaults, reduce: ScalarVal
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S449
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // id
+			nil,        // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			nil,        // _
+			nil,        // ,
+			nil,        // subgraph
+			nil,        // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			shift(379), // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S450
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // id
+			nil,        // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			nil,        // _
+			shift(341), // ,
+			nil,        // subgraph
+			nil,        // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			shift(483), // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S451
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // id
+			nil,        // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			nil,        // _
+			nil,        // ,
+			nil,        // subgraph
+			nil,        // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			shift(484), // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S452
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			shift(155), // id
+			shift(107), // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			shift(157), // _
+			nil,        // ,
+			shift(159), // subgraph
+			shift(160), // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			shift(485), // )
+			nil,        // @use
+			nil,        // @namespace
+			shift(257), // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S453
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(65), // ;, reduce: TemplateUseDecl
+			reduce(65), // id, reduce: TemplateUseDecl
+			reduce(65), // dotted_id, reduce: TemplateUseDecl
+			reduce(65), // quoted_string, reduce: TemplateUseDecl
+			reduce(65), // ns_prefix, reduce: TemplateUseDecl
+			nil,        // [
+			nil,        // ]
+			reduce(65), // _, reduce: TemplateUseDecl
+			nil,        // ,
+			reduce(65), // subgraph, reduce: TemplateUseDecl
+			reduce(65), // include, reduce: TemplateUseDecl
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(65), // {, reduce: TemplateUseDecl
+			reduce(65), // }, reduce: TemplateUseDecl
+			nil,        // :
+			reduce(65), // @let, reduce: TemplateUseDecl
+			nil,        // =
+			reduce(65), // @template, reduce: TemplateUseDecl
+			nil,        // (
+			nil,        // )
+			reduce(65), // @use, reduce: TemplateUseDecl
+			reduce(65), // @namespace, reduce: TemplateUseDecl
+			reduce(65), // !, reduce: TemplateUseDecl
+			reduce(65), // @graph, reduce: TemplateUseDecl
+			reduce(65), // @defaults, reduce: TemplateUseDecl
+			reduce(65), // @edge_defaults, reduce: TemplateUseDecl
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S454
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(72), // ;, reduce: GraphAttrsDecl
+			reduce(72), // id, reduce: GraphAttrsDecl
+			reduce(72), // dotted_id, reduce: GraphAttrsDecl
+			reduce(72), // quoted_string, reduce: GraphAttrsDecl
+			reduce(72), // ns_prefix, reduce: GraphAttrsDecl
+			nil,        // [
+			nil,        // ]
+			reduce(72), // _, reduce: GraphAttrsDecl
+			nil,        // ,
+			reduce(72), // subgraph, reduce: GraphAttrsDecl
+			reduce(72), // include, reduce: GraphAttrsDecl
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(72), // {, reduce: GraphAttrsDecl
+			reduce(72), // }, reduce: GraphAttrsDecl
+			nil,        // :
+			reduce(72), // @let, reduce: GraphAttrsDecl
+			nil,        // =
+			reduce(72), // @template, reduce: GraphAttrsDecl
+			nil,        // (
+			nil,        // )
+			reduce(72), // @use, reduce: GraphAttrsDecl
+			reduce(72), // @namespace, reduce: GraphAttrsDecl
+			reduce(72), // !, reduce: GraphAttrsDecl
+			reduce(72), // @graph, reduce: GraphAttrsDecl
+			reduce(72), // @defaults, reduce: GraphAttrsDecl
+			reduce(72), // @edge_defaults, reduce: GraphAttrsDecl
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S455
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			shift(155), // id
+			shift(107), // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			shift(486), // ]
+			shift(157), // _
+			nil,        // ,
+			shift(159), // subgraph
+			shift(160), // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			shift(115), // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S456
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			shift(155), // id
+			shift(107), // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			shift(487), // ]
+			shift(157), // _
+			nil,        // ,
+			shift(159), // subgraph
+			shift(160), // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			shift(115), // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S457
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(39), // ␚, reduce: EdgeRHS
+			nil,        // empty
+			reduce(39), // ;, reduce: EdgeRHS
+			reduce(39), // id, reduce: EdgeRHS
+			reduce(39), // dotted_id, reduce: EdgeRHS
+			reduce(39), // quoted_string, reduce: EdgeRHS
+			reduce(39), // ns_prefix, reduce: EdgeRHS
+			nil,        // [
+			nil,        // ]
+			reduce(39), // _, reduce: EdgeRHS
+			nil,        // ,
+			reduce(39), // subgraph, reduce: EdgeRHS
+			reduce(39), // include, reduce: EdgeRHS
+			reduce(39), // edgearrow, reduce: EdgeRHS
+			reduce(39), // edgeline, reduce: EdgeRHS
+			reduce(39), // edgebiarrow, reduce: EdgeRHS
+			reduce(39), // edgebackarrow, reduce: EdgeRHS
+			reduce(39), // edge_attr_open, reduce: EdgeRHS
+			reduce(39), // edge_attr_open_head, reduce: EdgeRHS
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(39), // {, reduce: EdgeRHS
+			nil,        // }
+			nil,        // :
+			reduce(39), // @let, reduce: EdgeRHS
+			nil,        // =
+			reduce(39), // @template, reduce: EdgeRHS
+			nil,        // (
+			nil,        // )
+			reduce(39), // @use, reduce: EdgeRHS
+			reduce(39), // @namespace, reduce: EdgeRHS
+			reduce(39), // !, reduce: EdgeRHS
+			reduce(39), // @graph, reduce: EdgeRHS
+			reduce(39), // @defaults, reduce: EdgeRHS
+			reduce(39), // @edge_defaults, reduce: EdgeRHS
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S458
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			reduce(93), // id, reduce: AttrVal
+			reduce(93), // dotted_id, reduce: AttrVal
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			reduce(93), // _, reduce: AttrVal
+			reduce(93), // ,, reduce: AttrVal
+			reduce(93), // subgraph, reduce: AttrVal
+			reduce(93), // include, reduce: AttrVal
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			reduce(93), // edge_attr_close, reduce: AttrVal
+			reduce(93), // edge_attr_close_nohead, reduce: AttrVal
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			reduce(93), // !, reduce: AttrVal
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S459
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			reduce(12), // id, reduce: NodeDecl
+			reduce(12), // dotted_id, reduce: NodeDecl
+			reduce(12), // quoted_string, reduce: NodeDecl
+			reduce(12), // ns_prefix, reduce: NodeDecl
+			nil,        // [
+			nil,        // ]
+			reduce(12), // _, reduce: NodeDecl
+			reduce(12), // ,, reduce: NodeDecl
+			nil,        // subgraph
+			nil,        // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			reduce(12), // }, reduce: NodeDecl
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S460
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(68), // ␚, reduce: TemplateUseDecl
+			nil,        // empty
+			reduce(68), // ;, reduce: TemplateUseDecl
+			reduce(68), // id, reduce: TemplateUseDecl
+			reduce(68), // dotted_id, reduce: TemplateUseDecl
+			reduce(68), // quoted_string, reduce: TemplateUseDecl
+			reduce(68), // ns_prefix, reduce: TemplateUseDecl
+			nil,        // [
+			nil,        // ]
+			reduce(68), // _, reduce: TemplateUseDecl
+			nil,        // ,
+			reduce(68), // subgraph, reduce: TemplateUseDecl
+			reduce(68), // include, reduce: TemplateUseDecl
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(68), // {, reduce: TemplateUseDecl
+			nil,        // }
+			nil,        // :
+			reduce(68), // @let, reduce: TemplateUseDecl
+			nil,        // =
+			reduce(68), // @template, reduce: TemplateUseDecl
+			nil,        // (
+			nil,        // )
+			reduce(68), // @use, reduce: TemplateUseDecl
+			reduce(68), // @namespace, reduce: TemplateUseDecl
+			reduce(68), // !, reduce: TemplateUseDecl
+			reduce(68), // @graph, reduce: TemplateUseDecl
+			reduce(68), // @defaults, reduce: TemplateUseDecl
+			reduce(68), // @edge_defaults, reduce: TemplateUseDecl
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S461
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			reduce(92), // id, reduce: AttrVal
+			reduce(92), // dotted_id, reduce: AttrVal
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			reduce(92), // _, reduce: AttrVal
+			reduce(92), // ,, reduce: AttrVal
+			reduce(92), // subgraph, reduce: AttrVal
+			reduce(92), // include, reduce: AttrVal
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			reduce(92), // ), reduce: AttrVal
+			nil,        // @use
+			nil,        // @namespace
+			reduce(92), // !, reduce: AttrVal
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S462
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			shift(359), // id
+			nil,        // dotted_id
+			shift(360), // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			shift(488), // ]
+			shift(362), // _
+			nil,        // ,
+			shift(364), // subgraph
+			shift(365), // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			shift(368), // numeric_literal
+			shift(369), // raw_string
+			shift(370), // param_ref
+		},
+	},
+	actionRow{ // S463
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			reduce(87), // id, reduce: Attr
+			reduce(87), // dotted_id, reduce: Attr
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			reduce(87), // _, reduce: Attr
+			nil,        // ,
+			reduce(87), // subgraph, reduce: Attr
+			reduce(87), // include, reduce: Attr
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			reduce(87), // ), reduce: Attr
+			nil,        // @use
+			nil,        // @namespace
+			reduce(87), // !, reduce: Attr
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S464
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // id
+			nil,        // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			nil,        // _
+			nil,        // ,
+			nil,        // subgraph
+			nil,        // include
+			reduce(12), // edgearrow, reduce: NodeDecl
+			reduce(12), // edgeline, reduce: NodeDecl
+			reduce(12), // edgebiarrow, reduce: NodeDecl
+			reduce(12), // edgebackarrow, reduce: NodeDecl
+			reduce(12), // edge_attr_open, reduce: NodeDecl
+			reduce(12), // edge_attr_open_head, reduce: NodeDecl
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S465
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,         // INVALID
+			nil,         // ␚
+			nil,         // empty
+			nil,         // ;
+			reduce(100), // id, reduce: ListItems
+			nil,         // dotted_id
+			reduce(100), // quoted_string, reduce: ListItems
+			nil,         // ns_prefix
+			nil,         // [
+			reduce(100), // ], reduce: ListItems
+			reduce(100), // _, reduce: ListItems
+			nil,         // ,
+			reduce(100), // subgraph, reduce: ListItems
+			reduce(100), // include, reduce: ListItems
+			nil,         // edgearrow
+			nil,         // edgeline
+			nil,         // edgebiarrow
+			nil,         // edgebackarrow
+			nil,         // edge_attr_open
+			nil,         // edge_attr_open_head
+			nil,         // edge_attr_close
+			nil,         // edge_attr_close_nohead
+			nil,         // edge_key
+			nil,         // {
+			nil,         // }
+			nil,         // :
+			nil,         // @let
+			nil,         // =
+			nil,         // @template
+			nil,         // (
+			nil,         // )
+			nil,         // @use
+			nil,         // @namespace
+			nil,         // !
+			nil,         // @graph
+			nil,         // @defaults
+			nil,         // @edge_defaults
+			reduce(100), // numeric_literal, reduce: ListItems
+			reduce(100), // raw_string, reduce: ListItems
+			reduce(100), // param_ref, reduce: ListItems
+		},
+	},
+	actionRow{ // S466
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(78), // ␚, reduce: GroupDecl
+			nil,        // empty
+			reduce(78), // ;, reduce: GroupDecl
+			reduce(78), // id, reduce: GroupDecl
+			reduce(78), // dotted_id, reduce: GroupDecl
+			reduce(78), // quoted_string, reduce: GroupDecl
+			reduce(78), // ns_prefix, reduce: GroupDecl
+			nil,        // [
+			nil,        // ]
+			reduce(78), // _, reduce: GroupDecl
+			nil,        // ,
+			reduce(78), // subgraph, reduce: GroupDecl
+			reduce(78), // include, reduce: GroupDecl
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(78), // {, reduce: GroupDecl
+			nil,        // }
+			nil,        // :
+			reduce(78), // @let, reduce: GroupDecl
+			nil,        // =
+			reduce(78), // @template, reduce: GroupDecl
+			nil,        // (
+			nil,        // )
+			reduce(78), // @use, reduce: GroupDecl
+			reduce(78), // @namespace, reduce: GroupDecl
+			reduce(78), // !, reduce: GroupDecl
+			reduce(78), // @graph, reduce: GroupDecl
+			reduce(78), // @defaults, reduce: GroupDecl
+			reduce(78), // @edge_defaults, reduce: GroupDecl
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S467
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			shift(155), // id
+			shift(107), // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			shift(489), // ]
+			shift(157), // _
+			nil,        // ,
+			shift(159), // subgraph
+			shift(160), // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			shift(115), // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S468
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(13), // ;, reduce: NodeDecl
+			reduce(13), // id, reduce: NodeDecl
+			reduce(13), // dotted_id, reduce: NodeDecl
+			reduce(13), // quoted_string, reduce: NodeDecl
+			reduce(13), // ns_prefix, reduce: NodeDecl
+			nil,        // [
+			nil,        // ]
+			reduce(13), // _, reduce: NodeDecl
+			nil,        // ,
+			reduce(13), // subgraph, reduce: NodeDecl
+			reduce(13), // include, reduce: NodeDecl
+			reduce(13), // edgearrow, reduce: NodeDecl
+			reduce(13), // edgeline, reduce: NodeDecl
+			reduce(13), // edgebiarrow, reduce: NodeDecl
+			reduce(13), // edgebackarrow, reduce: NodeDecl
+			reduce(13), // edge_attr_open, reduce: NodeDecl
+			reduce(13), // edge_attr_open_head, reduce: NodeDecl
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(13), // {, reduce: NodeDecl
+			reduce(13), // }, reduce: NodeDecl
+			nil,        // :
+			reduce(13), // @let, reduce: NodeDecl
+			nil,        // =
+			reduce(13), // @template, reduce: NodeDecl
+			nil,        // (
+			nil,        // )
+			reduce(13), // @use, reduce: NodeDecl
+			reduce(13), // @namespace, reduce: NodeDecl
+			reduce(13), // !, reduce: NodeDecl
+			reduce(13), // @graph, reduce: NodeDecl
+			reduce(13), // @defaults, reduce: NodeDecl
+			reduce(13), // @edge_defaults, reduce: NodeDecl
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S469
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // id
+			nil,        // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			nil,        // _
+			nil,        // ,
+			nil,        // subgraph
+			nil,        // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			shift(379), // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S470
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(80), // ;, reduce: GroupDecl
+			reduce(80), // id, reduce: GroupDecl
+			reduce(80), // dotted_id, reduce: GroupDecl
+			reduce(80), // quoted_string, reduce: GroupDecl
+			reduce(80), // ns_prefix, reduce: GroupDecl
+			nil,        // [
+			nil,        // ]
+			reduce(80), // _, reduce: GroupDecl
+			nil,        // ,
+			reduce(80), // subgraph, reduce: GroupDecl
+			reduce(80), // include, reduce: GroupDecl
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(80), // {, reduce: GroupDecl
+			reduce(80), // }, reduce: GroupDecl
+			nil,        // :
+			reduce(80), // @let, reduce: GroupDecl
+			nil,        // =
+			reduce(80), // @template, reduce: GroupDecl
+			nil,        // (
+			nil,        // )
+			reduce(80), // @use, reduce: GroupDecl
+			reduce(80), // @namespace, reduce: GroupDecl
+			reduce(80), // !, reduce: GroupDecl
+			reduce(80), // @graph, reduce: GroupDecl
+			reduce(80), // @defaults, reduce: GroupDecl
+			reduce(80), // @edge_defaults, reduce: GroupDecl
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S471
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			shift(155), // id
+			shift(107), // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			shift(492), // ]
+			shift(157), // _
+			nil,        // ,
+			shift(159), // subgraph
+			shift(160), // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			shift(115), // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S472
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(82), // ;, reduce: GroupBody
+			reduce(82), // id, reduce: GroupBody
+			reduce(82), // dotted_id, reduce: GroupBody
+			reduce(82), // quoted_string, reduce: GroupBody
+			reduce(82), // ns_prefix, reduce: GroupBody
+			nil,        // [
+			nil,        // ]
+			reduce(82), // _, reduce: GroupBody
+			nil,        // ,
+			reduce(82), // subgraph, reduce: GroupBody
+			reduce(82), // include, reduce: GroupBody
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(82), // {, reduce: GroupBody
+			reduce(82), // }, reduce: GroupBody
+			nil,        // :
+			reduce(82), // @let, reduce: GroupBody
+			nil,        // =
+			reduce(82), // @template, reduce: GroupBody
+			nil,        // (
+			nil,        // )
+			reduce(82), // @use, reduce: GroupBody
+			reduce(82), // @namespace, reduce: GroupBody
+			reduce(82), // !, reduce: GroupBody
+			reduce(82), // @graph, reduce: GroupBody
+			reduce(82), // @defaults, reduce: GroupBody
+			reduce(82), // @edge_defaults, reduce: GroupBody
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S473
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(43), // ;, reduce: EdgeRef
+			reduce(43), // id, reduce: EdgeRef
+			reduce(43), // dotted_id, reduce: EdgeRef
+			reduce(43), // quoted_string, reduce: EdgeRef
+			reduce(43), // ns_prefix, reduce: EdgeRef
+			nil,        // [
+			nil,        // ]
+			reduce(43), // _, reduce: EdgeRef
+			nil,        // ,
+			reduce(43), // subgraph, reduce: EdgeRef
+			reduce(43), // include, reduce: EdgeRef
+			reduce(43), // edgearrow, reduce: EdgeRef
+			reduce(43), // edgeline, reduce: EdgeRef
+			reduce(43), // edgebiarrow, reduce: EdgeRef
+			reduce(43), // edgebackarrow, reduce: EdgeRef
+			reduce(43), // edge_attr_open, reduce: EdgeRef
+			reduce(43), // edge_attr_open_head, reduce: EdgeRef
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(43), // {, reduce: EdgeRef
+			reduce(43), // }, reduce: EdgeRef
+			nil,        // :
+			reduce(43), // @let, reduce: EdgeRef
+			nil,        // =
+			reduce(43), // @template, reduce: EdgeRef
+			nil,        // (
+			nil,        // )
+			reduce(43), // @use, reduce: EdgeRef
+			reduce(43), // @namespace, reduce: EdgeRef
+			reduce(43), // !, reduce: EdgeRef
+			reduce(43), // @graph, reduce: EdgeRef
+			reduce(43), // @defaults, reduce: EdgeRef
+			reduce(43), // @edge_defaults, reduce: EdgeRef
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S474
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			nil,       // ␚
+			nil,       // empty
+			reduce(7), // ;, reduce: NodeId
+			reduce(7), // id, reduce: NodeId
+			reduce(7), // dotted_id, reduce: NodeId
+			reduce(7), // quoted_string, reduce: NodeId
+			reduce(7), // ns_prefix, reduce: NodeId
+			nil,       // [
+			nil,       // ]
+			reduce(7), // _, reduce: NodeId
+			nil,       // ,
+			reduce(7), // subgraph, reduce: NodeId
+			reduce(7), // include, reduce: NodeId
+			reduce(7), // edgearrow, reduce: NodeId
+			reduce(7), // edgeline, reduce: NodeId
+			reduce(7), // edgebiarrow, reduce: NodeId
+			reduce(7), // edgebackarrow, reduce: NodeId
+			reduce(7), // edge_attr_open, reduce: NodeId
+			reduce(7), // edge_attr_open_head, reduce: NodeId
+			nil,       // edge_attr_close
+			nil,       // edge_attr_close_nohead
+			nil,       // edge_key
+			reduce(7), // {, reduce: NodeId
+			reduce(7), // }, reduce: NodeId
+			nil,       // :
+			reduce(7), // @let, reduce: NodeId
+			nil,       // =
+			reduce(7), // @template, reduce: NodeId
+			nil,       // (
+			nil,       // )
+			reduce(7), // @use, reduce: NodeId
+			reduce(7), // @namespace, reduce: NodeId
+			reduce(7), // !, reduce: NodeId
+			reduce(7), // @graph, reduce: NodeId
+			reduce(7), // @defaults, reduce: NodeId
+			reduce(7), // @edge_defaults, reduce: NodeId
+			nil,       // numeric_literal
+			nil,       // raw_string
+			nil,       // param_ref
+		},
+	},
+	actionRow{ // S475
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			nil,       // ␚
+			nil,       // empty
+			reduce(8), // ;, reduce: NodeId
+			reduce(8), // id, reduce: NodeId
+			reduce(8), // dotted_id, reduce: NodeId
+			reduce(8), // quoted_string, reduce: NodeId
+			reduce(8), // ns_prefix, reduce: NodeId
+			nil,       // [
+			nil,       // ]
+			reduce(8), // _, reduce: NodeId
+			nil,       // ,
+			reduce(8), // subgraph, reduce: NodeId
+			reduce(8), // include, reduce: NodeId
+			reduce(8), // edgearrow, reduce: NodeId
+			reduce(8), // edgeline, reduce: NodeId
+			reduce(8), // edgebiarrow, reduce: NodeId
+			reduce(8), // edgebackarrow, reduce: NodeId
+			reduce(8), // edge_attr_open, reduce: NodeId
+			reduce(8), // edge_attr_open_head, reduce: NodeId
+			nil,       // edge_attr_close
+			nil,       // edge_attr_close_nohead
+			nil,       // edge_key
+			reduce(8), // {, reduce: NodeId
+			reduce(8), // }, reduce: NodeId
+			nil,       // :
+			reduce(8), // @let, reduce: NodeId
+			nil,       // =
+			reduce(8), // @template, reduce: NodeId
+			nil,       // (
+			nil,       // )
+			reduce(8), // @use, reduce: NodeId
+			reduce(8), // @namespace, reduce: NodeId
+			reduce(8), // !, reduce: NodeId
+			reduce(8), // @graph, reduce: NodeId
+			reduce(8), // @defaults, reduce: NodeId
+			reduce(8), // @edge_defaults, reduce: NodeId
+			nil,       // numeric_literal
+			nil,       // raw_string
+			nil,       // param_ref
+		},
+	},
+	actionRow{ // S476
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			nil,       // ␚
+			nil,       // empty
+			reduce(9), // ;, reduce: NodeId
+			reduce(9), // id, reduce: NodeId
+			reduce(9), // dotted_id, reduce: NodeId
+			reduce(9), // quoted_string, reduce: NodeId
+			reduce(9), // ns_prefix, reduce: NodeId
+			nil,       // [
+			nil,       // ]
+			reduce(9), // _, reduce: NodeId
+			nil,       // ,
+			reduce(9), // subgraph, reduce: NodeId
+			reduce(9), // include, reduce: NodeId
+			reduce(9), // edgearrow, reduce: NodeId
+			reduce(9), // edgeline, reduce: NodeId
+			reduce(9), // edgebiarrow, reduce: NodeId
+			reduce(9), // edgebackarrow, reduce: NodeId
+			reduce(9), // edge_attr_open, reduce: NodeId
+			reduce(9), // edge_attr_open_head, reduce: NodeId
+			nil,       // edge_attr_close
+			nil,       // edge_attr_close_nohead
+			nil,       // edge_key
+			reduce(9), // {, reduce: NodeId
+			reduce(9), // }, reduce: NodeId
+			nil,       // :
+			reduce(9), // @let, reduce: NodeId
+			nil,       // =
+			reduce(9), // @template, reduce: NodeId
+			nil,       // (
+			nil,       // )
+			reduce(9), // @use, reduce: NodeId
+			reduce(9), // @namespace, reduce: NodeId
+			reduce(9), // !, reduce: NodeId
+			reduce(9), // @graph, reduce: NodeId
+			reduce(9), // @defaults, reduce: NodeId
+			reduce(9), // @edge_defaults, reduce: NodeId
+			nil,       // numeric_literal
+			nil,       // raw_string
+			nil,       // param_ref
+		},
+	},
+	actionRow{ // S477
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // id
+			nil,        // dotted_id
+			shift(493), // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			nil,        // _
+			nil,        // ,
+			nil,        // subgraph
+			nil,        // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S478
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(41), // ;, reduce: EdgeEnd
+			reduce(41), // id, reduce: EdgeEnd
+			reduce(41), // dotted_id, reduce: EdgeEnd
+			reduce(41), // quoted_string, reduce: EdgeEnd
+			reduce(41), // ns_prefix, reduce: EdgeEnd
+			nil,        // [
+			nil,        // ]
+			reduce(41), // _, reduce: EdgeEnd
+			nil,        // ,
+			reduce(41), // subgraph, reduce: EdgeEnd
+			reduce(41), // include, reduce: EdgeEnd
+			reduce(41), // edgearrow, reduce: EdgeEnd
+			reduce(41), // edgeline, reduce: EdgeEnd
+			reduce(41), // edgebiarrow, reduce: EdgeEnd
+			reduce(41), // edgebackarrow, reduce: EdgeEnd
+			reduce(41), // edge_attr_open, reduce: EdgeEnd
+			reduce(41), // edge_attr_open_head, reduce: EdgeEnd
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(41), // {, reduce: EdgeEnd
+			reduce(41), // }, reduce: EdgeEnd
+			nil,        // :
+			reduce(41), // @let, reduce: EdgeEnd
+			nil,        // =
+			reduce(41), // @template, reduce: EdgeEnd
+			nil,        // (
+			nil,        // )
+			reduce(41), // @use, reduce: EdgeEnd
+			reduce(41), // @namespace, reduce: EdgeEnd
+			reduce(41), // !, reduce: EdgeEnd
+			reduce(41), // @graph, reduce: EdgeEnd
+			reduce(41), // @defaults, reduce: EdgeEnd
+			reduce(41), // @edge_defaults, reduce: EdgeEnd
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S479
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(37), // ;, reduce: EdgeRHS
+			reduce(37), // id, reduce: EdgeRHS
+			reduce(37), // dotted_id, reduce: EdgeRHS
+			reduce(37), // quoted_string, reduce: EdgeRHS
+			reduce(37), // ns_prefix, reduce: EdgeRHS
+			nil,        // [
+			nil,        // ]
+			reduce(37), // _, reduce: EdgeRHS
+			nil,        // ,
+			reduce(37), // subgraph, reduce: EdgeRHS
+			reduce(37), // include, reduce: EdgeRHS
+			reduce(37), // edgearrow, reduce: EdgeRHS
+			reduce(37), // edgeline, reduce: EdgeRHS
+			reduce(37), // edgebiarrow, reduce: EdgeRHS
+			reduce(37), // edgebackarrow, reduce: EdgeRHS
+			reduce(37), // edge_attr_open, reduce: EdgeRHS
+			reduce(37), // edge_attr_open_head, reduce: EdgeRHS
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(37), // {, reduce: EdgeRHS
+			reduce(37), // }, reduce: EdgeRHS
+			nil,        // :
+			reduce(37), // @let, reduce: EdgeRHS
+			nil,        // =
+			reduce(37), // @template, reduce: EdgeRHS
+			nil,        // (
+			nil,        // )
+			reduce(37), // @use, reduce: EdgeRHS
+			reduce(37), // @namespace, reduce: EdgeRHS
+			reduce(37), // !, reduce: EdgeRHS
+			reduce(37), // @graph, reduce: EdgeRHS
+			reduce(37), // @defaults, reduce: EdgeRHS
+			reduce(37), // @edge_defaults, reduce: EdgeRHS
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S480
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			shift(155), // id
+			shift(107), // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			shift(157), // _
+			nil,        // ,
+			shift(159), // subgraph
+			shift(160), // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			shift(134), // edge_attr_close
+			shift(135), // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			shift(138), // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S481
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			shift(187), // id
+			shift(188), // dotted_id
+			shift(189), // quoted_string
+			shift(190), // ns_prefix
+			nil,        // [
+			nil,        // ]
+			shift(193), // _
+			nil,        // ,
+			nil,        // subgraph
+			nil,        // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			shift(385), // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S482
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(61), // ;, reduce: TemplateDecl
+			reduce(61), // id, reduce: TemplateDecl
+			reduce(61), // dotted_id, reduce: TemplateDecl
+			reduce(61), // quoted_string, reduce: TemplateDecl
+			reduce(61), // ns_prefix, reduce: TemplateDecl
+			nil,        // [
+			nil,        // ]
+			reduce(61), // _, reduce: TemplateDecl
+			nil,        // ,
+			reduce(61), // subgraph, reduce: TemplateDecl
+			reduce(61), // include, reduce: TemplateDecl
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(61), // {, reduce: TemplateDecl
+			reduce(61), // }, reduce: TemplateDecl
+			nil,        // :
+			reduce(61), // @let, reduce: TemplateDecl
+			nil,        // =
+			reduce(61), // @template, reduce: TemplateDecl
+			nil,        // (
+			nil,        // )
+			reduce(61), // @use, reduce: TemplateDecl
+			reduce(61), // @namespace, reduce: TemplateDecl
+			reduce(61), // !, reduce: TemplateDecl
+			reduce(61), // @graph, reduce: TemplateDecl
+			reduce(61), // @defaults, reduce: TemplateDecl
+			reduce(61), // @edge_defaults, reduce: TemplateDecl
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S483
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // id
+			nil,        // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			nil,        // _
+			nil,        // ,
+			nil,        // subgraph
+			nil,        // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			shift(379), // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S484
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			shift(155), // id
+			shift(107), // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			shift(157), // _
+			nil,        // ,
+			shift(159), // subgraph
+			shift(160), // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			shift(498), // )
+			nil,        // @use
+			nil,        // @namespace
+			shift(257), // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S485
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(66), // ;, reduce: TemplateUseDecl
+			reduce(66), // id, reduce: TemplateUseDecl
+			reduce(66), // dotted_id, reduce: TemplateUseDecl
+			reduce(66), // quoted_string, reduce: TemplateUseDecl
+			reduce(66), // ns_prefix, reduce: TemplateUseDecl
+			nil,        // [
+			nil,        // ]
+			reduce(66), // _, reduce: TemplateUseDecl
+			nil,        // ,
+			reduce(66), // subgraph, reduce: TemplateUseDecl
+			reduce(66), // include, reduce: TemplateUseDecl
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(66), // {, reduce: TemplateUseDecl
+			reduce(66), // }, reduce: TemplateUseDecl
+			nil,        // :
+			reduce(66), // @let, reduce: TemplateUseDecl
+			nil,        // =
+			reduce(66), // @template, reduce: TemplateUseDecl
+			nil,        // (
+			nil,        // )
+			reduce(66), // @use, reduce: TemplateUseDecl
+			reduce(66), // @namespace, reduce: TemplateUseDecl
+			reduce(66), // !, reduce: TemplateUseDecl
+			reduce(66), // @graph, reduce: TemplateUseDecl
+			reduce(66), // @defaults, reduce: TemplateUseDecl
+			reduce(66), // @edge_defaults, reduce: TemplateUseDecl
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S486
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(73), // ;, reduce: DefaultsDecl
+			reduce(73), // id, reduce: DefaultsDecl
+			reduce(73), // dotted_id, reduce: DefaultsDecl
+			reduce(73), // quoted_string, reduce: DefaultsDecl
+			reduce(73), // ns_prefix, reduce: DefaultsDecl
+			nil,        // [
+			nil,        // ]
+			reduce(73), // _, reduce: DefaultsDecl
+			nil,        // ,
+			reduce(73), // subgraph, reduce: DefaultsDecl
+			reduce(73), // include, reduce: DefaultsDecl
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(73), // {, reduce: DefaultsDecl
+			reduce(73), // }, reduce: DefaultsDecl
+			nil,        // :
+			reduce(73), // @let, reduce: DefaultsDecl
+			nil,        // =
+			reduce(73), // @template, reduce: DefaultsDecl
+			nil,        // (
+			nil,        // )
+			reduce(73), // @use, reduce: DefaultsDecl
+			reduce(73), // @namespace, reduce: DefaultsDecl
+			reduce(73), // !, reduce: DefaultsDecl
+			reduce(73), // @graph, reduce: DefaultsDecl
+			reduce(73), // @defaults, reduce: DefaultsDecl
+			reduce(73), // @edge_defaults, reduce: DefaultsDecl
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S487
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(74), // ;, reduce: DefaultsDecl
+			reduce(74), // id, reduce: DefaultsDecl
+			reduce(74), // dotted_id, reduce: DefaultsDecl
+			reduce(74), // quoted_string, reduce: DefaultsDecl
+			reduce(74), // ns_prefix, reduce: DefaultsDecl
+			nil,        // [
+			nil,        // ]
+			reduce(74), // _, reduce: DefaultsDecl
+			nil,        // ,
+			reduce(74), // subgraph, reduce: DefaultsDecl
+			reduce(74), // include, reduce: DefaultsDecl
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(74), // {, reduce: DefaultsDecl
+			reduce(74), // }, reduce: DefaultsDecl
+			nil,        // :
+			reduce(74), // @let, reduce: DefaultsDecl
+			nil,        // =
+			reduce(74), // @template, reduce: DefaultsDecl
+			nil,        // (
+			nil,        // )
+			reduce(74), // @use, reduce: DefaultsDecl
+			reduce(74), // @namespace, reduce: DefaultsDecl
+			reduce(74), // !, reduce: DefaultsDecl
+			reduce(74), // @graph, reduce: DefaultsDecl
+			reduce(74), // @defaults, reduce: DefaultsDecl
+			reduce(74), // @edge_defaults, reduce: DefaultsDecl
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S488
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			reduce(93), // id, reduce: AttrVal
+			reduce(93), // dotted_id, reduce: AttrVal
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			reduce(93), // _, reduce: AttrVal
+			reduce(93), // ,, reduce: AttrVal
+			reduce(93), // subgraph, reduce: AttrVal
+			reduce(93), // include, reduce: AttrVal
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			reduce(93), // ), reduce: AttrVal
+			nil,        // @use
+			nil,        // @namespace
+			reduce(93), // !, reduce: AttrVal
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S489
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(12), // ;, reduce: NodeDecl
+			reduce(12), // id, reduce: NodeDecl
+			reduce(12), // dotted_id, reduce: NodeDecl
+			reduce(12), // quoted_string, reduce: NodeDecl
+			reduce(12), // ns_prefix, reduce: NodeDecl
+			nil,        // [
+			nil,        // ]
+			reduce(12), // _, reduce: NodeDecl
+			nil,        // ,
+			reduce(12), // subgraph, reduce: NodeDecl
+			reduce(12), // include, reduce: NodeDecl
+			reduce(12), // edgearrow, reduce: NodeDecl
+			reduce(12), // edgeline, reduce: NodeDecl
+			reduce(12), // edgebiarrow, reduce: NodeDecl
+			reduce(12), // edgebackarrow, reduce: NodeDecl
+			reduce(12), // edge_attr_open, reduce: NodeDecl
+			reduce(12), // edge_attr_open_head, reduce: NodeDecl
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(12), // {, reduce: NodeDecl
+			reduce(12), // }, reduce: NodeDecl
+			nil,        // :
+			reduce(12), // @let, reduce: NodeDecl
+			nil,        // =
+			reduce(12), // @template, reduce: NodeDecl
+			nil,        // (
+			nil,        // )
+			reduce(12), // @use, reduce: NodeDecl
+			reduce(12), // @namespace, reduce: NodeDecl
+			reduce(12), // !, reduce: NodeDecl
+			reduce(12), // @graph, reduce: NodeDecl
+			reduce(12), // @defaults, reduce: NodeDecl
+			reduce(12), // @edge_defaults, reduce: NodeDecl
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S490
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(77), // ;, reduce: GroupDecl
+			reduce(77), // id, reduce: GroupDecl
+			reduce(77), // dotted_id, reduce: GroupDecl
+			reduce(77), // quoted_string, reduce: GroupDecl
+			reduce(77), // ns_prefix, reduce: GroupDecl
+			nil,        // [
+			nil,        // ]
+			reduce(77), // _, reduce: GroupDecl
+			nil,        // ,
+			reduce(77), // subgraph, reduce: GroupDecl
+			reduce(77), // include, reduce: GroupDecl
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(77), // {, reduce: GroupDecl
+			reduce(77), // }, reduce: GroupDecl
+			nil,        // :
+			reduce(77), // @let, reduce: GroupDecl
+			nil,        // =
+			reduce(77), // @template, reduce: GroupDecl
+			nil,        // (
+			nil,        // )
+			reduce(77), // @use, reduce: GroupDecl
+			reduce(77), // @namespace, reduce: GroupDecl
+			reduce(77), // !, reduce: GroupDecl
+			reduce(77), // @graph, reduce: GroupDecl
+			reduce(77), // @defaults, reduce: GroupDecl
+			reduce(77), // @edge_defaults, reduce: GroupDecl
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S491
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			shift(155), // id
+			shift(107), // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			shift(499), // ]
+			shift(157), // _
+			nil,        // ,
+			shift(159), // subgraph
+			shift(160), // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			shift(115), // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S492
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // id
+			nil,        // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			nil,        // _
+			nil,        // ,
+			nil,        // subgraph
+			nil,        // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			shift(379), // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S493
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(10), // ;, reduce: NodeId
+			reduce(10), // id, reduce: NodeId
+			reduce(10), // dotted_id, reduce: NodeId
+			reduce(10), // quoted_string, reduce: NodeId
+			reduce(10), // ns_prefix, reduce: NodeId
+			nil,        // [
+			nil,        // ]
+			reduce(10), // _, reduce: NodeId
+			nil,        // ,
+			reduce(10), // subgraph, reduce: NodeId
+			reduce(10), // include, reduce: NodeId
+			reduce(10), // edgearrow, reduce: NodeId
+			reduce(10), // edgeline, reduce: NodeId
+			reduce(10), // edgebiarrow, reduce: NodeId
+			reduce(10), // edgebackarrow, reduce: NodeId
+			reduce(10), // edge_attr_open, reduce: NodeId
+			reduce(10), // edge_attr_open_head, reduce: NodeId
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(10), // {, reduce: NodeId
+			reduce(10), // }, reduce: NodeId
+			nil,        // :
+			reduce(10), // @let, reduce: NodeId
+			nil,        // =
+			reduce(10), // @template, reduce: NodeId
+			nil,        // (
+			nil,        // )
+			reduce(10), // @use, reduce: NodeId
+			reduce(10), // @namespace, reduce: NodeId
+			reduce(10), // !, reduce: NodeId
+			reduce(10), // @graph, reduce: NodeId
+			reduce(10), // @defaults, reduce: NodeId
+			reduce(10), // @edge_defaults, reduce: NodeId
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S494
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			shift(187), // id
+			shift(188), // dotted_id
+			shift(189), // quoted_string
+			shift(190), // ns_prefix
+			nil,        // [
+			nil,        // ]
+			shift(193), // _
+			nil,        // ,
+			nil,        // subgraph
+			nil,        // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			shift(385), // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S495
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(38), // ;, reduce: EdgeRHS
+			reduce(38), // id, reduce: EdgeRHS
+			reduce(38), // dotted_id, reduce: EdgeRHS
+			reduce(38), // quoted_string, reduce: EdgeRHS
+			reduce(38), // ns_prefix, reduce: EdgeRHS
+			nil,        // [
+			nil,        // ]
+			reduce(38), // _, reduce: EdgeRHS
+			nil,        // ,
+			reduce(38), // subgraph, reduce: EdgeRHS
+			reduce(38), // include, reduce: EdgeRHS
+			reduce(38), // edgearrow, reduce: EdgeRHS
+			reduce(38), // edgeline, reduce: EdgeRHS
+			reduce(38), // edgebiarrow, reduce: EdgeRHS
+			reduce(38), // edgebackarrow, reduce: EdgeRHS
+			reduce(38), // edge_attr_open, reduce: EdgeRHS
+			reduce(38), // edge_attr_open_head, reduce: EdgeRHS
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(38), // {, reduce: EdgeRHS
+			reduce(38), // }, reduce: EdgeRHS
+			nil,        // :
+			reduce(38), // @let, reduce: EdgeRHS
+			nil,        // =
+			reduce(38), // @template, reduce: EdgeRHS
+			nil,        // (
+			nil,        // )
+			reduce(38), // @use, reduce: EdgeRHS
+			reduce(38), // @namespace, reduce: EdgeRHS
+			reduce(38), // !, reduce: EdgeRHS
+			reduce(38), // @graph, reduce: EdgeRHS
+			reduce(38), // @defaults, reduce: EdgeRHS
+			reduce(38), // @edge_defaults, reduce: EdgeRHS
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S496
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(62), // ;, reduce: TemplateDecl
+			reduce(62), // id, reduce: TemplateDecl
+			reduce(62), // dotted_id, reduce: TemplateDecl
+			reduce(62), // quoted_string, reduce: TemplateDecl
+			reduce(62), // ns_prefix, reduce: TemplateDecl
+			nil,        // [
+			nil,        // ]
+			reduce(62), // _, reduce: TemplateDecl
+			nil,        // ,
+			reduce(62), // subgraph, reduce: TemplateDecl
+			reduce(62), // include, reduce: TemplateDecl
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(62), // {, reduce: TemplateDecl
+			reduce(62), // }, reduce: TemplateDecl
+			nil,        // :
+			reduce(62), // @let, reduce: TemplateDecl
+			nil,        // =
+			reduce(62), // @template, reduce: TemplateDecl
+			nil,        // (
+			nil,        // )
+			reduce(62), // @use, reduce: TemplateDecl
+			reduce(62), // @namespace, reduce: TemplateDecl
+			reduce(62), // !, reduce: TemplateDecl
+			reduce(62), // @graph, reduce: TemplateDecl
+			reduce(62), // @defaults, reduce: TemplateDecl
+			reduce(62), // @edge_defaults, reduce: TemplateDecl
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S497
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			shift(155), // id
+			shift(107), // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			shift(157), // _
+			nil,        // ,
+			shift(159), // subgraph
+			shift(160), // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			nil,        // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			shift(502), // )
+			nil,        // @use
+			nil,        // @namespace
+			shift(257), // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S498
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(67), // ;, reduce: TemplateUseDecl
+			reduce(67), // id, reduce: TemplateUseDecl
+			reduce(67), // dotted_id, reduce: TemplateUseDecl
+			reduce(67), // quoted_string, reduce: TemplateUseDecl
+			reduce(67), // ns_prefix, reduce: TemplateUseDecl
+			nil,        // [
+			nil,        // ]
+			reduce(67), // _, reduce: TemplateUseDecl
+			nil,        // ,
+			reduce(67), // subgraph, reduce: TemplateUseDecl
+			reduce(67), // include, reduce: TemplateUseDecl
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(67), // {, reduce: TemplateUseDecl
+			reduce(67), // }, reduce: TemplateUseDecl
+			nil,        // :
+			reduce(67), // @let, reduce: TemplateUseDecl
+			nil,        // =
+			reduce(67), // @template, reduce: TemplateUseDecl
+			nil,        // (
+			nil,        // )
+			reduce(67), // @use, reduce: TemplateUseDecl
+			reduce(67), // @namespace, reduce: TemplateUseDecl
+			reduce(67), // !, reduce: TemplateUseDecl
+			reduce(67), // @graph, reduce: TemplateUseDecl
+			reduce(67), // @defaults, reduce: TemplateUseDecl
+			reduce(67), // @edge_defaults, reduce: TemplateUseDecl
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S499
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // id
+			nil,        // dotted_id
+			nil,        // quoted_string
+			nil,        // ns_prefix
+			nil,        // [
+			nil,        // ]
+			nil,        // _
+			nil,        // ,
+			nil,        // subgraph
+			nil,        // include
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			shift(379), // {
+			nil,        // }
+			nil,        // :
+			nil,        // @let
+			nil,        // =
+			nil,        // @template
+			nil,        // (
+			nil,        // )
+			nil,        // @use
+			nil,        // @namespace
+			nil,        // !
+			nil,        // @graph
+			nil,        // @defaults
+			nil,        // @edge_defaults
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S500
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(79), // ;, reduce: GroupDecl
+			reduce(79), // id, reduce: GroupDecl
+			reduce(79), // dotted_id, reduce: GroupDecl
+			reduce(79), // quoted_string, reduce: GroupDecl
+			reduce(79), // ns_prefix, reduce: GroupDecl
+			nil,        // [
+			nil,        // ]
+			reduce(79), // _, reduce: GroupDecl
+			nil,        // ,
+			reduce(79), // subgraph, reduce: GroupDecl
+			reduce(79), // include, reduce: GroupDecl
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(79), // {, reduce: GroupDecl
+			reduce(79), // }, reduce: GroupDecl
+			nil,        // :
+			reduce(79), // @let, reduce: GroupDecl
+			nil,        // =
+			reduce(79), // @template, reduce: GroupDecl
+			nil,        // (
+			nil,        // )
+			reduce(79), // @use, reduce: GroupDecl
+			reduce(79), // @namespace, reduce: GroupDecl
+			reduce(79), // !, reduce: GroupDecl
+			reduce(79), // @graph, reduce: GroupDecl
+			reduce(79), // @defaults, reduce: GroupDecl
+			reduce(79), // @edge_defaults, reduce: GroupDecl
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S501
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(39), // ;, reduce: EdgeRHS
+			reduce(39), // id, reduce: EdgeRHS
+			reduce(39), // dotted_id, reduce: EdgeRHS
+			reduce(39), // quoted_string, reduce: EdgeRHS
+			reduce(39), // ns_prefix, reduce: EdgeRHS
+			nil,        // [
+			nil,        // ]
+			reduce(39), // _, reduce: EdgeRHS
+			nil,        // ,
+			reduce(39), // subgraph, reduce: EdgeRHS
+			reduce(39), // include, reduce: EdgeRHS
+			reduce(39), // edgearrow, reduce: EdgeRHS
+			reduce(39), // edgeline, reduce: EdgeRHS
+			reduce(39), // edgebiarrow, reduce: EdgeRHS
+			reduce(39), // edgebackarrow, reduce: EdgeRHS
+			reduce(39), // edge_attr_open, reduce: EdgeRHS
+			reduce(39), // edge_attr_open_head, reduce: EdgeRHS
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(39), // {, reduce: EdgeRHS
+			reduce(39), // }, reduce: EdgeRHS
+			nil,        // :
+			reduce(39), // @let, reduce: EdgeRHS
+			nil,        // =
+			reduce(39), // @template, reduce: EdgeRHS
+			nil,        // (
+			nil,        // )
+			reduce(39), // @use, reduce: EdgeRHS
+			reduce(39), // @namespace, reduce: EdgeRHS
+			reduce(39), // !, reduce: EdgeRHS
+			reduce(39), // @graph, reduce: EdgeRHS
+			reduce(39), // @defaults, reduce: EdgeRHS
+			reduce(39), // @edge_defaults, reduce: EdgeRHS
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S502
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(68), // ;, reduce: TemplateUseDecl
+			reduce(68), // id, reduce: TemplateUseDecl
+			reduce(68), // dotted_id, reduce: TemplateUseDecl
+			reduce(68), // quoted_string, reduce: TemplateUseDecl
+			reduce(68), // ns_prefix, reduce: TemplateUseDecl
+			nil,        // [
+			nil,        // ]
+			reduce(68), // _, reduce: TemplateUseDecl
+			nil,        // ,
+			reduce(68), // subgraph, reduce: TemplateUseDecl
+			reduce(68), // include, reduce: TemplateUseDecl
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(68), // {, reduce: TemplateUseDecl
+			reduce(68), // }, reduce: TemplateUseDecl
+			nil,        // :
+			reduce(68), // @let, reduce: TemplateUseDecl
+			nil,        // =
+			reduce(68), // @template, reduce: TemplateUseDecl
+			nil,        // (
+			nil,        // )
+			reduce(68), // @use, reduce: TemplateUseDecl
+			reduce(68), // @namespace, reduce: TemplateUseDecl
+			reduce(68), // !, reduce: TemplateUseDecl
+			reduce(68), // @graph, reduce: TemplateUseDecl
+			reduce(68), // @defaults, reduce: TemplateUseDecl
+			reduce(68), // @edge_defaults, reduce: TemplateUseDecl
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
+		},
+	},
+	actionRow{ // S503
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(78), // ;, reduce: GroupDecl
+			reduce(78), // id, reduce: GroupDecl
+			reduce(78), // dotted_id, reduce: GroupDecl
+			reduce(78), // quoted_string, reduce: GroupDecl
+			reduce(78), // ns_prefix, reduce: GroupDecl
+			nil,        // [
+			nil,        // ]
+			reduce(78), // _, reduce: GroupDecl
+			nil,        // ,
+			reduce(78), // subgraph, reduce: GroupDecl
+			reduce(78), // include, reduce: GroupDecl
+			nil,        // edgearrow
+			nil,        // edgeline
+			nil,        // edgebiarrow
+			nil,        // edgebackarrow
+			nil,        // edge_attr_open
+			nil,        // edge_attr_open_head
+			nil,        // edge_attr_close
+			nil,        // edge_attr_close_nohead
+			nil,        // edge_key
+			reduce(78), // {, reduce: GroupDecl
+			reduce(78), // }, reduce: GroupDecl
+			nil,        // :
+			reduce(78), // @let, reduce: GroupDecl
+			nil,        // =
+			reduce(78), // @template, reduce: GroupDecl
+			nil,        // (
+			nil,        // )
+			reduce(78), // @use, reduce: GroupDecl
+			reduce(78), // @namespace, reduce: GroupDecl
+			reduce(78), // !, reduce: GroupDecl
+			reduce(78), // @graph, reduce: GroupDecl
+			reduce(78), // @defaults, reduce: GroupDecl
+			reduce(78), // @edge_defaults, reduce: GroupDecl
+			nil,        // numeric_literal
+			nil,        // raw_string
+			nil,        // param_ref
 		},
 	},
 }
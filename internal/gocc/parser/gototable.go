@@ -2,7 +2,7 @@
 
 package parser
 
-const numNTSymbols = 12
+const numNTSymbols = 37
 
 type (
 	gotoTable [numStates]gotoRow
@@ -15,825 +15,19655 @@ var gotoTab = gotoTable{
 		1,  // WholeDoc
 		2,  // TopLevelDeclList
 		-1, // OptSep
-		4,  // NodeDecl
+		4,  // NodeId
+		9,  // NodeDecl
+		10, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
 		-1, // EdgeRHS
-		6,  // EdgeDecl
+		14, // EdgeEnd
+		15, // EdgeRef
+		-1, // EdgeRefList
+		17, // EdgeDecl
 		3,  // TopLevelStmt
+		26, // LetDecl
+		24, // TemplateDecl
+		-1, // ParamList
+		25, // TemplateUseDecl
+		23, // NamespaceDecl
+		22, // DeleteDecl
+		21, // GraphAttrsDecl
+		20, // DefaultsDecl
+		19, // IncludeDecl
+		18, // GroupDecl
+		-1, // GroupBody
 		-1, // AttrItems
 		-1, // OptAttrSep
 		-1, // Attr
+		-1, // AttrKey
 		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
 	},
 	gotoRow{ // S1
 		-1, // S'
 		-1, // WholeDoc
 		-1, // TopLevelDeclList
 		-1, // OptSep
+		-1, // NodeId
 		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
 		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
 		-1, // EdgeDecl
 		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
 		-1, // AttrItems
 		-1, // OptAttrSep
 		-1, // Attr
+		-1, // AttrKey
 		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
 	},
 	gotoRow{ // S2
 		-1, // S'
 		-1, // WholeDoc
 		-1, // TopLevelDeclList
 		-1, // OptSep
-		4,  // NodeDecl
+		4,  // NodeId
+		9,  // NodeDecl
+		10, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
 		-1, // EdgeRHS
-		6,  // EdgeDecl
-		7,  // TopLevelStmt
+		14, // EdgeEnd
+		15, // EdgeRef
+		-1, // EdgeRefList
+		17, // EdgeDecl
+		35, // TopLevelStmt
+		26, // LetDecl
+		24, // TemplateDecl
+		-1, // ParamList
+		25, // TemplateUseDecl
+		23, // NamespaceDecl
+		22, // DeleteDecl
+		21, // GraphAttrsDecl
+		20, // DefaultsDecl
+		19, // IncludeDecl
+		18, // GroupDecl
+		-1, // GroupBody
 		-1, // AttrItems
 		-1, // OptAttrSep
 		-1, // Attr
+		-1, // AttrKey
 		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
 	},
 	gotoRow{ // S3
 		-1, // S'
 		-1, // WholeDoc
 		-1, // TopLevelDeclList
 		-1, // OptSep
+		-1, // NodeId
 		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
 		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
 		-1, // EdgeDecl
 		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
 		-1, // AttrItems
 		-1, // OptAttrSep
 		-1, // Attr
+		-1, // AttrKey
 		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
 	},
 	gotoRow{ // S4
 		-1, // S'
 		-1, // WholeDoc
 		-1, // TopLevelDeclList
-		8,  // OptSep
+		-1, // OptSep
+		-1, // NodeId
 		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
 		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
 		-1, // EdgeDecl
 		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
 		-1, // AttrItems
 		-1, // OptAttrSep
 		-1, // Attr
+		-1, // AttrKey
 		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
 	},
 	gotoRow{ // S5
 		-1, // S'
 		-1, // WholeDoc
 		-1, // TopLevelDeclList
 		-1, // OptSep
+		-1, // NodeId
 		-1, // NodeDecl
-		11, // EdgeRHS
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
 		-1, // EdgeDecl
 		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
 		-1, // AttrItems
 		-1, // OptAttrSep
 		-1, // Attr
+		-1, // AttrKey
 		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
 	},
 	gotoRow{ // S6
 		-1, // S'
 		-1, // WholeDoc
 		-1, // TopLevelDeclList
-		14, // OptSep
+		-1, // OptSep
+		-1, // NodeId
 		-1, // NodeDecl
-		15, // EdgeRHS
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
 		-1, // EdgeDecl
 		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
 		-1, // AttrItems
 		-1, // OptAttrSep
 		-1, // Attr
+		-1, // AttrKey
 		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
 	},
 	gotoRow{ // S7
 		-1, // S'
 		-1, // WholeDoc
 		-1, // TopLevelDeclList
 		-1, // OptSep
+		-1, // NodeId
 		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
 		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
 		-1, // EdgeDecl
 		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
 		-1, // AttrItems
 		-1, // OptAttrSep
 		-1, // Attr
+		-1, // AttrKey
 		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
 	},
 	gotoRow{ // S8
 		-1, // S'
 		-1, // WholeDoc
 		-1, // TopLevelDeclList
 		-1, // OptSep
+		-1, // NodeId
 		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
 		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
 		-1, // EdgeDecl
 		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
 		-1, // AttrItems
 		-1, // OptAttrSep
 		-1, // Attr
+		-1, // AttrKey
 		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
 	},
 	gotoRow{ // S9
 		-1, // S'
 		-1, // WholeDoc
 		-1, // TopLevelDeclList
-		-1, // OptSep
+		38, // OptSep
+		-1, // NodeId
 		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
 		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
 		-1, // EdgeDecl
 		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
 		-1, // AttrItems
 		-1, // OptAttrSep
 		-1, // Attr
+		-1, // AttrKey
 		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
 	},
 	gotoRow{ // S10
 		-1, // S'
 		-1, // WholeDoc
 		-1, // TopLevelDeclList
 		-1, // OptSep
+		-1, // NodeId
 		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
 		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
 		-1, // EdgeDecl
 		-1, // TopLevelStmt
-		17, // AttrItems
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
 		-1, // OptAttrSep
-		19, // Attr
+		-1, // Attr
+		-1, // AttrKey
 		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
 	},
 	gotoRow{ // S11
 		-1, // S'
 		-1, // WholeDoc
 		-1, // TopLevelDeclList
 		-1, // OptSep
+		-1, // NodeId
 		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
 		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
 		-1, // EdgeDecl
 		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
 		-1, // AttrItems
 		-1, // OptAttrSep
 		-1, // Attr
+		-1, // AttrKey
 		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
 	},
 	gotoRow{ // S12
 		-1, // S'
 		-1, // WholeDoc
 		-1, // TopLevelDeclList
 		-1, // OptSep
+		41, // NodeId
 		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
 		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
 		-1, // EdgeDecl
 		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
 		-1, // AttrItems
 		-1, // OptAttrSep
 		-1, // Attr
+		-1, // AttrKey
 		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
 	},
 	gotoRow{ // S13
 		-1, // S'
 		-1, // WholeDoc
 		-1, // TopLevelDeclList
 		-1, // OptSep
+		-1, // NodeId
 		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
 		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
 		-1, // EdgeDecl
 		-1, // TopLevelStmt
-		22, // AttrItems
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
 		-1, // OptAttrSep
-		24, // Attr
+		-1, // Attr
+		-1, // AttrKey
 		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
 	},
 	gotoRow{ // S14
 		-1, // S'
 		-1, // WholeDoc
 		-1, // TopLevelDeclList
 		-1, // OptSep
+		-1, // NodeId
 		-1, // NodeDecl
-		-1, // EdgeRHS
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		47, // EdgeArrow
+		52, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		55, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
 		-1, // EdgeDecl
 		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
 		-1, // AttrItems
 		-1, // OptAttrSep
 		-1, // Attr
+		-1, // AttrKey
 		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
 	},
 	gotoRow{ // S15
 		-1, // S'
 		-1, // WholeDoc
 		-1, // TopLevelDeclList
 		-1, // OptSep
+		-1, // NodeId
 		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
 		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
 		-1, // EdgeDecl
 		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
 		-1, // AttrItems
 		-1, // OptAttrSep
 		-1, // Attr
+		-1, // AttrKey
 		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
 	},
 	gotoRow{ // S16
 		-1, // S'
 		-1, // WholeDoc
 		-1, // TopLevelDeclList
-		25, // OptSep
-		-1, // NodeDecl
+		-1, // OptSep
+		56, // NodeId
+		61, // NodeDecl
+		62, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
 		-1, // EdgeRHS
+		-1, // EdgeEnd
+		64, // EdgeRef
+		65, // EdgeRefList
 		-1, // EdgeDecl
 		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
 		-1, // AttrItems
 		-1, // OptAttrSep
 		-1, // Attr
+		-1, // AttrKey
 		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
 	},
 	gotoRow{ // S17
 		-1, // S'
 		-1, // WholeDoc
 		-1, // TopLevelDeclList
-		-1, // OptSep
+		66, // OptSep
+		-1, // NodeId
 		-1, // NodeDecl
-		-1, // EdgeRHS
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		47, // EdgeArrow
+		52, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		67, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
 		-1, // EdgeDecl
 		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
 		-1, // AttrItems
 		-1, // OptAttrSep
-		30, // Attr
+		-1, // Attr
+		-1, // AttrKey
 		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
 	},
 	gotoRow{ // S18
 		-1, // S'
 		-1, // WholeDoc
 		-1, // TopLevelDeclList
-		-1, // OptSep
+		68, // OptSep
+		-1, // NodeId
 		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
 		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
 		-1, // EdgeDecl
 		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
 		-1, // AttrItems
 		-1, // OptAttrSep
 		-1, // Attr
+		-1, // AttrKey
 		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
 	},
 	gotoRow{ // S19
 		-1, // S'
 		-1, // WholeDoc
 		-1, // TopLevelDeclList
-		-1, // OptSep
+		69, // OptSep
+		-1, // NodeId
 		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
 		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
 		-1, // EdgeDecl
 		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
 		-1, // AttrItems
 		-1, // OptAttrSep
 		-1, // Attr
+		-1, // AttrKey
 		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
 	},
 	gotoRow{ // S20
 		-1, // S'
 		-1, // WholeDoc
 		-1, // TopLevelDeclList
-		-1, // OptSep
+		70, // OptSep
+		-1, // NodeId
 		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
 		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
 		-1, // EdgeDecl
 		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
 		-1, // AttrItems
 		-1, // OptAttrSep
 		-1, // Attr
+		-1, // AttrKey
 		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
 	},
 	gotoRow{ // S21
 		-1, // S'
 		-1, // WholeDoc
 		-1, // TopLevelDeclList
-		31, // OptSep
+		71, // OptSep
+		-1, // NodeId
 		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
 		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
 		-1, // EdgeDecl
 		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
 		-1, // AttrItems
 		-1, // OptAttrSep
 		-1, // Attr
+		-1, // AttrKey
 		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
 	},
 	gotoRow{ // S22
 		-1, // S'
 		-1, // WholeDoc
 		-1, // TopLevelDeclList
-		-1, // OptSep
+		72, // OptSep
+		-1, // NodeId
 		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
 		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
 		-1, // EdgeDecl
 		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
 		-1, // AttrItems
 		-1, // OptAttrSep
-		36, // Attr
+		-1, // Attr
+		-1, // AttrKey
 		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
 	},
 	gotoRow{ // S23
 		-1, // S'
 		-1, // WholeDoc
 		-1, // TopLevelDeclList
-		-1, // OptSep
+		73, // OptSep
+		-1, // NodeId
 		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
 		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
 		-1, // EdgeDecl
 		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
 		-1, // AttrItems
 		-1, // OptAttrSep
 		-1, // Attr
+		-1, // AttrKey
 		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
 	},
 	gotoRow{ // S24
 		-1, // S'
 		-1, // WholeDoc
 		-1, // TopLevelDeclList
-		-1, // OptSep
+		74, // OptSep
+		-1, // NodeId
 		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
 		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
 		-1, // EdgeDecl
 		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
 		-1, // AttrItems
 		-1, // OptAttrSep
 		-1, // Attr
+		-1, // AttrKey
 		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
 	},
 	gotoRow{ // S25
 		-1, // S'
 		-1, // WholeDoc
 		-1, // TopLevelDeclList
-		-1, // OptSep
+		75, // OptSep
+		-1, // NodeId
 		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
 		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
 		-1, // EdgeDecl
 		-1, // TopLevelStmt
-		38, // AttrItems
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
 		-1, // OptAttrSep
-		19, // Attr
+		-1, // Attr
+		-1, // AttrKey
 		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
 	},
 	gotoRow{ // S26
 		-1, // S'
 		-1, // WholeDoc
 		-1, // TopLevelDeclList
-		-1, // OptSep
+		76, // OptSep
+		-1, // NodeId
 		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
 		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
 		-1, // EdgeDecl
 		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
 		-1, // AttrItems
 		-1, // OptAttrSep
 		-1, // Attr
+		-1, // AttrKey
 		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
 	},
 	gotoRow{ // S27
 		-1, // S'
 		-1, // WholeDoc
 		-1, // TopLevelDeclList
 		-1, // OptSep
+		-1, // NodeId
 		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
 		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
 		-1, // EdgeDecl
 		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
 		-1, // AttrItems
 		-1, // OptAttrSep
 		-1, // Attr
-		41, // AttrVal
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
 	},
 	gotoRow{ // S28
 		-1, // S'
 		-1, // WholeDoc
 		-1, // TopLevelDeclList
 		-1, // OptSep
+		-1, // NodeId
 		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
 		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
 		-1, // EdgeDecl
 		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
 		-1, // AttrItems
 		-1, // OptAttrSep
 		-1, // Attr
+		-1, // AttrKey
 		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
 	},
 	gotoRow{ // S29
 		-1, // S'
 		-1, // WholeDoc
 		-1, // TopLevelDeclList
 		-1, // OptSep
+		79, // NodeId
 		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
 		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
 		-1, // EdgeDecl
 		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
 		-1, // AttrItems
 		-1, // OptAttrSep
 		-1, // Attr
+		-1, // AttrKey
 		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
 	},
 	gotoRow{ // S30
 		-1, // S'
 		-1, // WholeDoc
 		-1, // TopLevelDeclList
 		-1, // OptSep
+		84, // NodeId
 		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
 		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
 		-1, // EdgeDecl
 		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
 		-1, // AttrItems
 		-1, // OptAttrSep
 		-1, // Attr
+		-1, // AttrKey
 		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
 	},
 	gotoRow{ // S31
 		-1, // S'
 		-1, // WholeDoc
 		-1, // TopLevelDeclList
 		-1, // OptSep
-		-1, // NodeDecl
+		89, // NodeId
+		90, // NodeDecl
+		91, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
 		-1, // EdgeRHS
-		-1, // EdgeDecl
+		14, // EdgeEnd
+		15, // EdgeRef
+		-1, // EdgeRefList
+		93, // EdgeDecl
 		-1, // TopLevelStmt
-		44, // AttrItems
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
 		-1, // OptAttrSep
-		24, // Attr
+		-1, // Attr
+		-1, // AttrKey
 		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
 	},
 	gotoRow{ // S32
 		-1, // S'
 		-1, // WholeDoc
 		-1, // TopLevelDeclList
 		-1, // OptSep
+		-1, // NodeId
 		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
 		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
 		-1, // EdgeDecl
 		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
 		-1, // AttrItems
 		-1, // OptAttrSep
 		-1, // Attr
+		-1, // AttrKey
 		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
 	},
 	gotoRow{ // S33
 		-1, // S'
 		-1, // WholeDoc
 		-1, // TopLevelDeclList
 		-1, // OptSep
+		-1, // NodeId
 		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		97, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
 		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
 		-1, // EdgeDecl
 		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
 		-1, // AttrItems
 		-1, // OptAttrSep
 		-1, // Attr
-		47, // AttrVal
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
 	},
 	gotoRow{ // S34
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		100, // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		-1,  // AttrItems
+		-1,  // OptAttrSep
+		-1,  // Attr
+		-1,  // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S35
 		-1, // S'
 		-1, // WholeDoc
 		-1, // TopLevelDeclList
 		-1, // OptSep
+		-1, // NodeId
 		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
 		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
 		-1, // EdgeDecl
 		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
 		-1, // AttrItems
 		-1, // OptAttrSep
 		-1, // Attr
+		-1, // AttrKey
 		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
 	},
-	gotoRow{ // S35
+	gotoRow{ // S36
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		101, // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		-1,  // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		-1,  // AttrItems
+		-1,  // OptAttrSep
+		-1,  // Attr
+		-1,  // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S37
 		-1, // S'
 		-1, // WholeDoc
 		-1, // TopLevelDeclList
 		-1, // OptSep
+		-1, // NodeId
 		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
 		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
 		-1, // EdgeDecl
 		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
 		-1, // AttrItems
 		-1, // OptAttrSep
 		-1, // Attr
+		-1, // AttrKey
 		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
 	},
-	gotoRow{ // S36
+	gotoRow{ // S38
 		-1, // S'
 		-1, // WholeDoc
 		-1, // TopLevelDeclList
 		-1, // OptSep
+		-1, // NodeId
 		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
 		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
 		-1, // EdgeDecl
 		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
 		-1, // AttrItems
 		-1, // OptAttrSep
 		-1, // Attr
+		-1, // AttrKey
 		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
 	},
-	gotoRow{ // S37
+	gotoRow{ // S39
 		-1, // S'
 		-1, // WholeDoc
 		-1, // TopLevelDeclList
 		-1, // OptSep
+		-1, // NodeId
 		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
 		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
 		-1, // EdgeDecl
 		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
 		-1, // AttrItems
 		-1, // OptAttrSep
 		-1, // Attr
+		-1, // AttrKey
 		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
 	},
-	gotoRow{ // S38
+	gotoRow{ // S40
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		110, // TypeList
+		112, // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		108, // AttrItems
+		-1,  // OptAttrSep
+		116, // Attr
+		117, // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S41
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		-1,  // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		120, // GroupBody
+		-1,  // AttrItems
+		-1,  // OptAttrSep
+		-1,  // Attr
+		-1,  // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S42
 		-1, // S'
 		-1, // WholeDoc
 		-1, // TopLevelDeclList
 		-1, // OptSep
+		-1, // NodeId
 		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
 		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
 		-1, // EdgeDecl
 		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
 		-1, // AttrItems
 		-1, // OptAttrSep
-		30, // Attr
+		-1, // Attr
+		-1, // AttrKey
 		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
 	},
-	gotoRow{ // S39
+	gotoRow{ // S43
 		-1, // S'
 		-1, // WholeDoc
 		-1, // TopLevelDeclList
 		-1, // OptSep
+		-1, // NodeId
 		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
 		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
 		-1, // EdgeDecl
 		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
 		-1, // AttrItems
 		-1, // OptAttrSep
 		-1, // Attr
+		-1, // AttrKey
 		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
 	},
-	gotoRow{ // S40
+	gotoRow{ // S44
 		-1, // S'
 		-1, // WholeDoc
 		-1, // TopLevelDeclList
 		-1, // OptSep
+		-1, // NodeId
 		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
 		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
 		-1, // EdgeDecl
 		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
 		-1, // AttrItems
 		-1, // OptAttrSep
 		-1, // Attr
+		-1, // AttrKey
 		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
 	},
-	gotoRow{ // S41
+	gotoRow{ // S45
 		-1, // S'
 		-1, // WholeDoc
 		-1, // TopLevelDeclList
 		-1, // OptSep
+		-1, // NodeId
 		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
 		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
 		-1, // EdgeDecl
 		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
 		-1, // AttrItems
-		52, // OptAttrSep
+		-1, // OptAttrSep
 		-1, // Attr
+		-1, // AttrKey
 		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
 	},
-	gotoRow{ // S42
+	gotoRow{ // S46
 		-1, // S'
 		-1, // WholeDoc
 		-1, // TopLevelDeclList
 		-1, // OptSep
+		-1, // NodeId
 		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
 		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
 		-1, // EdgeDecl
 		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
 		-1, // AttrItems
 		-1, // OptAttrSep
 		-1, // Attr
+		-1, // AttrKey
 		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
 	},
-	gotoRow{ // S43
+	gotoRow{ // S47
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		122, // NodeId
+		123, // NodeDecl
+		10,  // NodeRef
+		-1,  // TypeList
+		-1,  // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		124, // EdgeEnd
+		125, // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		-1,  // AttrItems
+		-1,  // OptAttrSep
+		-1,  // Attr
+		-1,  // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S48
 		-1, // S'
 		-1, // WholeDoc
 		-1, // TopLevelDeclList
 		-1, // OptSep
+		-1, // NodeId
 		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
 		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
 		-1, // EdgeDecl
 		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
 		-1, // AttrItems
 		-1, // OptAttrSep
 		-1, // Attr
+		-1, // AttrKey
 		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
 	},
-	gotoRow{ // S44
+	gotoRow{ // S49
 		-1, // S'
 		-1, // WholeDoc
 		-1, // TopLevelDeclList
 		-1, // OptSep
+		-1, // NodeId
 		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
 		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
 		-1, // EdgeDecl
 		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
 		-1, // AttrItems
 		-1, // OptAttrSep
-		36, // Attr
+		-1, // Attr
+		-1, // AttrKey
 		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
 	},
-	gotoRow{ // S45
+	gotoRow{ // S50
 		-1, // S'
 		-1, // WholeDoc
 		-1, // TopLevelDeclList
 		-1, // OptSep
+		-1, // NodeId
 		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
 		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
 		-1, // EdgeDecl
 		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
 		-1, // AttrItems
 		-1, // OptAttrSep
 		-1, // Attr
+		-1, // AttrKey
 		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
 	},
-	gotoRow{ // S46
+	gotoRow{ // S51
 		-1, // S'
 		-1, // WholeDoc
 		-1, // TopLevelDeclList
 		-1, // OptSep
+		-1, // NodeId
 		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
 		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
 		-1, // EdgeDecl
 		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
 		-1, // AttrItems
 		-1, // OptAttrSep
 		-1, // Attr
+		-1, // AttrKey
 		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
 	},
-	gotoRow{ // S47
+	gotoRow{ // S52
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		130, // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		133, // EdgeAttrClose
+		136, // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		128, // AttrItems
+		-1,  // OptAttrSep
+		139, // Attr
+		140, // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S53
 		-1, // S'
 		-1, // WholeDoc
 		-1, // TopLevelDeclList
 		-1, // OptSep
+		-1, // NodeId
 		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
 		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
 		-1, // EdgeDecl
 		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
 		-1, // AttrItems
-		56, // OptAttrSep
+		-1, // OptAttrSep
 		-1, // Attr
+		-1, // AttrKey
 		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
 	},
-	gotoRow{ // S48
+	gotoRow{ // S54
 		-1, // S'
 		-1, // WholeDoc
 		-1, // TopLevelDeclList
 		-1, // OptSep
+		-1, // NodeId
 		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
 		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
 		-1, // EdgeDecl
 		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
 		-1, // AttrItems
 		-1, // OptAttrSep
 		-1, // Attr
+		-1, // AttrKey
 		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
 	},
-	gotoRow{ // S49
+	gotoRow{ // S55
 		-1, // S'
 		-1, // WholeDoc
 		-1, // TopLevelDeclList
 		-1, // OptSep
+		-1, // NodeId
 		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
 		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
 		-1, // EdgeDecl
 		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
 		-1, // AttrItems
 		-1, // OptAttrSep
 		-1, // Attr
+		-1, // AttrKey
 		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
 	},
-	gotoRow{ // S50
+	gotoRow{ // S56
 		-1, // S'
 		-1, // WholeDoc
 		-1, // TopLevelDeclList
 		-1, // OptSep
+		-1, // NodeId
 		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
 		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
 		-1, // EdgeDecl
 		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
 		-1, // AttrItems
 		-1, // OptAttrSep
 		-1, // Attr
+		-1, // AttrKey
 		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
 	},
-	gotoRow{ // S51
+	gotoRow{ // S57
 		-1, // S'
 		-1, // WholeDoc
 		-1, // TopLevelDeclList
 		-1, // OptSep
+		-1, // NodeId
 		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
 		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
 		-1, // EdgeDecl
 		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
 		-1, // AttrItems
 		-1, // OptAttrSep
 		-1, // Attr
+		-1, // AttrKey
 		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
 	},
-	gotoRow{ // S52
+	gotoRow{ // S58
 		-1, // S'
 		-1, // WholeDoc
 		-1, // TopLevelDeclList
 		-1, // OptSep
+		-1, // NodeId
 		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
 		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
 		-1, // EdgeDecl
 		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
 		-1, // AttrItems
 		-1, // OptAttrSep
 		-1, // Attr
+		-1, // AttrKey
 		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
 	},
-	gotoRow{ // S53
+	gotoRow{ // S59
 		-1, // S'
 		-1, // WholeDoc
 		-1, // TopLevelDeclList
 		-1, // OptSep
+		-1, // NodeId
 		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
 		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
 		-1, // EdgeDecl
 		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
 		-1, // AttrItems
 		-1, // OptAttrSep
 		-1, // Attr
+		-1, // AttrKey
 		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
 	},
-	gotoRow{ // S54
+	gotoRow{ // S60
 		-1, // S'
 		-1, // WholeDoc
 		-1, // TopLevelDeclList
 		-1, // OptSep
+		-1, // NodeId
 		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
 		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
 		-1, // EdgeDecl
 		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
 		-1, // AttrItems
 		-1, // OptAttrSep
 		-1, // Attr
+		-1, // AttrKey
 		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
 	},
-	gotoRow{ // S55
+	gotoRow{ // S61
 		-1, // S'
 		-1, // WholeDoc
 		-1, // TopLevelDeclList
 		-1, // OptSep
+		-1, // NodeId
 		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
 		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
 		-1, // EdgeDecl
 		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
 		-1, // AttrItems
 		-1, // OptAttrSep
 		-1, // Attr
+		-1, // AttrKey
 		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
 	},
-	gotoRow{ // S56
+	gotoRow{ // S62
 		-1, // S'
 		-1, // WholeDoc
 		-1, // TopLevelDeclList
 		-1, // OptSep
+		-1, // NodeId
 		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
 		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
 		-1, // EdgeDecl
 		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
 		-1, // AttrItems
 		-1, // OptAttrSep
 		-1, // Attr
+		-1, // AttrKey
 		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
 	},
-	gotoRow{ // S57
+	gotoRow{ // S63
 		-1, // S'
 		-1, // WholeDoc
 		-1, // TopLevelDeclList
 		-1, // OptSep
+		-1, // NodeId
 		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
 		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
 		-1, // EdgeDecl
 		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
 		-1, // AttrItems
 		-1, // OptAttrSep
 		-1, // Attr
+		-1, // AttrKey
 		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
 	},
-	gotoRow{ // S58
+	gotoRow{ // S64
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S65
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		56,  // NodeId
+		61,  // NodeDecl
+		62,  // NodeRef
+		-1,  // TypeList
+		-1,  // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		145, // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		-1,  // AttrItems
+		-1,  // OptAttrSep
+		-1,  // Attr
+		-1,  // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S66
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S67
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S68
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S69
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S70
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S71
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S72
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S73
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S74
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S75
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S76
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S77
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S78
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S79
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S80
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S81
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S82
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S83
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S84
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		-1,  // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		152, // GroupBody
+		-1,  // AttrItems
+		-1,  // OptAttrSep
+		-1,  // Attr
+		-1,  // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S85
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S86
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S87
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S88
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S89
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S90
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S91
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S92
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S93
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		47, // EdgeArrow
+		52, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		67, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S94
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		158, // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		156, // AttrItems
+		-1,  // OptAttrSep
+		116, // Attr
+		117, // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S95
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S96
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S97
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S98
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S99
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S100
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S101
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S102
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S103
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S104
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S105
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S106
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S107
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S108
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		158, // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		-1,  // AttrItems
+		-1,  // OptAttrSep
+		165, // Attr
+		117, // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S109
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S110
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		166, // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		-1,  // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		-1,  // AttrItems
+		-1,  // OptAttrSep
+		-1,  // Attr
+		-1,  // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S111
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S112
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S113
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S114
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S115
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		172, // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		-1,  // AttrItems
+		-1,  // OptAttrSep
+		-1,  // Attr
+		175, // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S116
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S117
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S118
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		181, // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		178, // AttrItems
+		-1,  // OptAttrSep
+		116, // Attr
+		117, // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S119
+		-1,  // S'
+		-1,  // WholeDoc
+		184, // TopLevelDeclList
+		-1,  // OptSep
+		186, // NodeId
+		191, // NodeDecl
+		192, // NodeRef
+		-1,  // TypeList
+		-1,  // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		196, // EdgeEnd
+		15,  // EdgeRef
+		-1,  // EdgeRefList
+		198, // EdgeDecl
+		185, // TopLevelStmt
+		207, // LetDecl
+		205, // TemplateDecl
+		-1,  // ParamList
+		206, // TemplateUseDecl
+		204, // NamespaceDecl
+		203, // DeleteDecl
+		202, // GraphAttrsDecl
+		201, // DefaultsDecl
+		200, // IncludeDecl
+		199, // GroupDecl
+		-1,  // GroupBody
+		-1,  // AttrItems
+		-1,  // OptAttrSep
+		-1,  // Attr
+		-1,  // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S120
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S121
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S122
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S123
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S124
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S125
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S126
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		56,  // NodeId
+		61,  // NodeDecl
+		62,  // NodeRef
+		-1,  // TypeList
+		-1,  // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		64,  // EdgeRef
+		217, // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		-1,  // AttrItems
+		-1,  // OptAttrSep
+		-1,  // Attr
+		-1,  // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S127
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S128
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		158, // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		218, // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		-1,  // AttrItems
+		-1,  // OptAttrSep
+		219, // Attr
+		140, // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S129
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S130
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S131
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S132
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S133
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		122, // NodeId
+		123, // NodeDecl
+		10,  // NodeRef
+		-1,  // TypeList
+		-1,  // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		221, // EdgeEnd
+		125, // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		-1,  // AttrItems
+		-1,  // OptAttrSep
+		-1,  // Attr
+		-1,  // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S134
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S135
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S136
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		222, // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		-1,  // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		-1,  // AttrItems
+		-1,  // OptAttrSep
+		-1,  // Attr
+		-1,  // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S137
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S138
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		227, // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		-1,  // AttrItems
+		-1,  // OptAttrSep
+		-1,  // Attr
+		230, // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S139
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S140
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S141
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		232, // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		-1,  // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		-1,  // AttrItems
+		-1,  // OptAttrSep
+		-1,  // Attr
+		-1,  // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S142
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S143
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		239, // TypeList
+		112, // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		237, // AttrItems
+		-1,  // OptAttrSep
+		116, // Attr
+		117, // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S144
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		56,  // NodeId
+		61,  // NodeDecl
+		62,  // NodeRef
+		-1,  // TypeList
+		-1,  // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		240, // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		-1,  // AttrItems
+		-1,  // OptAttrSep
+		-1,  // Attr
+		-1,  // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S145
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S146
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S147
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		244, // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		-1,  // AttrItems
+		-1,  // OptAttrSep
+		-1,  // Attr
+		-1,  // AttrKey
+		-1,  // AttrVal
+		247, // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S148
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		-1,  // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		253, // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		-1,  // AttrItems
+		-1,  // OptAttrSep
+		-1,  // Attr
+		-1,  // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S149
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S150
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		158, // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		255, // AttrItems
+		-1,  // OptAttrSep
+		258, // Attr
+		259, // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S151
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S152
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S153
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S154
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		262, // TypeList
+		112, // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		260, // AttrItems
+		-1,  // OptAttrSep
+		116, // Attr
+		117, // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S155
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S156
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		158, // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		-1,  // AttrItems
+		-1,  // OptAttrSep
+		165, // Attr
+		117, // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S157
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S158
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S159
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S160
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S161
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		158, // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		264, // AttrItems
+		-1,  // OptAttrSep
+		116, // Attr
+		117, // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S162
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		158, // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		265, // AttrItems
+		-1,  // OptAttrSep
+		116, // Attr
+		117, // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S163
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S164
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S165
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S166
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		158, // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		266, // AttrItems
+		-1,  // OptAttrSep
+		116, // Attr
+		117, // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S167
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S168
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		270, // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		-1,  // AttrItems
+		-1,  // OptAttrSep
+		-1,  // Attr
+		-1,  // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S169
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S170
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S171
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S172
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S173
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S174
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S175
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		-1,  // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		-1,  // AttrItems
+		274, // OptAttrSep
+		-1,  // Attr
+		-1,  // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S176
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		277, // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		-1,  // AttrItems
+		-1,  // OptAttrSep
+		-1,  // Attr
+		-1,  // AttrKey
+		279, // AttrVal
+		278, // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S177
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S178
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		158, // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		-1,  // AttrItems
+		-1,  // OptAttrSep
+		165, // Attr
+		117, // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S179
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		-1,  // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		284, // GroupBody
+		-1,  // AttrItems
+		-1,  // OptAttrSep
+		-1,  // Attr
+		-1,  // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S180
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S181
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		285, // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		-1,  // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		-1,  // AttrItems
+		-1,  // OptAttrSep
+		-1,  // Attr
+		-1,  // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S182
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S183
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S184
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		186, // NodeId
+		191, // NodeDecl
+		192, // NodeRef
+		-1,  // TypeList
+		-1,  // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		196, // EdgeEnd
+		15,  // EdgeRef
+		-1,  // EdgeRefList
+		198, // EdgeDecl
+		286, // TopLevelStmt
+		207, // LetDecl
+		205, // TemplateDecl
+		-1,  // ParamList
+		206, // TemplateUseDecl
+		204, // NamespaceDecl
+		203, // DeleteDecl
+		202, // GraphAttrsDecl
+		201, // DefaultsDecl
+		200, // IncludeDecl
+		199, // GroupDecl
+		-1,  // GroupBody
+		-1,  // AttrItems
+		-1,  // OptAttrSep
+		-1,  // Attr
+		-1,  // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S185
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S186
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S187
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S188
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S189
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S190
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S191
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		289, // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		-1,  // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		-1,  // AttrItems
+		-1,  // OptAttrSep
+		-1,  // Attr
+		-1,  // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S192
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S193
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S194
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		292, // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		-1,  // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		-1,  // AttrItems
+		-1,  // OptAttrSep
+		-1,  // Attr
+		-1,  // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S195
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S196
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		-1,  // Word
+		294, // EdgeArrow
+		295, // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		296, // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		-1,  // AttrItems
+		-1,  // OptAttrSep
+		-1,  // Attr
+		-1,  // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S197
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S198
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		297, // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		-1,  // Word
+		294, // EdgeArrow
+		295, // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		298, // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		-1,  // AttrItems
+		-1,  // OptAttrSep
+		-1,  // Attr
+		-1,  // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S199
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		299, // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		-1,  // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		-1,  // AttrItems
+		-1,  // OptAttrSep
+		-1,  // Attr
+		-1,  // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S200
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		300, // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		-1,  // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		-1,  // AttrItems
+		-1,  // OptAttrSep
+		-1,  // Attr
+		-1,  // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S201
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		301, // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		-1,  // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		-1,  // AttrItems
+		-1,  // OptAttrSep
+		-1,  // Attr
+		-1,  // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S202
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		302, // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		-1,  // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		-1,  // AttrItems
+		-1,  // OptAttrSep
+		-1,  // Attr
+		-1,  // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S203
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		303, // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		-1,  // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		-1,  // AttrItems
+		-1,  // OptAttrSep
+		-1,  // Attr
+		-1,  // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S204
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		304, // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		-1,  // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		-1,  // AttrItems
+		-1,  // OptAttrSep
+		-1,  // Attr
+		-1,  // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S205
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		305, // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		-1,  // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		-1,  // AttrItems
+		-1,  // OptAttrSep
+		-1,  // Attr
+		-1,  // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S206
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		306, // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		-1,  // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		-1,  // AttrItems
+		-1,  // OptAttrSep
+		-1,  // Attr
+		-1,  // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S207
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		307, // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		-1,  // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		-1,  // AttrItems
+		-1,  // OptAttrSep
+		-1,  // Attr
+		-1,  // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S208
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S209
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S210
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		310, // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		-1,  // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		-1,  // AttrItems
+		-1,  // OptAttrSep
+		-1,  // Attr
+		-1,  // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S211
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		312, // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		-1,  // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		-1,  // AttrItems
+		-1,  // OptAttrSep
+		-1,  // Attr
+		-1,  // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S212
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		313, // NodeId
+		90,  // NodeDecl
+		91,  // NodeRef
+		-1,  // TypeList
+		-1,  // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		196, // EdgeEnd
+		15,  // EdgeRef
+		-1,  // EdgeRefList
+		314, // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		-1,  // AttrItems
+		-1,  // OptAttrSep
+		-1,  // Attr
+		-1,  // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S213
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S214
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		316, // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		-1,  // AttrItems
+		-1,  // OptAttrSep
+		-1,  // Attr
+		-1,  // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S215
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		317, // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		-1,  // AttrItems
+		-1,  // OptAttrSep
+		-1,  // Attr
+		-1,  // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S216
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		318, // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		-1,  // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		-1,  // AttrItems
+		-1,  // OptAttrSep
+		-1,  // Attr
+		-1,  // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S217
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		56,  // NodeId
+		61,  // NodeDecl
+		62,  // NodeRef
+		-1,  // TypeList
+		-1,  // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		145, // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		-1,  // AttrItems
+		-1,  // OptAttrSep
+		-1,  // Attr
+		-1,  // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S218
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		122, // NodeId
+		123, // NodeDecl
+		10,  // NodeRef
+		-1,  // TypeList
+		-1,  // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		324, // EdgeEnd
+		125, // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		-1,  // AttrItems
+		-1,  // OptAttrSep
+		-1,  // Attr
+		-1,  // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S219
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S220
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S221
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S222
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		158, // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		326, // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		325, // AttrItems
+		-1,  // OptAttrSep
+		139, // Attr
+		140, // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S223
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S224
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S225
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S226
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S227
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S228
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S229
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S230
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		-1,  // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		-1,  // AttrItems
+		328, // OptAttrSep
+		-1,  // Attr
+		-1,  // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S231
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		331, // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		-1,  // AttrItems
+		-1,  // OptAttrSep
+		-1,  // Attr
+		-1,  // AttrKey
+		333, // AttrVal
+		332, // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S232
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S233
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S234
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S235
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S236
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S237
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		158, // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		-1,  // AttrItems
+		-1,  // OptAttrSep
+		165, // Attr
+		117, // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S238
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S239
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		339, // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		-1,  // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		-1,  // AttrItems
+		-1,  // OptAttrSep
+		-1,  // Attr
+		-1,  // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S240
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S241
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S242
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S243
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S244
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S245
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S246
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S247
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S248
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S249
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S250
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S251
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S252
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		-1,  // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		340, // GroupBody
+		-1,  // AttrItems
+		-1,  // OptAttrSep
+		-1,  // Attr
+		-1,  // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S253
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S254
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S255
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		158, // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		-1,  // AttrItems
+		-1,  // OptAttrSep
+		345, // Attr
+		259, // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S256
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S257
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		349, // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		-1,  // AttrItems
+		-1,  // OptAttrSep
+		-1,  // Attr
+		352, // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S258
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S259
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S260
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		158, // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		-1,  // AttrItems
+		-1,  // OptAttrSep
+		165, // Attr
+		117, // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S261
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S262
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		355, // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		-1,  // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		-1,  // AttrItems
+		-1,  // OptAttrSep
+		-1,  // Attr
+		-1,  // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S263
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S264
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		158, // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		-1,  // AttrItems
+		-1,  // OptAttrSep
+		165, // Attr
+		117, // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S265
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		158, // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		-1,  // AttrItems
+		-1,  // OptAttrSep
+		165, // Attr
+		117, // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S266
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		158, // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		-1,  // AttrItems
+		-1,  // OptAttrSep
+		165, // Attr
+		117, // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S267
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S268
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S269
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S270
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S271
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S272
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S273
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S274
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S275
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S276
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		363, // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		-1,  // AttrItems
+		-1,  // OptAttrSep
+		-1,  // Attr
+		-1,  // AttrKey
+		-1,  // AttrVal
+		366, // ScalarVal
+		367, // ListItems
+	},
+	gotoRow{ // S277
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S278
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S279
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		-1,  // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		-1,  // AttrItems
+		371, // OptAttrSep
+		-1,  // Attr
+		-1,  // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S280
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S281
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S282
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S283
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		-1,  // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		372, // GroupBody
+		-1,  // AttrItems
+		-1,  // OptAttrSep
+		-1,  // Attr
+		-1,  // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S284
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S285
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		158, // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		373, // AttrItems
+		-1,  // OptAttrSep
+		116, // Attr
+		117, // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S286
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S287
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S288
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S289
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S290
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S291
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		377, // TypeList
+		112, // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		375, // AttrItems
+		-1,  // OptAttrSep
+		116, // Attr
+		117, // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S292
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		-1,  // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		380, // GroupBody
+		-1,  // AttrItems
+		-1,  // OptAttrSep
+		-1,  // Attr
+		-1,  // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S293
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S294
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		381, // NodeId
+		382, // NodeDecl
+		192, // NodeRef
+		-1,  // TypeList
+		-1,  // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		383, // EdgeEnd
+		384, // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		-1,  // AttrItems
+		-1,  // OptAttrSep
+		-1,  // Attr
+		-1,  // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S295
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		130, // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		387, // EdgeAttrClose
+		388, // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		386, // AttrItems
+		-1,  // OptAttrSep
+		139, // Attr
+		140, // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S296
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S297
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S298
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S299
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S300
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S301
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S302
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S303
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S304
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S305
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S306
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S307
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S308
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S309
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S310
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S311
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S312
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		-1,  // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		393, // GroupBody
+		-1,  // AttrItems
+		-1,  // OptAttrSep
+		-1,  // Attr
+		-1,  // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S313
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S314
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		-1,  // Word
+		294, // EdgeArrow
+		295, // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		298, // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		-1,  // AttrItems
+		-1,  // OptAttrSep
+		-1,  // Attr
+		-1,  // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S315
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		158, // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		394, // AttrItems
+		-1,  // OptAttrSep
+		116, // Attr
+		117, // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S316
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S317
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S318
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S319
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S320
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S321
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S322
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S323
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S324
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S325
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		158, // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		398, // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		-1,  // AttrItems
+		-1,  // OptAttrSep
+		219, // Attr
+		140, // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S326
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		122, // NodeId
+		123, // NodeDecl
+		10,  // NodeRef
+		-1,  // TypeList
+		-1,  // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		399, // EdgeEnd
+		125, // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		-1,  // AttrItems
+		-1,  // OptAttrSep
+		-1,  // Attr
+		-1,  // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S327
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S328
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S329
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S330
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		363, // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		-1,  // AttrItems
+		-1,  // OptAttrSep
+		-1,  // Attr
+		-1,  // AttrKey
+		-1,  // AttrVal
+		366, // ScalarVal
+		401, // ListItems
+	},
+	gotoRow{ // S331
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S332
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S333
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		-1,  // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		-1,  // AttrItems
+		402, // OptAttrSep
+		-1,  // Attr
+		-1,  // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S334
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S335
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S336
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S337
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S338
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S339
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		158, // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		403, // AttrItems
+		-1,  // OptAttrSep
+		116, // Attr
+		117, // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S340
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S341
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S342
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		-1,  // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		406, // GroupBody
+		-1,  // AttrItems
+		-1,  // OptAttrSep
+		-1,  // Attr
+		-1,  // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S343
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		158, // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		407, // AttrItems
+		-1,  // OptAttrSep
+		258, // Attr
+		259, // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S344
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S345
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S346
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S347
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S348
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S349
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S350
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S351
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S352
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		-1,  // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		-1,  // AttrItems
+		410, // OptAttrSep
+		-1,  // Attr
+		-1,  // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S353
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		413, // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		-1,  // AttrItems
+		-1,  // OptAttrSep
+		-1,  // Attr
+		-1,  // AttrKey
+		415, // AttrVal
+		414, // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S354
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S355
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		158, // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		419, // AttrItems
+		-1,  // OptAttrSep
+		116, // Attr
+		117, // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S356
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S357
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S358
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S359
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S360
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S361
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S362
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S363
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S364
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S365
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S366
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		-1,  // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		-1,  // AttrItems
+		422, // OptAttrSep
+		-1,  // Attr
+		-1,  // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S367
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		363, // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		-1,  // AttrItems
+		-1,  // OptAttrSep
+		-1,  // Attr
+		-1,  // AttrKey
+		-1,  // AttrVal
+		424, // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S368
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S369
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S370
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S371
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S372
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S373
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		158, // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		-1,  // AttrItems
+		-1,  // OptAttrSep
+		165, // Attr
+		117, // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S374
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		-1,  // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		426, // GroupBody
+		-1,  // AttrItems
+		-1,  // OptAttrSep
+		-1,  // Attr
+		-1,  // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S375
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		158, // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		-1,  // AttrItems
+		-1,  // OptAttrSep
+		165, // Attr
+		117, // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S376
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S377
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		428, // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		-1,  // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		-1,  // AttrItems
+		-1,  // OptAttrSep
+		-1,  // Attr
+		-1,  // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S378
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		431, // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		429, // AttrItems
+		-1,  // OptAttrSep
+		116, // Attr
+		117, // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S379
+		-1,  // S'
+		-1,  // WholeDoc
+		432, // TopLevelDeclList
+		-1,  // OptSep
+		186, // NodeId
+		191, // NodeDecl
+		192, // NodeRef
+		-1,  // TypeList
+		-1,  // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		196, // EdgeEnd
+		15,  // EdgeRef
+		-1,  // EdgeRefList
+		198, // EdgeDecl
+		185, // TopLevelStmt
+		207, // LetDecl
+		205, // TemplateDecl
+		-1,  // ParamList
+		206, // TemplateUseDecl
+		204, // NamespaceDecl
+		203, // DeleteDecl
+		202, // GraphAttrsDecl
+		201, // DefaultsDecl
+		200, // IncludeDecl
+		199, // GroupDecl
+		-1,  // GroupBody
+		-1,  // AttrItems
+		-1,  // OptAttrSep
+		-1,  // Attr
+		-1,  // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S380
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S381
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S382
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S383
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S384
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S385
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		56,  // NodeId
+		61,  // NodeDecl
+		62,  // NodeRef
+		-1,  // TypeList
+		-1,  // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		64,  // EdgeRef
+		435, // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		-1,  // AttrItems
+		-1,  // OptAttrSep
+		-1,  // Attr
+		-1,  // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S386
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		158, // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		436, // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		-1,  // AttrItems
+		-1,  // OptAttrSep
+		219, // Attr
+		140, // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S387
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		381, // NodeId
+		382, // NodeDecl
+		192, // NodeRef
+		-1,  // TypeList
+		-1,  // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		437, // EdgeEnd
+		384, // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		-1,  // AttrItems
+		-1,  // OptAttrSep
+		-1,  // Attr
+		-1,  // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S388
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		438, // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		-1,  // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		-1,  // AttrItems
+		-1,  // OptAttrSep
+		-1,  // Attr
+		-1,  // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S389
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		442, // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		-1,  // AttrItems
+		-1,  // OptAttrSep
+		-1,  // Attr
+		-1,  // AttrKey
+		-1,  // AttrVal
+		445, // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S390
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		-1,  // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		450, // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		-1,  // AttrItems
+		-1,  // OptAttrSep
+		-1,  // Attr
+		-1,  // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S391
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S392
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		158, // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		452, // AttrItems
+		-1,  // OptAttrSep
+		258, // Attr
+		259, // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S393
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S394
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		158, // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		-1,  // AttrItems
+		-1,  // OptAttrSep
+		165, // Attr
+		117, // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S395
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		158, // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		455, // AttrItems
+		-1,  // OptAttrSep
+		116, // Attr
+		117, // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S396
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		158, // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		456, // AttrItems
+		-1,  // OptAttrSep
+		116, // Attr
+		117, // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S397
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S398
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		122, // NodeId
+		123, // NodeDecl
+		10,  // NodeRef
+		-1,  // TypeList
+		-1,  // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		457, // EdgeEnd
+		125, // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		-1,  // AttrItems
+		-1,  // OptAttrSep
+		-1,  // Attr
+		-1,  // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S399
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S400
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S401
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		363, // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		-1,  // AttrItems
+		-1,  // OptAttrSep
+		-1,  // Attr
+		-1,  // AttrKey
+		-1,  // AttrVal
+		424, // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S402
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S403
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		158, // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		-1,  // AttrItems
+		-1,  // OptAttrSep
+		165, // Attr
+		117, // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S404
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S405
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S406
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S407
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		158, // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		-1,  // AttrItems
+		-1,  // OptAttrSep
+		345, // Attr
+		259, // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S408
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S409
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S410
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S411
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S412
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		363, // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		-1,  // AttrItems
+		-1,  // OptAttrSep
+		-1,  // Attr
+		-1,  // AttrKey
+		-1,  // AttrVal
+		366, // ScalarVal
+		462, // ListItems
+	},
+	gotoRow{ // S413
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S414
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S415
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		-1,  // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		-1,  // AttrItems
+		463, // OptAttrSep
+		-1,  // Attr
+		-1,  // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S416
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S417
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S418
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S419
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		158, // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		-1,  // AttrItems
+		-1,  // OptAttrSep
+		165, // Attr
+		117, // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S420
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S421
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S422
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S423
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S424
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		-1,  // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		-1,  // AttrItems
+		465, // OptAttrSep
+		-1,  // Attr
+		-1,  // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S425
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		-1,  // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		466, // GroupBody
+		-1,  // AttrItems
+		-1,  // OptAttrSep
+		-1,  // Attr
+		-1,  // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S426
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S427
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S428
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		158, // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		467, // AttrItems
+		-1,  // OptAttrSep
+		116, // Attr
+		117, // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S429
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		158, // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		-1,  // AttrItems
+		-1,  // OptAttrSep
+		165, // Attr
+		117, // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S430
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		-1,  // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		470, // GroupBody
+		-1,  // AttrItems
+		-1,  // OptAttrSep
+		-1,  // Attr
+		-1,  // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S431
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		471, // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		-1,  // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		-1,  // AttrItems
+		-1,  // OptAttrSep
+		-1,  // Attr
+		-1,  // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S432
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		186, // NodeId
+		191, // NodeDecl
+		192, // NodeRef
+		-1,  // TypeList
+		-1,  // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		196, // EdgeEnd
+		15,  // EdgeRef
+		-1,  // EdgeRefList
+		198, // EdgeDecl
+		286, // TopLevelStmt
+		207, // LetDecl
+		205, // TemplateDecl
+		-1,  // ParamList
+		206, // TemplateUseDecl
+		204, // NamespaceDecl
+		203, // DeleteDecl
+		202, // GraphAttrsDecl
+		201, // DefaultsDecl
+		200, // IncludeDecl
+		199, // GroupDecl
+		-1,  // GroupBody
+		-1,  // AttrItems
+		-1,  // OptAttrSep
+		-1,  // Attr
+		-1,  // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S433
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S434
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		473, // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		-1,  // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		-1,  // AttrItems
+		-1,  // OptAttrSep
+		-1,  // Attr
+		-1,  // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S435
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		56,  // NodeId
+		61,  // NodeDecl
+		62,  // NodeRef
+		-1,  // TypeList
+		-1,  // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		145, // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		-1,  // AttrItems
+		-1,  // OptAttrSep
+		-1,  // Attr
+		-1,  // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S436
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		381, // NodeId
+		382, // NodeDecl
+		192, // NodeRef
+		-1,  // TypeList
+		-1,  // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		479, // EdgeEnd
+		384, // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		-1,  // AttrItems
+		-1,  // OptAttrSep
+		-1,  // Attr
+		-1,  // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S437
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S438
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		158, // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		481, // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		480, // AttrItems
+		-1,  // OptAttrSep
+		139, // Attr
+		140, // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S439
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S440
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S441
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S442
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S443
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S444
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S445
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S446
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S447
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S448
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S449
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		-1,  // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		482, // GroupBody
+		-1,  // AttrItems
+		-1,  // OptAttrSep
+		-1,  // Attr
+		-1,  // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S450
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S451
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S452
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		158, // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		-1,  // AttrItems
+		-1,  // OptAttrSep
+		345, // Attr
+		259, // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S453
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S454
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S455
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		158, // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		-1,  // AttrItems
+		-1,  // OptAttrSep
+		165, // Attr
+		117, // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S456
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		158, // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		-1,  // AttrItems
+		-1,  // OptAttrSep
+		165, // Attr
+		117, // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S457
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S458
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S459
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S460
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S461
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S462
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		363, // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		-1,  // AttrItems
+		-1,  // OptAttrSep
+		-1,  // Attr
+		-1,  // AttrKey
+		-1,  // AttrVal
+		424, // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S463
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S464
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S465
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S466
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S467
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		158, // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		-1,  // AttrItems
+		-1,  // OptAttrSep
+		165, // Attr
+		117, // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S468
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S469
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		-1,  // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		490, // GroupBody
+		-1,  // AttrItems
+		-1,  // OptAttrSep
+		-1,  // Attr
+		-1,  // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S470
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S471
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		158, // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		491, // AttrItems
+		-1,  // OptAttrSep
+		116, // Attr
+		117, // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S472
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S473
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S474
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S475
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S476
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S477
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S478
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S479
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S480
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		158, // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		494, // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		-1,  // AttrItems
+		-1,  // OptAttrSep
+		219, // Attr
+		140, // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S481
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		381, // NodeId
+		382, // NodeDecl
+		192, // NodeRef
+		-1,  // TypeList
+		-1,  // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		495, // EdgeEnd
+		384, // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		-1,  // AttrItems
+		-1,  // OptAttrSep
+		-1,  // Attr
+		-1,  // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S482
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S483
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		-1,  // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		496, // GroupBody
+		-1,  // AttrItems
+		-1,  // OptAttrSep
+		-1,  // Attr
+		-1,  // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S484
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		158, // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		497, // AttrItems
+		-1,  // OptAttrSep
+		258, // Attr
+		259, // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S485
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S486
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S487
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S488
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S489
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S490
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S491
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		158, // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		-1,  // AttrItems
+		-1,  // OptAttrSep
+		165, // Attr
+		117, // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S492
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		-1,  // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		500, // GroupBody
+		-1,  // AttrItems
+		-1,  // OptAttrSep
+		-1,  // Attr
+		-1,  // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S493
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S494
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		381, // NodeId
+		382, // NodeDecl
+		192, // NodeRef
+		-1,  // TypeList
+		-1,  // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		501, // EdgeEnd
+		384, // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		-1,  // AttrItems
+		-1,  // OptAttrSep
+		-1,  // Attr
+		-1,  // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S495
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S496
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S497
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		158, // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		-1,  // GroupBody
+		-1,  // AttrItems
+		-1,  // OptAttrSep
+		345, // Attr
+		259, // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S498
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S499
+		-1,  // S'
+		-1,  // WholeDoc
+		-1,  // TopLevelDeclList
+		-1,  // OptSep
+		-1,  // NodeId
+		-1,  // NodeDecl
+		-1,  // NodeRef
+		-1,  // TypeList
+		-1,  // Word
+		-1,  // EdgeArrow
+		-1,  // EdgeAttrOpen
+		-1,  // EdgeAttrClose
+		-1,  // EdgeType
+		-1,  // EdgeRHS
+		-1,  // EdgeEnd
+		-1,  // EdgeRef
+		-1,  // EdgeRefList
+		-1,  // EdgeDecl
+		-1,  // TopLevelStmt
+		-1,  // LetDecl
+		-1,  // TemplateDecl
+		-1,  // ParamList
+		-1,  // TemplateUseDecl
+		-1,  // NamespaceDecl
+		-1,  // DeleteDecl
+		-1,  // GraphAttrsDecl
+		-1,  // DefaultsDecl
+		-1,  // IncludeDecl
+		-1,  // GroupDecl
+		503, // GroupBody
+		-1,  // AttrItems
+		-1,  // OptAttrSep
+		-1,  // Attr
+		-1,  // AttrKey
+		-1,  // AttrVal
+		-1,  // ScalarVal
+		-1,  // ListItems
+	},
+	gotoRow{ // S500
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S501
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S502
+		-1, // S'
+		-1, // WholeDoc
+		-1, // TopLevelDeclList
+		-1, // OptSep
+		-1, // NodeId
+		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
+		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
+		-1, // EdgeDecl
+		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
+		-1, // AttrItems
+		-1, // OptAttrSep
+		-1, // Attr
+		-1, // AttrKey
+		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
+	},
+	gotoRow{ // S503
 		-1, // S'
 		-1, // WholeDoc
 		-1, // TopLevelDeclList
 		-1, // OptSep
+		-1, // NodeId
 		-1, // NodeDecl
+		-1, // NodeRef
+		-1, // TypeList
+		-1, // Word
+		-1, // EdgeArrow
+		-1, // EdgeAttrOpen
+		-1, // EdgeAttrClose
+		-1, // EdgeType
 		-1, // EdgeRHS
+		-1, // EdgeEnd
+		-1, // EdgeRef
+		-1, // EdgeRefList
 		-1, // EdgeDecl
 		-1, // TopLevelStmt
+		-1, // LetDecl
+		-1, // TemplateDecl
+		-1, // ParamList
+		-1, // TemplateUseDecl
+		-1, // NamespaceDecl
+		-1, // DeleteDecl
+		-1, // GraphAttrsDecl
+		-1, // DefaultsDecl
+		-1, // IncludeDecl
+		-1, // GroupDecl
+		-1, // GroupBody
 		-1, // AttrItems
 		-1, // OptAttrSep
 		-1, // Attr
+		-1, // AttrKey
 		-1, // AttrVal
+		-1, // ScalarVal
+		-1, // ListItems
 	},
 }
@@ -11,9 +11,9 @@ import (
 )
 
 const (
-	numProductions = 29
-	numStates      = 59
-	numSymbols     = 26
+	numProductions = 101
+	numStates      = 504
+	numSymbols     = 77
 )
 
 // Stack
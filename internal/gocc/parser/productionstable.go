@@ -90,110 +90,410 @@ var productionsTable = ProdTab{
 		},
 	},
 	ProdTabEntry{
-		String: `NodeDecl : id "[" AttrItems "]"	<< ast.NewNode(X[0], "", X[2]) >>`,
-		Id:         "NodeDecl",
+		String: `NodeId : id	<<  >>`,
+		Id:         "NodeId",
 		NTType:     4,
 		Index:      7,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `NodeId : dotted_id	<< ast.NewDottedId(X[0]) >>`,
+		Id:         "NodeId",
+		NTType:     4,
+		Index:      8,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewDottedId(X[0])
+		},
+	},
+	ProdTabEntry{
+		String: `NodeId : quoted_string	<< ast.UnquoteId(X[0]) >>`,
+		Id:         "NodeId",
+		NTType:     4,
+		Index:      9,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.UnquoteId(X[0])
+		},
+	},
+	ProdTabEntry{
+		String: `NodeId : ns_prefix quoted_string	<< ast.NewNamespacedId(X[0], X[1]) >>`,
+		Id:         "NodeId",
+		NTType:     4,
+		Index:      10,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewNamespacedId(X[0], X[1])
+		},
+	},
+	ProdTabEntry{
+		String: `NodeDecl : NodeRef "[" AttrItems "]"	<< ast.NewNode(X[0], "", X[2]) >>`,
+		Id:         "NodeDecl",
+		NTType:     5,
+		Index:      11,
 		NumSymbols: 4,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return ast.NewNode(X[0], "", X[2])
 		},
 	},
 	ProdTabEntry{
-		String: `NodeDecl : id "[" id OptSep AttrItems "]"	<< ast.NewNode(X[0], X[2], X[4]) >>`,
+		String: `NodeDecl : NodeRef "[" TypeList OptSep AttrItems "]"	<< ast.NewNode(X[0], X[2], X[4]) >>`,
 		Id:         "NodeDecl",
-		NTType:     4,
-		Index:      8,
+		NTType:     5,
+		Index:      12,
 		NumSymbols: 6,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return ast.NewNode(X[0], X[2], X[4])
 		},
 	},
 	ProdTabEntry{
-		String: `NodeDecl : id "[" id OptSep "]"	<< ast.NewNode(X[0], X[2], nil) >>`,
+		String: `NodeDecl : NodeRef "[" TypeList OptSep "]"	<< ast.NewNode(X[0], X[2], nil) >>`,
 		Id:         "NodeDecl",
-		NTType:     4,
-		Index:      9,
+		NTType:     5,
+		Index:      13,
 		NumSymbols: 5,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return ast.NewNode(X[0], X[2], nil)
 		},
 	},
 	ProdTabEntry{
-		String: `NodeDecl : id "[" "]"	<< ast.NewNode(X[0], "", nil) >>`,
+		String: `NodeDecl : NodeRef "[" "]"	<< ast.NewNode(X[0], "", nil) >>`,
 		Id:         "NodeDecl",
-		NTType:     4,
-		Index:      10,
+		NTType:     5,
+		Index:      14,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return ast.NewNode(X[0], "", nil)
 		},
 	},
 	ProdTabEntry{
-		String: `NodeDecl : id	<< ast.NewNode(X[0], "", nil) >>`,
+		String: `NodeDecl : NodeRef	<< ast.NewNode(X[0], "", nil) >>`,
 		Id:         "NodeDecl",
-		NTType:     4,
-		Index:      11,
+		NTType:     5,
+		Index:      15,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return ast.NewNode(X[0], "", nil)
 		},
 	},
 	ProdTabEntry{
-		String: `EdgeRHS : edgearrow id	<< ast.NewEdgeStep(X[0], X[1], "", nil) >>`,
+		String: `NodeRef : NodeId	<<  >>`,
+		Id:         "NodeRef",
+		NTType:     6,
+		Index:      16,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `NodeRef : "_"	<< ast.NewAnonId(X[0]) >>`,
+		Id:         "NodeRef",
+		NTType:     6,
+		Index:      17,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewAnonId(X[0])
+		},
+	},
+	ProdTabEntry{
+		String: `TypeList : Word	<< ast.NewIdList(X[0]) >>`,
+		Id:         "TypeList",
+		NTType:     7,
+		Index:      18,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewIdList(X[0])
+		},
+	},
+	ProdTabEntry{
+		String: `TypeList : TypeList "," Word	<< ast.AppendIdList(X[0], X[2]) >>`,
+		Id:         "TypeList",
+		NTType:     7,
+		Index:      19,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.AppendIdList(X[0], X[2])
+		},
+	},
+	ProdTabEntry{
+		String: `Word : id	<<  >>`,
+		Id:         "Word",
+		NTType:     8,
+		Index:      20,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `Word : "subgraph"	<<  >>`,
+		Id:         "Word",
+		NTType:     8,
+		Index:      21,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `Word : "include"	<<  >>`,
+		Id:         "Word",
+		NTType:     8,
+		Index:      22,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `Word : "_"	<<  >>`,
+		Id:         "Word",
+		NTType:     8,
+		Index:      23,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `EdgeArrow : edgearrow	<<  >>`,
+		Id:         "EdgeArrow",
+		NTType:     9,
+		Index:      24,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `EdgeArrow : edgeline	<<  >>`,
+		Id:         "EdgeArrow",
+		NTType:     9,
+		Index:      25,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `EdgeArrow : edgebiarrow	<<  >>`,
+		Id:         "EdgeArrow",
+		NTType:     9,
+		Index:      26,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `EdgeArrow : edgebackarrow	<<  >>`,
+		Id:         "EdgeArrow",
+		NTType:     9,
+		Index:      27,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `EdgeAttrOpen : edge_attr_open	<<  >>`,
+		Id:         "EdgeAttrOpen",
+		NTType:     10,
+		Index:      28,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `EdgeAttrOpen : edge_attr_open_head	<<  >>`,
+		Id:         "EdgeAttrOpen",
+		NTType:     10,
+		Index:      29,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `EdgeAttrClose : edge_attr_close	<<  >>`,
+		Id:         "EdgeAttrClose",
+		NTType:     11,
+		Index:      30,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `EdgeAttrClose : edge_attr_close_nohead	<<  >>`,
+		Id:         "EdgeAttrClose",
+		NTType:     11,
+		Index:      31,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `EdgeType : Word	<<  >>`,
+		Id:         "EdgeType",
+		NTType:     12,
+		Index:      32,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `EdgeType : Word edge_key	<< ast.NewKeyedType(X[0], X[1]) >>`,
+		Id:         "EdgeType",
+		NTType:     12,
+		Index:      33,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewKeyedType(X[0], X[1])
+		},
+	},
+	ProdTabEntry{
+		String: `EdgeType : edge_key	<<  >>`,
+		Id:         "EdgeType",
+		NTType:     12,
+		Index:      34,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `EdgeRHS : EdgeArrow EdgeEnd	<< ast.NewEdgeStep(X[0], X[0], X[1], "", nil) >>`,
 		Id:         "EdgeRHS",
-		NTType:     5,
-		Index:      12,
+		NTType:     13,
+		Index:      35,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return ast.NewEdgeStep(X[0], X[1], "", nil)
+			return ast.NewEdgeStep(X[0], X[0], X[1], "", nil)
 		},
 	},
 	ProdTabEntry{
-		String: `EdgeRHS : edge_attr_open edge_attr_close id	<< ast.NewEdgeStep(X[0], X[2], "", nil) >>`,
+		String: `EdgeRHS : EdgeAttrOpen EdgeAttrClose EdgeEnd	<< ast.NewEdgeStep(X[0], X[1], X[2], "", nil) >>`,
 		Id:         "EdgeRHS",
-		NTType:     5,
-		Index:      13,
+		NTType:     13,
+		Index:      36,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return ast.NewEdgeStep(X[0], X[2], "", nil)
+			return ast.NewEdgeStep(X[0], X[1], X[2], "", nil)
 		},
 	},
 	ProdTabEntry{
-		String: `EdgeRHS : edge_attr_open AttrItems edge_attr_close id	<< ast.NewEdgeStep(X[0], X[3], "", X[1]) >>`,
+		String: `EdgeRHS : EdgeAttrOpen AttrItems EdgeAttrClose EdgeEnd	<< ast.NewEdgeStep(X[0], X[2], X[3], "", X[1]) >>`,
 		Id:         "EdgeRHS",
-		NTType:     5,
-		Index:      14,
+		NTType:     13,
+		Index:      37,
 		NumSymbols: 4,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return ast.NewEdgeStep(X[0], X[3], "", X[1])
+			return ast.NewEdgeStep(X[0], X[2], X[3], "", X[1])
 		},
 	},
 	ProdTabEntry{
-		String: `EdgeRHS : edge_attr_open id OptSep edge_attr_close id	<< ast.NewEdgeStep(X[0], X[4], X[1], nil) >>`,
+		String: `EdgeRHS : EdgeAttrOpen EdgeType OptSep EdgeAttrClose EdgeEnd	<< ast.NewEdgeStep(X[0], X[3], X[4], X[1], nil) >>`,
 		Id:         "EdgeRHS",
-		NTType:     5,
-		Index:      15,
+		NTType:     13,
+		Index:      38,
 		NumSymbols: 5,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return ast.NewEdgeStep(X[0], X[4], X[1], nil)
+			return ast.NewEdgeStep(X[0], X[3], X[4], X[1], nil)
 		},
 	},
 	ProdTabEntry{
-		String: `EdgeRHS : edge_attr_open id OptSep AttrItems edge_attr_close id	<< ast.NewEdgeStep(X[0], X[5], X[1], X[3]) >>`,
+		String: `EdgeRHS : EdgeAttrOpen EdgeType OptSep AttrItems EdgeAttrClose EdgeEnd	<< ast.NewEdgeStep(X[0], X[4], X[5], X[1], X[3]) >>`,
 		Id:         "EdgeRHS",
-		NTType:     5,
-		Index:      16,
+		NTType:     13,
+		Index:      39,
 		NumSymbols: 6,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return ast.NewEdgeStep(X[0], X[5], X[1], X[3])
+			return ast.NewEdgeStep(X[0], X[4], X[5], X[1], X[3])
+		},
+	},
+	ProdTabEntry{
+		String: `EdgeEnd : EdgeRef	<< ast.NewEdgeEnds(X[0]) >>`,
+		Id:         "EdgeEnd",
+		NTType:     14,
+		Index:      40,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewEdgeEnds(X[0])
+		},
+	},
+	ProdTabEntry{
+		String: `EdgeEnd : "{" EdgeRefList "}"	<< ast.NewEdgeSet(X[0], X[1]) >>`,
+		Id:         "EdgeEnd",
+		NTType:     14,
+		Index:      41,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewEdgeSet(X[0], X[1])
+		},
+	},
+	ProdTabEntry{
+		String: `EdgeRef : NodeDecl	<< ast.NewInlineEdgeEnd(X[0]) >>`,
+		Id:         "EdgeRef",
+		NTType:     15,
+		Index:      42,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewInlineEdgeEnd(X[0])
+		},
+	},
+	ProdTabEntry{
+		String: `EdgeRef : NodeId ":" NodeId	<< ast.NewEdgeEnd(X[0], X[2]) >>`,
+		Id:         "EdgeRef",
+		NTType:     15,
+		Index:      43,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewEdgeEnd(X[0], X[2])
+		},
+	},
+	ProdTabEntry{
+		String: `EdgeRefList : EdgeRef	<< ast.NewEdgeEnds(X[0]) >>`,
+		Id:         "EdgeRefList",
+		NTType:     16,
+		Index:      44,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewEdgeEnds(X[0])
+		},
+	},
+	ProdTabEntry{
+		String: `EdgeRefList : EdgeRefList EdgeRef	<< ast.AppendEdgeEnds(X[0], X[1]) >>`,
+		Id:         "EdgeRefList",
+		NTType:     16,
+		Index:      45,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.AppendEdgeEnds(X[0], X[1])
+		},
+	},
+	ProdTabEntry{
+		String: `EdgeRefList : EdgeRefList "," EdgeRef	<< ast.AppendEdgeEnds(X[0], X[2]) >>`,
+		Id:         "EdgeRefList",
+		NTType:     16,
+		Index:      46,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.AppendEdgeEnds(X[0], X[2])
 		},
 	},
 	ProdTabEntry{
-		String: `EdgeDecl : id EdgeRHS	<< ast.NewEdgeChain(X[0],X[1]) >>`,
+		String: `EdgeDecl : EdgeEnd EdgeRHS	<< ast.NewEdgeChain(X[0],X[1]) >>`,
 		Id:         "EdgeDecl",
-		NTType:     6,
-		Index:      17,
+		NTType:     17,
+		Index:      47,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return ast.NewEdgeChain(X[0],X[1])
@@ -202,8 +502,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `EdgeDecl : EdgeDecl EdgeRHS	<< ast.ExtendEdgeChain(X[0],X[1]) >>`,
 		Id:         "EdgeDecl",
-		NTType:     6,
-		Index:      18,
+		NTType:     17,
+		Index:      48,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return ast.ExtendEdgeChain(X[0],X[1])
@@ -212,8 +512,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `TopLevelStmt : EdgeDecl OptSep	<<  >>`,
 		Id:         "TopLevelStmt",
-		NTType:     7,
-		Index:      19,
+		NTType:     18,
+		Index:      49,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -222,18 +522,338 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `TopLevelStmt : NodeDecl OptSep	<<  >>`,
 		Id:         "TopLevelStmt",
-		NTType:     7,
-		Index:      20,
+		NTType:     18,
+		Index:      50,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
 		},
 	},
+	ProdTabEntry{
+		String: `TopLevelStmt : GroupDecl OptSep	<<  >>`,
+		Id:         "TopLevelStmt",
+		NTType:     18,
+		Index:      51,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `TopLevelStmt : IncludeDecl OptSep	<<  >>`,
+		Id:         "TopLevelStmt",
+		NTType:     18,
+		Index:      52,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `TopLevelStmt : DefaultsDecl OptSep	<<  >>`,
+		Id:         "TopLevelStmt",
+		NTType:     18,
+		Index:      53,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `TopLevelStmt : GraphAttrsDecl OptSep	<<  >>`,
+		Id:         "TopLevelStmt",
+		NTType:     18,
+		Index:      54,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `TopLevelStmt : DeleteDecl OptSep	<<  >>`,
+		Id:         "TopLevelStmt",
+		NTType:     18,
+		Index:      55,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `TopLevelStmt : NamespaceDecl OptSep	<<  >>`,
+		Id:         "TopLevelStmt",
+		NTType:     18,
+		Index:      56,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `TopLevelStmt : TemplateDecl OptSep	<<  >>`,
+		Id:         "TopLevelStmt",
+		NTType:     18,
+		Index:      57,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `TopLevelStmt : TemplateUseDecl OptSep	<<  >>`,
+		Id:         "TopLevelStmt",
+		NTType:     18,
+		Index:      58,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `TopLevelStmt : LetDecl OptSep	<<  >>`,
+		Id:         "TopLevelStmt",
+		NTType:     18,
+		Index:      59,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `LetDecl : "@let" id "=" ScalarVal	<< ast.NewLet(X[1], X[3]) >>`,
+		Id:         "LetDecl",
+		NTType:     19,
+		Index:      60,
+		NumSymbols: 4,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewLet(X[1], X[3])
+		},
+	},
+	ProdTabEntry{
+		String: `TemplateDecl : "@template" id "(" ")" GroupBody	<< ast.NewTemplate(X[1], nil, X[4]) >>`,
+		Id:         "TemplateDecl",
+		NTType:     20,
+		Index:      61,
+		NumSymbols: 5,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewTemplate(X[1], nil, X[4])
+		},
+	},
+	ProdTabEntry{
+		String: `TemplateDecl : "@template" id "(" ParamList ")" GroupBody	<< ast.NewTemplate(X[1], X[3], X[5]) >>`,
+		Id:         "TemplateDecl",
+		NTType:     20,
+		Index:      62,
+		NumSymbols: 6,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewTemplate(X[1], X[3], X[5])
+		},
+	},
+	ProdTabEntry{
+		String: `ParamList : id	<< ast.NewIdList(X[0]) >>`,
+		Id:         "ParamList",
+		NTType:     21,
+		Index:      63,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewIdList(X[0])
+		},
+	},
+	ProdTabEntry{
+		String: `ParamList : ParamList "," id	<< ast.AppendIdList(X[0], X[2]) >>`,
+		Id:         "ParamList",
+		NTType:     21,
+		Index:      64,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.AppendIdList(X[0], X[2])
+		},
+	},
+	ProdTabEntry{
+		String: `TemplateUseDecl : "@use" id "(" ")"	<< ast.NewTemplateUse(X[0], "", X[1], nil) >>`,
+		Id:         "TemplateUseDecl",
+		NTType:     22,
+		Index:      65,
+		NumSymbols: 4,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewTemplateUse(X[0], "", X[1], nil)
+		},
+	},
+	ProdTabEntry{
+		String: `TemplateUseDecl : "@use" id "(" AttrItems ")"	<< ast.NewTemplateUse(X[0], "", X[1], X[3]) >>`,
+		Id:         "TemplateUseDecl",
+		NTType:     22,
+		Index:      66,
+		NumSymbols: 5,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewTemplateUse(X[0], "", X[1], X[3])
+		},
+	},
+	ProdTabEntry{
+		String: `TemplateUseDecl : "@use" NodeId "=" id "(" ")"	<< ast.NewTemplateUse(X[0], X[1], X[3], nil) >>`,
+		Id:         "TemplateUseDecl",
+		NTType:     22,
+		Index:      67,
+		NumSymbols: 6,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewTemplateUse(X[0], X[1], X[3], nil)
+		},
+	},
+	ProdTabEntry{
+		String: `TemplateUseDecl : "@use" NodeId "=" id "(" AttrItems ")"	<< ast.NewTemplateUse(X[0], X[1], X[3], X[5]) >>`,
+		Id:         "TemplateUseDecl",
+		NTType:     22,
+		Index:      68,
+		NumSymbols: 7,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewTemplateUse(X[0], X[1], X[3], X[5])
+		},
+	},
+	ProdTabEntry{
+		String: `NamespaceDecl : "@namespace" NodeId GroupBody	<< ast.NewNamespace(X[1], X[2]) >>`,
+		Id:         "NamespaceDecl",
+		NTType:     23,
+		Index:      69,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewNamespace(X[1], X[2])
+		},
+	},
+	ProdTabEntry{
+		String: `DeleteDecl : "!" NodeId	<< ast.NewNodeDeletion(X[0], X[1]) >>`,
+		Id:         "DeleteDecl",
+		NTType:     24,
+		Index:      70,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewNodeDeletion(X[0], X[1])
+		},
+	},
+	ProdTabEntry{
+		String: `DeleteDecl : "!" EdgeDecl	<< ast.NewEdgeDeletion(X[0], X[1]) >>`,
+		Id:         "DeleteDecl",
+		NTType:     24,
+		Index:      71,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewEdgeDeletion(X[0], X[1])
+		},
+	},
+	ProdTabEntry{
+		String: `GraphAttrsDecl : "@graph" "[" AttrItems "]"	<< ast.NewGraphAttrs(X[0], X[2]) >>`,
+		Id:         "GraphAttrsDecl",
+		NTType:     25,
+		Index:      72,
+		NumSymbols: 4,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewGraphAttrs(X[0], X[2])
+		},
+	},
+	ProdTabEntry{
+		String: `DefaultsDecl : "@defaults" Word "[" AttrItems "]"	<< ast.NewDefaults(X[0], X[1], X[3]) >>`,
+		Id:         "DefaultsDecl",
+		NTType:     26,
+		Index:      73,
+		NumSymbols: 5,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewDefaults(X[0], X[1], X[3])
+		},
+	},
+	ProdTabEntry{
+		String: `DefaultsDecl : "@edge_defaults" Word "[" AttrItems "]"	<< ast.NewDefaults(X[0], X[1], X[3]) >>`,
+		Id:         "DefaultsDecl",
+		NTType:     26,
+		Index:      74,
+		NumSymbols: 5,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewDefaults(X[0], X[1], X[3])
+		},
+	},
+	ProdTabEntry{
+		String: `IncludeDecl : "include" quoted_string	<< ast.NewInclude(X[0], X[1]) >>`,
+		Id:         "IncludeDecl",
+		NTType:     27,
+		Index:      75,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewInclude(X[0], X[1])
+		},
+	},
+	ProdTabEntry{
+		String: `GroupDecl : "subgraph" NodeId GroupBody	<< ast.NewGroup(X[1], "", nil, X[2]) >>`,
+		Id:         "GroupDecl",
+		NTType:     28,
+		Index:      76,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewGroup(X[1], "", nil, X[2])
+		},
+	},
+	ProdTabEntry{
+		String: `GroupDecl : "subgraph" NodeId "[" AttrItems "]" GroupBody	<< ast.NewGroup(X[1], "", X[3], X[5]) >>`,
+		Id:         "GroupDecl",
+		NTType:     28,
+		Index:      77,
+		NumSymbols: 6,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewGroup(X[1], "", X[3], X[5])
+		},
+	},
+	ProdTabEntry{
+		String: `GroupDecl : "subgraph" NodeId "[" Word OptSep AttrItems "]" GroupBody	<< ast.NewGroup(X[1], X[3], X[5], X[7]) >>`,
+		Id:         "GroupDecl",
+		NTType:     28,
+		Index:      78,
+		NumSymbols: 8,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewGroup(X[1], X[3], X[5], X[7])
+		},
+	},
+	ProdTabEntry{
+		String: `GroupDecl : "subgraph" NodeId "[" Word OptSep "]" GroupBody	<< ast.NewGroup(X[1], X[3], nil, X[6]) >>`,
+		Id:         "GroupDecl",
+		NTType:     28,
+		Index:      79,
+		NumSymbols: 7,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewGroup(X[1], X[3], nil, X[6])
+		},
+	},
+	ProdTabEntry{
+		String: `GroupDecl : "subgraph" NodeId "[" "]" GroupBody	<< ast.NewGroup(X[1], "", nil, X[4]) >>`,
+		Id:         "GroupDecl",
+		NTType:     28,
+		Index:      80,
+		NumSymbols: 5,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewGroup(X[1], "", nil, X[4])
+		},
+	},
+	ProdTabEntry{
+		String: `GroupBody : "{" "}"	<< ast.NewGraph(nil) >>`,
+		Id:         "GroupBody",
+		NTType:     29,
+		Index:      81,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewGraph(nil)
+		},
+	},
+	ProdTabEntry{
+		String: `GroupBody : "{" TopLevelDeclList "}"	<< X[1], nil >>`,
+		Id:         "GroupBody",
+		NTType:     29,
+		Index:      82,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[1], nil
+		},
+	},
 	ProdTabEntry{
 		String: `AttrItems : Attr	<< ast.NewAttrs(X[0]) >>`,
 		Id:         "AttrItems",
-		NTType:     8,
-		Index:      21,
+		NTType:     30,
+		Index:      83,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return ast.NewAttrs(X[0])
@@ -242,8 +862,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `AttrItems : AttrItems Attr	<< ast.AddAttr(X[0], X[1]) >>`,
 		Id:         "AttrItems",
-		NTType:     8,
-		Index:      22,
+		NTType:     30,
+		Index:      84,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return ast.AddAttr(X[0], X[1])
@@ -252,8 +872,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `OptAttrSep : empty	<<  >>`,
 		Id:         "OptAttrSep",
-		NTType:     9,
-		Index:      23,
+		NTType:     31,
+		Index:      85,
 		NumSymbols: 0,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return nil, nil
@@ -262,51 +882,151 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `OptAttrSep : ","	<<  >>`,
 		Id:         "OptAttrSep",
-		NTType:     9,
-		Index:      24,
+		NTType:     31,
+		Index:      86,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
 		},
 	},
 	ProdTabEntry{
-		String: `Attr : id "=" AttrVal OptAttrSep	<< ast.NewAttr(X[0], X[2]) >>`,
+		String: `Attr : AttrKey "=" AttrVal OptAttrSep	<< ast.NewAttr(X[0], X[2]) >>`,
 		Id:         "Attr",
-		NTType:     10,
-		Index:      25,
+		NTType:     32,
+		Index:      87,
 		NumSymbols: 4,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return ast.NewAttr(X[0], X[2])
 		},
 	},
 	ProdTabEntry{
-		String: `AttrVal : id	<< X[0], nil >>`,
-		Id:         "AttrVal",
-		NTType:     11,
-		Index:      26,
+		String: `Attr : "!" AttrKey OptAttrSep	<< ast.NewAttrUnset(X[1]) >>`,
+		Id:         "Attr",
+		NTType:     32,
+		Index:      88,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewAttrUnset(X[1])
+		},
+	},
+	ProdTabEntry{
+		String: `AttrKey : Word	<<  >>`,
+		Id:         "AttrKey",
+		NTType:     33,
+		Index:      89,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `AttrKey : dotted_id	<<  >>`,
+		Id:         "AttrKey",
+		NTType:     33,
+		Index:      90,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
 		},
 	},
 	ProdTabEntry{
-		String: `AttrVal : numeric_literal	<< X[0], nil >>`,
+		String: `AttrVal : ScalarVal	<<  >>`,
 		Id:         "AttrVal",
-		NTType:     11,
-		Index:      27,
+		NTType:     34,
+		Index:      91,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
 		},
 	},
 	ProdTabEntry{
-		String: `AttrVal : quoted_string	<< ast.Unquote(X[0]) >>`,
+		String: `AttrVal : "[" "]"	<< ast.NewListVal(X[0], nil) >>`,
 		Id:         "AttrVal",
-		NTType:     11,
-		Index:      28,
+		NTType:     34,
+		Index:      92,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewListVal(X[0], nil)
+		},
+	},
+	ProdTabEntry{
+		String: `AttrVal : "[" ListItems "]"	<< ast.NewListVal(X[0], X[1]) >>`,
+		Id:         "AttrVal",
+		NTType:     34,
+		Index:      93,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewListVal(X[0], X[1])
+		},
+	},
+	ProdTabEntry{
+		String: `ScalarVal : Word	<< ast.NewIdentVal(X[0]) >>`,
+		Id:         "ScalarVal",
+		NTType:     35,
+		Index:      94,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return ast.Unquote(X[0])
+			return ast.NewIdentVal(X[0])
+		},
+	},
+	ProdTabEntry{
+		String: `ScalarVal : numeric_literal	<< ast.NewNumberVal(X[0]) >>`,
+		Id:         "ScalarVal",
+		NTType:     35,
+		Index:      95,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewNumberVal(X[0])
+		},
+	},
+	ProdTabEntry{
+		String: `ScalarVal : quoted_string	<< ast.NewStringVal(X[0]) >>`,
+		Id:         "ScalarVal",
+		NTType:     35,
+		Index:      96,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewStringVal(X[0])
+		},
+	},
+	ProdTabEntry{
+		String: `ScalarVal : raw_string	<< ast.NewRawStringVal(X[0]) >>`,
+		Id:         "ScalarVal",
+		NTType:     35,
+		Index:      97,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewRawStringVal(X[0])
+		},
+	},
+	ProdTabEntry{
+		String: `ScalarVal : param_ref	<< ast.NewParamVal(X[0]) >>`,
+		Id:         "ScalarVal",
+		NTType:     35,
+		Index:      98,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewParamVal(X[0])
+		},
+	},
+	ProdTabEntry{
+		String: `ListItems : ScalarVal OptAttrSep	<< ast.NewListItems(X[0]) >>`,
+		Id:         "ListItems",
+		NTType:     36,
+		Index:      99,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewListItems(X[0])
+		},
+	},
+	ProdTabEntry{
+		String: `ListItems : ListItems ScalarVal OptAttrSep	<< ast.AddListItem(X[0], X[1]) >>`,
+		Id:         "ListItems",
+		NTType:     36,
+		Index:      100,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.AddListItem(X[0], X[1])
 		},
 	},
 }
@@ -141,31 +141,83 @@ var TokMap = TokenMap{
 		"empty",
 		";",
 		"id",
+		"dotted_id",
+		"quoted_string",
+		"ns_prefix",
 		"[",
 		"]",
+		"_",
+		",",
+		"subgraph",
+		"include",
 		"edgearrow",
+		"edgeline",
+		"edgebiarrow",
+		"edgebackarrow",
 		"edge_attr_open",
+		"edge_attr_open_head",
 		"edge_attr_close",
-		",",
+		"edge_attr_close_nohead",
+		"edge_key",
+		"{",
+		"}",
+		":",
+		"@let",
 		"=",
+		"@template",
+		"(",
+		")",
+		"@use",
+		"@namespace",
+		"!",
+		"@graph",
+		"@defaults",
+		"@edge_defaults",
 		"numeric_literal",
-		"quoted_string",
+		"raw_string",
+		"param_ref",
 	},
 
 	idMap: map[string]Type{
-		"INVALID":         0,
-		"␚":               1,
-		"empty":           2,
-		";":               3,
-		"id":              4,
-		"[":               5,
-		"]":               6,
-		"edgearrow":       7,
-		"edge_attr_open":  8,
-		"edge_attr_close": 9,
-		",":               10,
-		"=":               11,
-		"numeric_literal": 12,
-		"quoted_string":   13,
+		"INVALID":                0,
+		"␚":                      1,
+		"empty":                  2,
+		";":                      3,
+		"id":                     4,
+		"dotted_id":              5,
+		"quoted_string":          6,
+		"ns_prefix":              7,
+		"[":                      8,
+		"]":                      9,
+		"_":                      10,
+		",":                      11,
+		"subgraph":               12,
+		"include":                13,
+		"edgearrow":              14,
+		"edgeline":               15,
+		"edgebiarrow":            16,
+		"edgebackarrow":          17,
+		"edge_attr_open":         18,
+		"edge_attr_open_head":    19,
+		"edge_attr_close":        20,
+		"edge_attr_close_nohead": 21,
+		"edge_key":               22,
+		"{":                      23,
+		"}":                      24,
+		":":                      25,
+		"@let":                   26,
+		"=":                      27,
+		"@template":              28,
+		"(":                      29,
+		")":                      30,
+		"@use":                   31,
+		"@namespace":             32,
+		"!":                      33,
+		"@graph":                 34,
+		"@defaults":              35,
+		"@edge_defaults":         36,
+		"numeric_literal":        37,
+		"raw_string":             38,
+		"param_ref":              39,
 	},
 }
@@ -5,80 +5,252 @@ import (
 	"cmp"
 	"errors"
 	"fmt"
+	"io/fs"
 	"iter"
 	"maps"
-	"os"
+	"math"
+	"path/filepath"
 	"regexp"
 	"slices"
+	"strconv"
 	"strings"
 
 	"github.com/orls/lilgraph/internal/ast"
+	goccerrors "github.com/orls/lilgraph/internal/gocc/errors"
 	"github.com/orls/lilgraph/internal/gocc/lexer"
 	"github.com/orls/lilgraph/internal/gocc/parser"
 	"github.com/orls/lilgraph/internal/gocc/token"
 )
 
 var (
-	ErrInvalidId    = errors.New("invalid node id")
+	ErrInvalidId    = ast.ErrInvalidId
 	ErrParseFail    = errors.New("failed parsing")
 	ErrLoop         = errors.New("cannot create edge from a node to itself")
 	ErrBadParseType = errors.New("unexpected parser result type")
-	ErrTypeChange   = errors.New("nodes cannot be redefined with a different type")
+	ErrTypeChange   = errors.New("groups cannot be redefined with a different type")
+	ErrParentChange = errors.New("groups cannot be redefined within a different parent")
 	ErrTypeInAttrs  = errors.New("attributes called 'type' aren't allowed to avoid ambiguity")
 	ErrCyclic       = errors.New("graph is cyclic")
+	ErrBadValue     = errors.New("invalid attribute value")
+	ErrBadKey       = errors.New("invalid attribute key")
+	ErrInclude      = errors.New("failed including file")
+	ErrIncludeCycle = errors.New("include cycle")
+	ErrDelete       = errors.New("failed deleting")
+	ErrTemplate     = errors.New("invalid template")
+	ErrUnresolved   = errors.New("unresolved reference")
+	ErrVersion      = ast.ErrVersion
 )
 
-func ParseFile(path string) (*Lilgraph, error) {
-	src, err := os.ReadFile(path)
+// ParseOption configures how source is parsed.
+type ParseOption func(*parseOptions)
+
+type parseOptions struct {
+	legacyEscapes bool
+	allowLoops    bool
+	resolver      Resolver
+}
+
+// Resolver gives the values of variables referred to as `${name}` in quoted
+// attr values, for any that aren't declared in source with `@let`. Its
+// signature matches os.LookupEnv.
+type Resolver func(name string) (value string, ok bool)
+
+// WithLegacyEscapes makes quoted strings follow the original, DOT-like
+// escaping rules, where \" is the only escape sequence and backslashes are
+// otherwise literal. Graphs parsed this way are also marshalled this way, as
+// `lilgraph 1`, if they need nothing newer; else with the current rules. Files
+// that declare a version always use its rules; only version 1's are legacy.
+func WithLegacyEscapes() ParseOption {
+	return func(o *parseOptions) { o.legacyEscapes = true }
+}
+
+// WithLoops allows edges from a node to itself; see Lilgraph.SetAllowLoops.
+func WithLoops() ParseOption {
+	return func(o *parseOptions) { o.allowLoops = true }
+}
+
+// WithResolver supplies values for variables referred to in source but not
+// declared there; e.g. WithResolver(os.LookupEnv).
+func WithResolver(r Resolver) ParseOption {
+	return func(o *parseOptions) { o.resolver = r }
+}
+
+func (o parseOptions) astSettings() ast.Settings {
+	return ast.Settings{LegacyEscapes: o.legacyEscapes}
+}
+
+// ParseFile parses the file at path. Any files it includes are resolved
+// relative to it.
+func ParseFile(path string, opts ...ParseOption) (*Lilgraph, error) {
+	return parseFrom(path, osIncluder(), opts)
+}
+
+// ParseFS parses the file at path within fsys. Any files it includes are
+// resolved relative to it, within fsys.
+func ParseFS(fsys fs.FS, path string, opts ...ParseOption) (*Lilgraph, error) {
+	return parseFrom(path, fsIncluder(fsys), opts)
+}
+
+// Parse parses lilgraph source. As there's no file to resolve them relative
+// to, include statements aren't allowed; use ParseFile or ParseFS for those.
+func Parse(src []byte, opts ...ParseOption) (*Lilgraph, error) {
+	return parse(src, "", nil, opts)
+}
+
+func parseFrom(path string, inc *includer, opts []ParseOption) (*Lilgraph, error) {
+	src, err := inc.readFile(path)
 	if err != nil {
 		return nil, err
 	}
-	lexCtx := &lexer.SourceContext{Filepath: path}
-	return parse(src, lexCtx)
+	// Resolving the file's own name relative to itself gives a cleaned path,
+	// comparable with those of any files it includes.
+	inc.stack = []string{inc.resolve(path, filepath.Base(path))}
+	return parse(src, path, inc, opts)
 }
 
-func Parse(src []byte) (*Lilgraph, error) {
-	return parse(src, nil)
+func parse(src []byte, path string, inc *includer, opts []ParseOption) (*Lilgraph, error) {
+	var o parseOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	astGraph, err := parseAst(src, path, o.astSettings())
+	if err != nil {
+		return nil, err
+	}
+	return buildFromAst(astGraph, inc, o)
 }
 
-func parse(src []byte, lexCtx token.Context) (*Lilgraph, error) {
+func parseAst(src []byte, path string, settings ast.Settings) (*ast.Graph, error) {
 	// Comments at end, without a trailing newline, can cause errs. I'm not
 	// smart enough to figure out the true way to express "newline or EOF" in
 	// the grammar, so... hack it, by tacking on a newline if needed.
 	if !bytes.HasSuffix(src, []byte("\n")) {
 		src = append(src, byte('\n'))
 	}
+	// The parser's actions need to know the declared version up-front, to
+	// know what to allow and how to interpret strings.
+	lexCtx := ast.NewContext(path, settings)
+	src, version, err := stripVersionPragma(src)
+	if err != nil {
+		pos := token.Pos{Line: 1, Column: 1, Context: lexCtx}
+		return nil, fmt.Errorf("%w: %w (at %s)", ErrParseFail, err, pos)
+	}
+	if version > 0 {
+		settings.Version = version
+		// A declared version decides the escaping rules, whatever was asked.
+		settings.LegacyEscapes = version == 1
+		lexCtx = ast.NewContext(path, settings)
+	}
 	lex := lexer.NewLexer(src)
 	lex.Context = lexCtx
 	p := parser.NewParser()
-	rawAst, err := p.Parse(lex)
+	rawAst, err := p.Parse(ast.NewScanner(lex, src))
 	if err != nil {
+		var goccErr *goccerrors.Error
+		if errors.As(err, &goccErr) && goccErr.Err != nil {
+			err = actionError{goccErr}
+		}
 		return nil, fmt.Errorf("%w: %w", ErrParseFail, err)
 	}
 	astGraph, ok := rawAst.(*ast.Graph)
 	if !ok {
 		return nil, fmt.Errorf("%w: expected *ast.Graph, got %T", ErrBadParseType, rawAst)
 	}
-	return buildFromAst(astGraph)
+	astGraph.Version = version
+	return astGraph, nil
+}
+
+// actionError is an error from one of the parser's actions. The generated
+// parser wraps these in a way that hides them from errors.Is; this doesn't.
+type actionError struct {
+	err *goccerrors.Error
+}
+
+func (e actionError) Error() string { return e.err.Error() }
+func (e actionError) Unwrap() error { return e.err.Err }
+
+var (
+	versionPragmaRegexp = regexp.MustCompile(`^lilgraph[ \t]+([0-9]+)[ \t]*((//|#)[^\n]*)?\r?\n`)
+	// Anything else that starts like a pragma is a malformed one, rather than
+	// e.g. a node called `lilgraph`, which couldn't be followed by a number.
+	malformedPragmaRegexp = regexp.MustCompile(`^lilgraph[ \t]+[0-9][^\n]*`)
+)
+
+// stripVersionPragma finds the language version that src declares with a
+// `lilgraph N` pragma on its first line, if any; it can be followed by a line
+// comment. The pragma is blanked out of the returned src, so the parser
+// doesn't see it but positions are unchanged.
+func stripVersionPragma(src []byte) ([]byte, int, error) {
+	m := versionPragmaRegexp.FindSubmatchIndex(src)
+	if m == nil {
+		if line := malformedPragmaRegexp.Find(src); line != nil {
+			return nil, 0, fmt.Errorf(
+				"malformed version pragma '%s'; it has to be alone on the first line, other than a line comment",
+				bytes.TrimRight(line, "\r"),
+			)
+		}
+		return src, 0, nil
+	}
+	version, err := ast.ParseVersion(string(src[m[2]:m[3]]))
+	if err != nil {
+		return nil, 0, err
+	}
+	// The comment goes too, so that it can't be taken as a doc comment for
+	// whatever's on the next line.
+	end := m[3]
+	if m[4] >= 0 {
+		end = m[5]
+	}
+	stripped := bytes.Clone(src)
+	for i := 0; i < end; i++ {
+		stripped[i] = ' '
+	}
+	return stripped, version, nil
 }
 
 type Lilgraph struct {
+	// Attrs of the graph as a whole.
+	attrSet
+
 	nodes []*Node
 	edges []*Edge
 
-	nodesById map[string]*Node
-	edgesById map[edgeIdentity]*Edge
+	groups []*Group
+
+	defaults []*defaults
+
+	nodesById  map[string]*Node
+	edgesById  map[edgeIdentity]*Edge
+	groupsById map[string]*Group
+
+	// legacyEscapes is set for graphs parsed WithLegacyEscapes.
+	legacyEscapes bool
+
+	allowLoops bool
 }
 
 func NewGraph() *Lilgraph {
 	return &Lilgraph{
-		nodes:     []*Node{},
-		edges:     []*Edge{},
-		nodesById: map[string]*Node{},
-		edgesById: map[edgeIdentity]*Edge{},
+		nodes:      []*Node{},
+		edges:      []*Edge{},
+		groups:     []*Group{},
+		nodesById:  map[string]*Node{},
+		edgesById:  map[edgeIdentity]*Edge{},
+		groupsById: map[string]*Group{},
 	}
 }
 
+// SetAllowLoops sets whether edges from a node to itself can be added. By
+// default they can't, and attempting to gives ErrLoop. Disallowing loops
+// doesn't affect any that already exist.
+func (g *Lilgraph) SetAllowLoops(allow bool) {
+	g.allowLoops = allow
+}
+
+func (g *Lilgraph) AllowsLoops() bool {
+	return g.allowLoops
+}
+
 func (g *Lilgraph) SortTopo() error {
 	return lexicalTopoSort(g.nodes)
 }
@@ -95,33 +267,63 @@ func (g *Lilgraph) Edges() iter.Seq[*Edge] {
 	return slices.Values(g.edges)
 }
 
-// NOTE: this must match the equivalent grammar settings.
+// NOTE: these must match the equivalent grammar settings.
 var idRegexp = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+var dottedIdRegexp = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*(\.[a-zA-Z_][a-zA-Z0-9_]*)*$`)
+var edgeKeyRegexp = regexp.MustCompile(`^[a-zA-Z0-9_]+$`)
+var numberRegexp = regexp.MustCompile(`^-?([0-9]+(\.[0-9]+)?|\.[0-9]+)$`)
+
+// keywords can't be used as bare ids, only quoted ones.
+var keywords = []string{"subgraph", "include", "_"}
+
+// validId reports whether a node or group id is usable; the parser checks
+// quoted ids the same way.
+var validId = ast.ValidId
+
+// validKey reports whether an attr key can be written, which needs it to be a
+// bare, possibly dotted, id; unlike ids, keys can be keywords.
+func validKey(key string) bool {
+	return dottedIdRegexp.MatchString(key)
+}
+
+// needsQuotes reports whether an id has to be written as a quoted string.
+func needsQuotes(id string) bool {
+	return !dottedIdRegexp.MatchString(id) || slices.Contains(keywords, id)
+}
 
+// AddNode upserts a node with the given id. If typ is non-empty, it's added to
+// the node's types. A new node's namespace is taken from its id if it's
+// written with dots, as in `payments.api`; ids that need quoting, such as
+// "api.example.com", aren't namespaced.
 func (g *Lilgraph) AddNode(id string, typ string) (*Node, bool, error) {
-	if !idRegexp.MatchString(id) {
-		return nil, false, fmt.Errorf("%w %s", ErrInvalidId, id)
-	}
-	if n, ok := g.nodesById[id]; ok {
-		if typ != "" {
-			if n.typ != "" && n.typ != typ {
-				return nil, false, fmt.Errorf(
-					"%w: node '%s' already has type '%s'",
-					ErrTypeChange,
-					n.id,
-					n.typ,
-				)
-			}
-			n.typ = typ
-		}
-		return n, true, nil
+	if !validId(id) {
+		return nil, false, fmt.Errorf("%w %q", ErrInvalidId, id)
 	}
-	n := &Node{id: id, common: common{typ: typ}}
-	g.nodes = append(g.nodes, n)
-	g.nodesById[id] = n
-	return n, false, nil
+	ns := ""
+	if !needsQuotes(id) {
+		ns, _ = splitNamespace(id)
+	}
+	n, ok := g.upsertNode(id, ns)
+	if typ != "" {
+		n.AddType(typ)
+	}
+	return n, ok, nil
 }
 
+// upsertNode gets the node with the given id, or adds one in namespace ns.
+func (g *Lilgraph) upsertNode(id, ns string) (*Node, bool) {
+	n, ok := g.nodesById[id]
+	if !ok {
+		n = &Node{id: id, ns: ns}
+		g.nodes = append(g.nodes, n)
+		g.nodesById[id] = n
+	}
+	return n, ok
+}
+
+// FindEdges yields all edges that can be followed from one node to another:
+// directed edges from->to, plus any non-directed edges between the two,
+// regardless of which way round they were declared.
 func (g *Lilgraph) FindEdges(from *Node, to *Node) iter.Seq[*Edge] {
 	return func(yield func(*Edge) bool) {
 		for _, e := range from.edgesFrom {
@@ -131,22 +333,60 @@ func (g *Lilgraph) FindEdges(from *Node, to *Node) iter.Seq[*Edge] {
 				}
 			}
 		}
+		for _, e := range from.edgesTo {
+			// (A non-directed loop was already yielded above.)
+			if e.from == to && e.dir != Directed && e.from != e.to {
+				if !yield(e) {
+					return
+				}
+			}
+		}
 	}
 }
 
+// FindEdge finds a directed edge of the given type, with no ports or key. Use
+// FindEdgeSpec to find others.
 func (g *Lilgraph) FindEdge(from *Node, to *Node, edgeType string) (*Edge, bool) {
-	e, ok := g.edgesById[edgeIdentity{from, to, edgeType}]
+	return g.FindEdgeSpec(from, to, EdgeSpec{Type: edgeType})
+}
+
+func (g *Lilgraph) FindEdgeSpec(from *Node, to *Node, spec EdgeSpec) (*Edge, bool) {
+	e, ok := g.edgesById[newEdgeIdentity(from, to, spec)]
 	return e, ok
 }
 
+// AddEdge upserts a directed edge of the given type.
 func (g *Lilgraph) AddEdge(from *Node, to *Node, edgeType string) (*Edge, bool, error) {
-	if from == to {
+	return g.AddEdgeSpec(from, to, EdgeSpec{Type: edgeType})
+}
+
+// AddEdgeSpec upserts an edge with the given identifying properties. Non-directed
+// edges are matched regardless of which way round their endpoints are given;
+// a new edge keeps the from/to order it was first added with.
+func (g *Lilgraph) AddEdgeSpec(from *Node, to *Node, spec EdgeSpec) (*Edge, bool, error) {
+	if from == to && !g.allowLoops {
 		return nil, false, ErrLoop
 	}
-	id := edgeIdentity{from, to, edgeType}
+	for _, port := range []string{spec.FromPort, spec.ToPort} {
+		if port != "" && !validId(port) {
+			return nil, false, fmt.Errorf("%w: port %q", ErrInvalidId, port)
+		}
+	}
+	if spec.Key != "" && !edgeKeyRegexp.MatchString(spec.Key) {
+		return nil, false, fmt.Errorf("%w: edge key %q", ErrInvalidId, spec.Key)
+	}
+	id := newEdgeIdentity(from, to, spec)
 	e, ok := g.edgesById[id]
 	if !ok {
-		e = &Edge{from: from, to: to, common: common{typ: edgeType}}
+		e = &Edge{
+			from:     from,
+			to:       to,
+			dir:      spec.Dir,
+			fromPort: spec.FromPort,
+			toPort:   spec.ToPort,
+			key:      spec.Key,
+			common:   common{typ: spec.Type},
+		}
 		g.edges = append(g.edges, e)
 		g.edgesById[id] = e
 		from.edgesFrom = append(from.edgesFrom, e)
@@ -162,15 +402,20 @@ func (g *Lilgraph) DeleteNode(n *Node) bool {
 	}
 	g.nodes = slices.Delete(g.nodes, i, i+1)
 	delete(g.nodesById, n.id)
+	for _, gr := range slices.Clone(n.groups) {
+		gr.RemoveNode(n)
+	}
+	// Edges are only detached from n after deleting them, as their identity
+	// depends on both of their nodes.
 	for i, e := range n.edgesFrom {
+		g.deleteEdge(e, false, true)
 		e.from = nil
 		n.edgesFrom[i] = nil
-		g.deleteEdge(e, false, true)
 	}
 	for i, e := range n.edgesTo {
+		g.deleteEdge(e, true, false)
 		e.to = nil
 		n.edgesTo[i] = nil
-		g.deleteEdge(e, true, false)
 	}
 	n.edgesFrom = nil
 	n.edgesTo = nil
@@ -187,7 +432,10 @@ func (g *Lilgraph) deleteEdge(e *Edge, purgeFrom, purgeTo bool) bool {
 		return false
 	}
 	g.edges = slices.Delete(g.edges, i, i+1)
-	delete(g.edgesById, edgeIdentity{from: e.from, to: e.to, typ: e.typ})
+	delete(g.edgesById, e.identity())
+	for _, gr := range slices.Clone(e.groups) {
+		gr.RemoveEdge(e)
+	}
 	delFn := func(other *Edge) bool { return e == other }
 	if purgeFrom {
 		e.from.edgesFrom = slices.DeleteFunc(e.from.edgesFrom, delFn)
@@ -203,77 +451,394 @@ func (g *Lilgraph) MarshalText() (text []byte, err error) {
 }
 
 type Node struct {
-	common
+	common // typ is unused; nodes have types instead.
 
-	id        string
+	id string
+	// The namespace that id is in, if any; a prefix of it, up to a dot.
+	ns        string
+	types     []string
 	edgesFrom []*Edge
 	edgesTo   []*Edge
+	groups    []*Group
 
 	// AST parser metadata about location of node's first freestanding
 	// declaration (if any). Nodes that are only everdeclared by an edge chain
 	// will not have this set.
 	declPos *token.Pos
 
-	// AST parser metadata about location where this nodes' type was first
+	// AST parser metadata about location where this nodes' first type was
 	// declared (if any).
 	typeFromPos *token.Pos
+
+	// Whether this was declared as an anonymous node, with a generated id.
+	anonymous bool
+
+	// From a doc comment before the node's decl, if any.
+	doc string
 }
 
 func (n *Node) Id() string                 { return n.id }
 func (n *Node) EdgesFrom() iter.Seq[*Edge] { return slices.Values(n.edgesFrom) }
 func (n *Node) EdgesTo() iter.Seq[*Edge]   { return slices.Values(n.edgesTo) }
+func (n *Node) Groups() iter.Seq[*Group]   { return slices.Values(n.groups) }
+func (n *Node) Types() iter.Seq[string]    { return slices.Values(n.types) }
+func (n *Node) HasType(typ string) bool    { return slices.Contains(n.types, typ) }
+
+// Doc gives the node's documentation, from a doc comment before its decl (or
+// the last of them, if it has several), e.g. `/// The main API.`.
+func (n *Node) Doc() string { return n.doc }
+
+func (n *Node) SetDoc(doc string) { n.doc = doc }
+
+// Anonymous reports whether the node was declared anonymously, as `_`; in which
+// case its id was generated, e.g. `_1`.
+func (n *Node) Anonymous() bool { return n.anonymous }
+
+// Type gives the node's first type, or "" if it has none.
+func (n *Node) Type() string {
+	if len(n.types) == 0 {
+		return ""
+	}
+	return n.types[0]
+}
+
+// AddType adds a type to the node. Returns false if it already had it.
+func (n *Node) AddType(typ string) bool {
+	if typ == "" || n.HasType(typ) {
+		return false
+	}
+	n.types = append(n.types, typ)
+	return true
+}
+
+func (n *Node) RemoveType(typ string) bool {
+	if !n.HasType(typ) {
+		return false
+	}
+	n.types = slices.DeleteFunc(n.types, func(other string) bool { return typ == other })
+	return true
+}
 
 type Edge struct {
 	common
 
-	from *Node
-	to   *Node
+	from   *Node
+	to     *Node
+	dir    Direction
+	groups []*Group
+
+	// Ports on the from/to nodes that the edge attaches to, if any.
+	fromPort string
+	toPort   string
+
+	// Distinguishes parallel edges that are otherwise identical.
+	key string
+
+	// From a doc comment before the edge's decl, if any.
+	doc string
 
 	pos *token.Pos
 }
 
-func (e *Edge) Type() string { return e.typ }
-func (e *Edge) From() *Node  { return e.from }
-func (e *Edge) To() *Node    { return e.to }
+func (e *Edge) Type() string             { return e.typ }
+func (e *Edge) From() *Node              { return e.from }
+func (e *Edge) To() *Node                { return e.to }
+func (e *Edge) Direction() Direction     { return e.dir }
+func (e *Edge) FromPort() string         { return e.fromPort }
+func (e *Edge) ToPort() string           { return e.toPort }
+func (e *Edge) Key() string              { return e.key }
+func (e *Edge) Groups() iter.Seq[*Group] { return slices.Values(e.groups) }
+
+// Doc gives the edge's documentation, from a doc comment before its decl (or
+// the last of them, if it has several). A doc comment before an edge chain
+// documents the chain's first step.
+func (e *Edge) Doc() string { return e.doc }
+
+func (e *Edge) SetDoc(doc string) { e.doc = doc }
+
+func (e *Edge) identity() edgeIdentity {
+	return newEdgeIdentity(e.from, e.to, e.spec())
+}
+
+func (e *Edge) spec() EdgeSpec {
+	return EdgeSpec{Type: e.typ, Dir: e.dir, FromPort: e.fromPort, ToPort: e.toPort, Key: e.key}
+}
+
+// Direction of an edge. The zero value is a regular directed edge.
+type Direction int
+
+const (
+	Directed      Direction = iota // a -> b
+	Undirected                     // a -- b
+	Bidirectional                  // a <-> b
+)
+
+func (d Direction) String() string {
+	switch d {
+	case Directed:
+		return "directed"
+	case Undirected:
+		return "undirected"
+	case Bidirectional:
+		return "bidirectional"
+	}
+	return fmt.Sprintf("Direction(%d)", int(d))
+}
+
+// EdgeSpec holds the properties that, along with its endpoints, identify an
+// edge.
+type EdgeSpec struct {
+	Type string
+	Dir  Direction
+
+	// Optional named ports on the from/to nodes, e.g. `a:out -> b:in`.
+	FromPort string
+	ToPort   string
+
+	// Optional key, to allow several edges that are otherwise the same, e.g.
+	// `a -[link#primary]-> b` and `a -[link#backup]-> b`. Keys are made of
+	// the same characters as bare ids, but can start with a digit.
+	Key string
+}
 
 type edgeIdentity struct {
-	from *Node
-	to   *Node
-	typ  string
+	from     *Node
+	to       *Node
+	typ      string
+	dir      Direction
+	fromPort string
+	toPort   string
+	key      string
+}
+
+func newEdgeIdentity(from, to *Node, spec EdgeSpec) edgeIdentity {
+	fromPort, toPort := spec.FromPort, spec.ToPort
+	if spec.Dir != Directed && from != nil && to != nil &&
+		(to.id < from.id || (to.id == from.id && toPort < fromPort)) {
+		// Non-directed edges are the same edge whichever way round they're
+		// written, so normalise endpoint order.
+		from, to = to, from
+		fromPort, toPort = toPort, fromPort
+	}
+	return edgeIdentity{
+		from:     from,
+		to:       to,
+		typ:      spec.Type,
+		dir:      spec.Dir,
+		fromPort: fromPort,
+		toPort:   toPort,
+		key:      spec.Key,
+	}
 }
 
 type common struct {
-	typ   string
+	typ string
+	attrSet
+
+	// AST parser metadata about the order in which this was first declared,
+	// across all parsed files. Only meaningful where the item's declaration
+	// position is also set.
+	declOrder int
+}
+
+// attrSet holds the attrs of a node, edge or group; or of the graph as a whole.
+type attrSet struct {
 	attrs []attr
+
+	// Keys explicitly unset, e.g. by `!key`, so that defaults don't fill them
+	// in again. Once defaults are applied, only those that kept a default
+	// from being inherited are kept.
+	unset []string
 }
 
 type attr struct {
-	key   string
+	key string
+	scalar
+
+	// Only set for KindList attrs, in which case the scalar value holds the
+	// lilgraph literal form of the whole list, e.g. `[a, "b c"]`.
+	list []scalar
+
+	// Whether this came from a type-level defaults decl.
+	inherited bool
+}
+
+type scalar struct {
 	value string
+	kind  ValueKind
+}
+
+// ValueKind records the literal form of an attr value: e.g. whether `port=8080`
+// or `port="8080"` was written.
+type ValueKind int
+
+const (
+	KindString ValueKind = iota // a quoted string
+	KindIdent                   // a bare identifier
+	KindNumber                  // a numeric literal
+	KindBool                    // one of the identifiers true or false
+	KindList                    // a list of any of the above
+)
+
+func (k ValueKind) String() string {
+	switch k {
+	case KindString:
+		return "string"
+	case KindIdent:
+		return "ident"
+	case KindNumber:
+		return "number"
+	case KindBool:
+		return "bool"
+	case KindList:
+		return "list"
+	}
+	return fmt.Sprintf("ValueKind(%d)", int(k))
+}
+
+var astValueKinds = map[ast.ValueKind]ValueKind{
+	ast.StringValue: KindString,
+	ast.IdentValue:  KindIdent,
+	ast.NumberValue: KindNumber,
+	ast.BoolValue:   KindBool,
+	ast.ListValue:   KindList,
 }
 
 func (c *common) Type() string {
 	return c.typ
 }
 
-func (c *common) SetAttr(key, value string) error {
-	if strings.ToLower(key) == "type" {
+// SetAttr sets an attr to a string value.
+func (c *attrSet) SetAttr(key, value string) error {
+	return c.setAttr(key, value, KindString)
+}
+
+// SetAttrKind sets an attr to a value of a specific kind. The value must be
+// valid for that kind, i.e. it must be something that could be written as that
+// kind of literal in lilgraph source.
+func (c *attrSet) SetAttrKind(key, value string, kind ValueKind) error {
+	valid := true
+	switch kind {
+	case KindIdent:
+		// true and false would be read back as bools.
+		valid = idRegexp.MatchString(value) && value != "true" && value != "false"
+	case KindNumber:
+		valid = numberRegexp.MatchString(value)
+	case KindBool:
+		valid = value == "true" || value == "false"
+	case KindList:
+		// Lists need their items given individually; use SetList.
+		valid = false
+	}
+	if !valid {
+		return fmt.Errorf("%w: '%s' is not a valid %s", ErrBadValue, value, kind)
+	}
+	return c.setAttr(key, value, kind)
+}
+
+// SetNumber sets an attr to a number. It must be finite, as there are no
+// literals for NaN or infinities.
+func (c *attrSet) SetNumber(key string, value float64) error {
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return fmt.Errorf("%w: %v is not a valid %s", ErrBadValue, value, KindNumber)
+	}
+	return c.setAttr(key, strconv.FormatFloat(value, 'f', -1, 64), KindNumber)
+}
+
+func (c *attrSet) SetBool(key string, value bool) error {
+	return c.setAttr(key, strconv.FormatBool(value), KindBool)
+}
+
+// SetList sets an attr to a list of string values.
+func (c *attrSet) SetList(key string, values []string) error {
+	items := make([]scalar, 0, len(values))
+	for _, v := range values {
+		items = append(items, scalar{value: v, kind: KindString})
+	}
+	return c.setList(key, items)
+}
+
+func (c *attrSet) setAttr(key, value string, kind ValueKind) error {
+	return c.upsertAttr(attr{key: key, scalar: scalar{value: value, kind: kind}})
+}
+
+func (c *attrSet) setList(key string, items []scalar) error {
+	return c.upsertAttr(attr{
+		key:    key,
+		scalar: scalar{value: new(textWriter).formatList(items), kind: KindList},
+		list:   items,
+	})
+}
+
+func (c *attrSet) upsertAttr(a attr) error {
+	if strings.ToLower(a.key) == "type" {
 		return ErrTypeInAttrs
 	}
+	if !validKey(a.key) {
+		return fmt.Errorf("%w %q", ErrBadKey, a.key)
+	}
 	if c.attrs == nil {
 		c.attrs = []attr{}
 	}
+	// As with any other attr, the latest value wins; so setting e.g. `deploy`
+	// replaces everything under `deploy.*`, and vice-versa.
+	c.attrs = slices.DeleteFunc(c.attrs, func(attr attr) bool { return keysConflict(attr.key, a.key) })
+	c.unset = slices.DeleteFunc(c.unset, func(key string) bool { return key == a.key || keysConflict(key, a.key) })
 	for i, attr := range c.attrs {
-		if attr.key == key {
-			c.attrs[i].value = value
+		if attr.key == a.key {
+			c.attrs[i] = a
 			return nil
 		}
 	}
-	c.attrs = append(c.attrs, attr{key: key, value: value})
+	c.attrs = append(c.attrs, a)
 	return nil
 }
 
-func (c *common) GetAttr(key string) (string, bool) {
+// AttrKind returns the kind of an attr's value.
+func (c *attrSet) AttrKind(key string) (ValueKind, bool) {
+	for _, attr := range c.attrs {
+		if attr.key == key {
+			return attr.kind, true
+		}
+	}
+	return 0, false
+}
+
+// GetNumber returns the value of a number-kind attr. Returns false if the attr
+// doesn't exist, or is of another kind.
+func (c *attrSet) GetNumber(key string) (float64, bool) {
+	for _, attr := range c.attrs {
+		if attr.key == key && attr.kind == KindNumber {
+			f, err := strconv.ParseFloat(attr.value, 64)
+			return f, err == nil
+		}
+	}
+	return 0, false
+}
+
+// GetList returns the items of a list-kind attr. Returns false if the attr
+// doesn't exist, or is of another kind.
+func (c *attrSet) GetList(key string) ([]string, bool) {
+	for _, attr := range c.attrs {
+		if attr.key == key && attr.kind == KindList {
+			return attr.listValues(), true
+		}
+	}
+	return nil, false
+}
+
+// GetBool returns the value of a bool-kind attr. Returns false if the attr
+// doesn't exist, or is of another kind.
+func (c *attrSet) GetBool(key string) (value bool, ok bool) {
+	for _, attr := range c.attrs {
+		if attr.key == key && attr.kind == KindBool {
+			return attr.value == "true", true
+		}
+	}
+	return false, false
+}
+
+func (c *attrSet) GetAttr(key string) (string, bool) {
 	for _, attr := range c.attrs {
 		if attr.key == key {
 			return attr.value, true
@@ -282,19 +847,33 @@ func (c *common) GetAttr(key string) (string, bool) {
 	return "", false
 }
 
-func (c *common) DeleteAttr(key string) {
+// DeleteAttr removes an attr, along with any that are nested under it; e.g.
+// deleting `deploy` also deletes `deploy.region`. Removing an inherited attr
+// is recorded as an unset, so that it stays removed when marshalled.
+func (c *attrSet) DeleteAttr(key string) {
 	if c.attrs == nil {
 		return
 	}
-	for i, attr := range c.attrs {
-		if attr.key == key {
-			c.attrs = slices.Delete(c.attrs, i, i+1)
-			return
+	inherited := false
+	c.attrs = slices.DeleteFunc(c.attrs, func(attr attr) bool {
+		if attr.key == key || strings.HasPrefix(attr.key, key+".") {
+			inherited = inherited || attr.inherited
+			return true
 		}
+		return false
+	})
+	if inherited {
+		c.markUnset(key)
 	}
 }
 
-func (c *common) AttrsMap() map[string]string {
+func (c *attrSet) markUnset(key string) {
+	if !slices.Contains(c.unset, key) {
+		c.unset = append(c.unset, key)
+	}
+}
+
+func (c *attrSet) AttrsMap() map[string]string {
 	if c.attrs == nil {
 		return nil
 	}
@@ -305,7 +884,7 @@ func (c *common) AttrsMap() map[string]string {
 	return m
 }
 
-func (c *common) ReplaceAttrs(m map[string]string) {
+func (c *attrSet) ReplaceAttrs(m map[string]string) {
 	if c.attrs == nil {
 		c.attrs = []attr{}
 	}
@@ -315,105 +894,596 @@ func (c *common) ReplaceAttrs(m map[string]string) {
 	newAttrs := make([]attr, 0, len(m))
 	for _, attr := range c.attrs {
 		if wantVal, ok := m[attr.key]; ok {
-			attr.value = wantVal
+			if wantVal != attr.value {
+				attr.scalar = scalar{value: wantVal, kind: KindString}
+				attr.list = nil
+			}
 			newAttrs = append(newAttrs, attr)
+		} else if attr.inherited {
+			c.markUnset(attr.key)
 		}
 		delete(m, attr.key)
 	}
 	for k, v := range m {
-		newAttrs = append(newAttrs, attr{key: k, value: v})
+		newAttrs = append(newAttrs, attr{key: k, scalar: scalar{value: v, kind: KindString}})
 	}
 	c.attrs = newAttrs
 }
 
-func buildFromAst(astGraph *ast.Graph) (*Lilgraph, error) {
-	g := &Lilgraph{
-		nodes:     []*Node{},
-		edges:     []*Edge{},
-		nodesById: map[string]*Node{},
-		edgesById: map[edgeIdentity]*Edge{},
+func buildFromAst(astGraph *ast.Graph, inc *includer, opts parseOptions) (*Lilgraph, error) {
+	g := NewGraph()
+	g.legacyEscapes = opts.legacyEscapes
+	g.allowLoops = opts.allowLoops
+
+	// Offsets in token positions only make sense within a single file, so
+	// also keep a running count of declarations, for ordering across files.
+	declCount := 0
+	nextDeclOrder := func() int {
+		declCount++
+		return declCount
 	}
 
-	upsertNodeFromAst := func(id string, pos *token.Pos, typ string) (*Node, error) {
-		n, _, err := g.AddNode(id, typ)
-		if err != nil {
-			return nil, err
+	// Within namespace blocks, ids that aren't explicitly namespaced get
+	// prefixed with the namespace. qualify gives the full id, and the
+	// namespace it's then in.
+	ns := ""
+	qualify := func(id, idNs string) (string, string) {
+		if ns == "" || idNs != "" {
+			return id, idNs
 		}
-		if n.declPos == nil {
+		return ns + "." + id, ns
+	}
+
+	// Templates are expanded as they're used; while expanding one, sc.params
+	// holds the args given for its params.
+	templates := map[string]*ast.Template{}
+	var expanding []*ast.Template
+	sc := &scope{lets: map[string]string{}, resolver: opts.resolver}
+
+	// Anonymous nodes are only given ids once everything's declared, so that
+	// they can't clash with explicit ids, even ones declared later; until
+	// then, they have placeholder ids, which aren't valid ids. See
+	// nameAnonNodes.
+	var anonNodes []*Node
+
+	upsertNodeFromAst := func(id, idNs string, pos *token.Pos, types []string, grp *Group) (*Node, error) {
+		var n *Node
+		if pos != nil && id == anonPlaceholder {
+			n, _ = g.upsertNode(qualify(fmt.Sprintf("%s%d", anonPlaceholder, len(anonNodes)), ""))
+			n.anonymous = true
+			anonNodes = append(anonNodes, n)
+		} else {
+			id, idNs := qualify(id, idNs)
+			n, _ = g.upsertNode(id, idNs)
+			// The same id can't be both a quoted one and a namespaced one;
+			// e.g. both "a.b" and a.b.
+			if n.ns != idNs {
+				return nil, fmt.Errorf("%w %q: it's already %s", ErrInvalidId, id, describeNamespace(n.ns))
+			}
+		}
+		if n.declPos == nil && pos != nil {
 			n.declPos = pos
+			n.declOrder = nextDeclOrder()
 		}
-		if n.typeFromPos == nil && typ != "" {
-			// ...then this is the decl that's first defining the type.
+		if n.typeFromPos == nil && len(types) > 0 {
+			// ...then this is the decl that's first defining a type.
 			n.typeFromPos = pos
 		}
+		for _, typ := range types {
+			n.AddType(typ)
+		}
+		if grp != nil {
+			grp.AddNode(n)
+		}
 		return n, nil
 	}
 
-	for _, rawItem := range astGraph.AstItems {
-		switch item := rawItem.(type) {
-
-		case *ast.Node:
-			n, err := upsertNodeFromAst(item.Id, &item.Pos, item.Type)
-			if err != nil {
-				if errors.Is(err, ErrTypeChange) {
-					err = fmt.Errorf(
-						"%w: attempted re-declaration to '%s' at %s",
-						err,
-						item.Type,
-						item.Pos,
-					)
-				}
-				return nil, err
+	// declareNodeFromAst handles node decls; either standalone ones, or ones
+	// made inline in edge chains.
+	declareNodeFromAst := func(decl *ast.Node, grp *Group) (*Node, error) {
+		id := decl.Id
+		if decl.Anonymous {
+			id = anonPlaceholder
+		}
+		n, err := upsertNodeFromAst(id, decl.Namespace, &decl.Pos, decl.Types, grp)
+		if err != nil {
+			return nil, fmt.Errorf("%w (at %s)", err, decl.Pos)
+		}
+		if decl.Doc != "" {
+			n.doc = decl.Doc
+		}
+		if err := updateAttrs(&n.attrSet, decl.Attrs, sc); err != nil {
+			if errors.Is(err, ErrTypeInAttrs) {
+				return nil, fmt.Errorf("%w; consider using a node type decl", err)
 			}
-			if err := updateAttrs(&n.common, item.Attrs); err != nil {
-				if errors.Is(err, ErrTypeInAttrs) {
-					return nil, fmt.Errorf("%w; consider using a node type decl", err)
+			return nil, err
+		}
+		return n, nil
+	}
+
+	upsertEdgeEndFromAst := func(ends []ast.EdgeEnd, grp *Group) ([]endpoint, error) {
+		endpoints := make([]endpoint, 0, len(ends))
+		for _, end := range ends {
+			var n *Node
+			var err error
+			if end.Decl != nil {
+				n, err = declareNodeFromAst(end.Decl, grp)
+			} else {
+				if n, err = upsertNodeFromAst(end.Id, end.Namespace, nil, nil, grp); err != nil {
+					err = fmt.Errorf("%w (at %s)", err, end.Pos)
 				}
-				return nil, err
 			}
-
-		case *ast.EdgeChain:
-			from, err := upsertNodeFromAst(item.From, nil, "")
 			if err != nil {
 				return nil, err
 			}
-			for _, step := range item.Steps {
-				to, err := upsertNodeFromAst(step.To, nil, "")
+			endpoints = append(endpoints, endpoint{node: n, port: end.Port})
+		}
+		return endpoints, nil
+	}
+
+	loadInclude := func(item *ast.Include) (*ast.Graph, string, error) {
+		from, ok := sourcePath(item.Pos)
+		if inc == nil || !ok {
+			return nil, "", fmt.Errorf(
+				"%w: include of '%s' at %s can only be resolved when parsing from a file",
+				ErrInclude,
+				item.Path,
+				item.Pos,
+			)
+		}
+		path := inc.resolve(from, item.Path)
+		if slices.Contains(inc.stack, path) {
+			return nil, "", fmt.Errorf(
+				"%w: '%s' included at %s is already being included, via: %s",
+				ErrIncludeCycle,
+				path,
+				item.Pos,
+				strings.Join(inc.stack, " -> "),
+			)
+		}
+		src, err := inc.readFile(path)
+		if err != nil {
+			return nil, "", fmt.Errorf("%w: '%s' at %s: %w", ErrInclude, item.Path, item.Pos, err)
+		}
+		incAst, err := parseAst(src, path, opts.astSettings())
+		return incAst, path, err
+	}
+
+	var addItems func(items []ast.TopLevel, grp *Group) error
+	addItems = func(items []ast.TopLevel, grp *Group) error {
+		for _, rawItem := range items {
+			switch item := rawItem.(type) {
+
+			case *ast.Node:
+				if _, err := declareNodeFromAst(item, grp); err != nil {
+					return err
+				}
+
+			case *ast.EdgeChain:
+				froms, err := upsertEdgeEndFromAst(item.From, grp)
 				if err != nil {
-					return nil, err
+					return err
+				}
+				for _, step := range item.Steps {
+					tos, err := upsertEdgeEndFromAst(step.To, grp)
+					if err != nil {
+						return err
+					}
+					// Sets of ids at either end expand to one edge per pair.
+					for _, from := range froms {
+						for _, to := range tos {
+							from, to, spec := astEdgeSpec(step, from, to)
+							e, existed, err := g.AddEdgeSpec(from.node, to.node, spec)
+							if err != nil {
+								if errors.Is(err, ErrLoop) {
+									err = fmt.Errorf(
+										"%w: edge at %s forms a loop from '%s' to itself",
+										ErrLoop,
+										step.Pos,
+										from.node.Id(),
+									)
+								}
+								return err
+							}
+							if !existed {
+								e.pos = &step.Pos
+								e.declOrder = nextDeclOrder()
+							}
+							if grp != nil {
+								grp.AddEdge(e)
+							}
+							if step.Doc != "" {
+								e.doc = step.Doc
+							}
+							if err := updateAttrs(&e.attrSet, step.Attrs, sc); err != nil {
+								if errors.Is(err, ErrTypeInAttrs) {
+									return fmt.Errorf("%w; consider using an edge type decl", err)
+								}
+								return err
+							}
+						}
+					}
+					froms = tos
 				}
-				e, existed, err := g.AddEdge(from, to, step.Type)
+
+			case *ast.Group:
+				id, _ := qualify(item.Id, item.Namespace)
+				gr, _, err := g.AddGroup(grp, id, item.Type)
 				if err != nil {
-					if errors.Is(err, ErrLoop) {
+					if errors.Is(err, ErrTypeChange) {
 						err = fmt.Errorf(
-							"%w: edge at %s forms a loop from '%s' to itself",
-							ErrLoop,
-							step.Pos,
-							from.Id(),
+							"%w: attempted re-declaration to '%s' at %s",
+							err,
+							item.Type,
+							item.Pos,
 						)
+					} else if errors.Is(err, ErrParentChange) {
+						err = fmt.Errorf("%w: attempted re-declaration at %s", err, item.Pos)
 					}
-					return nil, err
+					return err
 				}
-				if !existed {
-					e.pos = &step.Pos
+				if gr.declPos == nil {
+					gr.declPos = &item.Pos
+					gr.declOrder = nextDeclOrder()
 				}
-				if err := updateAttrs(&e.common, step.Attrs); err != nil {
+				if err := updateAttrs(&gr.attrSet, item.Attrs, sc); err != nil {
 					if errors.Is(err, ErrTypeInAttrs) {
-						return nil, fmt.Errorf("%w; consider using an edge type decl", err)
+						return fmt.Errorf("%w; consider using a group type decl", err)
+					}
+					return err
+				}
+				if err := addItems(item.Items, gr); err != nil {
+					return err
+				}
+
+			case *ast.Deletion:
+				if err := g.deleteFromAst(item, qualify); err != nil {
+					return err
+				}
+
+			case *ast.Namespace:
+				if needsQuotes(item.Name) {
+					return fmt.Errorf("%w %q for namespace at %s", ErrInvalidId, item.Name, item.Pos)
+				}
+				outer := ns
+				ns, _ = qualify(item.Name, explicitNamespace(item.Name))
+				if err := addItems(item.Items, grp); err != nil {
+					return err
+				}
+				ns = outer
+
+			case *ast.Template:
+				if other, ok := templates[item.Name]; ok && other != item {
+					return fmt.Errorf(
+						"%w: template '%s' redeclared at %s; first declared at %s",
+						ErrTemplate,
+						item.Name,
+						item.Pos,
+						other.Pos,
+					)
+				}
+				for i, param := range item.Params {
+					if slices.Contains(item.Params[:i], param) {
+						return fmt.Errorf("%w: template '%s' has param '%s' twice (at %s)", ErrTemplate, item.Name, param, item.Pos)
+					}
+				}
+				templates[item.Name] = item
+
+			case *ast.TemplateUse:
+				tmpl, ok := templates[item.Template]
+				if !ok {
+					return fmt.Errorf("%w: no template '%s' declared before use at %s", ErrTemplate, item.Template, item.Pos)
+				}
+				if slices.Contains(expanding, tmpl) {
+					return fmt.Errorf("%w: template '%s' uses itself (at %s)", ErrTemplate, tmpl.Name, item.Pos)
+				}
+				args, err := templateArgs(tmpl, item, sc)
+				if err != nil {
+					return err
+				}
+				outerNs, outerParams, outerLets := ns, sc.params, sc.lets
+				if item.Namespace != "" {
+					if needsQuotes(item.Namespace) {
+						return fmt.Errorf("%w %q for namespace at %s", ErrInvalidId, item.Namespace, item.Pos)
 					}
-					return nil, err
+					ns, _ = qualify(item.Namespace, explicitNamespace(item.Namespace))
 				}
-				from = to
+				// Variables declared within the template's body are local to
+				// this expansion of it.
+				sc.params, sc.lets = args, maps.Clone(sc.lets)
+				expanding = append(expanding, tmpl)
+				// Positions within the expansion are those in the template's
+				// body, so errors also need to say where it was used.
+				if err := addItems(tmpl.Items, grp); err != nil {
+					return fmt.Errorf("%w (in template '%s' used at %s)", err, tmpl.Name, item.Pos)
+				}
+				expanding = expanding[:len(expanding)-1]
+				ns, sc.params, sc.lets = outerNs, outerParams, outerLets
+
+			case *ast.Let:
+				val, err := sc.bind(item.Value)
+				if err != nil {
+					return fmt.Errorf("%w (at %s)", err, item.Pos)
+				}
+				// Variables are interpolated into strings, so can't be lists;
+				// but a param they're bound to could be.
+				if val.Kind == ast.ListValue {
+					return fmt.Errorf("%w: variable '%s' can't be a list (at %s)", ErrBadValue, item.Name, item.Pos)
+				}
+				sc.lets[item.Name] = val.Value
+
+			case *ast.GraphAttrs:
+				if err := updateAttrs(&g.attrSet, item.Attrs, sc); err != nil {
+					return err
+				}
+
+			case *ast.Defaults:
+				d := g.upsertDefaults(item.Type, item.ForEdges)
+				if err := updateAttrs(&d.attrSet, item.Attrs, sc); err != nil {
+					return err
+				}
+
+			case *ast.Include:
+				incAst, path, err := loadInclude(item)
+				if err != nil {
+					return err
+				}
+				inc.stack = append(inc.stack, path)
+				if err := addItems(incAst.AstItems, grp); err != nil {
+					return err
+				}
+				inc.stack = inc.stack[:len(inc.stack)-1]
 			}
 		}
+		return nil
 	}
 
+	if err := addItems(astGraph.AstItems, nil); err != nil {
+		return nil, err
+	}
+	g.nameAnonNodes(anonNodes)
+	// Done after everything else, so that defaults apply regardless of where
+	// they're declared.
+	g.applyDefaults()
 	return g, nil
 }
 
-func updateAttrs(obj *common, astAttrs ast.Attrs) error {
+// anonPlaceholder starts the ids of anonymous nodes while a graph's being built.
+// As a control char, it can't be part of any valid id.
+const anonPlaceholder = "\x00"
+
+// nameAnonNodes gives anonymous nodes their ids, numbered in order of
+// declaration, so that they're the same each time a file is parsed; e.g. `_1`,
+// or `payments._1` within a namespace. Ids that are already taken are skipped.
+func (g *Lilgraph) nameAnonNodes(nodes []*Node) {
+	count := 0
+	for _, n := range nodes {
+		if g.nodesById[n.id] != n {
+			continue
+		}
+		delete(g.nodesById, n.id)
+		for {
+			count++
+			n.id = fmt.Sprintf("_%d", count)
+			if n.ns != "" {
+				n.id = n.ns + "." + n.id
+			}
+			if g.nodesById[n.id] == nil {
+				break
+			}
+		}
+		g.nodesById[n.id] = n
+	}
+	// Non-directed edges' identities depend on the order of their nodes' ids.
+	clear(g.edgesById)
+	for _, e := range g.edges {
+		g.edgesById[e.identity()] = e
+	}
+}
+
+// endpoint is a node at one end of an edge, and the port used on it, if any.
+type endpoint struct {
+	node *Node
+	port string
+}
+
+// astEdgeSpec gives the spec of the edge that an edge step declares between
+// two endpoints, along with the endpoints in from/to order; i.e. swapped, if
+// the step is written backwards.
+func astEdgeSpec(step *ast.EdgeStep, from, to endpoint) (endpoint, endpoint, EdgeSpec) {
+	if step.Dir == ast.Backward {
+		from, to = to, from
+	}
+	return from, to, EdgeSpec{
+		Type:     step.Type,
+		Dir:      astDirections[step.Dir],
+		FromPort: from.port,
+		ToPort:   to.port,
+		Key:      step.Key,
+	}
+}
+
+// deleteFromAst applies a deletion statement to the graph built so far, with
+// ids qualified by the namespace it appears in.
+func (g *Lilgraph) deleteFromAst(del *ast.Deletion, qualify func(id, idNs string) (string, string)) error {
+	if del.Edge == nil {
+		id, _ := qualify(del.Node, del.Namespace)
+		n := g.Find(id)
+		if n == nil {
+			return fmt.Errorf("%w: no node '%s' to delete (at %s)", ErrDelete, id, del.Pos)
+		}
+		g.DeleteNode(n)
+		return nil
+	}
+
+	findEdgeEnds := func(ends []ast.EdgeEnd) ([]endpoint, error) {
+		endpoints := make([]endpoint, 0, len(ends))
+		for _, end := range ends {
+			if end.Decl != nil {
+				return nil, fmt.Errorf(
+					"%w: node '%s' can't be declared in a deletion (at %s)",
+					ErrDelete,
+					end.Id,
+					end.Decl.Pos,
+				)
+			}
+			id, _ := qualify(end.Id, end.Namespace)
+			n := g.Find(id)
+			if n == nil {
+				return nil, fmt.Errorf("%w: no node '%s' to delete edges of (at %s)", ErrDelete, id, del.Pos)
+			}
+			endpoints = append(endpoints, endpoint{node: n, port: end.Port})
+		}
+		return endpoints, nil
+	}
+
+	froms, err := findEdgeEnds(del.Edge.From)
+	if err != nil {
+		return err
+	}
+	for _, step := range del.Edge.Steps {
+		if len(step.Attrs) > 0 {
+			return fmt.Errorf("%w: edge attrs can't be given in a deletion (at %s)", ErrDelete, step.Pos)
+		}
+		tos, err := findEdgeEnds(step.To)
+		if err != nil {
+			return err
+		}
+		for _, from := range froms {
+			for _, to := range tos {
+				from, to, spec := astEdgeSpec(step, from, to)
+				e, ok := g.FindEdgeSpec(from.node, to.node, spec)
+				if !ok {
+					return fmt.Errorf(
+						"%w: no matching edge from '%s' to '%s' to delete (at %s)",
+						ErrDelete,
+						from.node.Id(),
+						to.node.Id(),
+						step.Pos,
+					)
+				}
+				g.DeleteEdge(e)
+			}
+		}
+		froms = tos
+	}
+	return nil
+}
+
+var astDirections = map[ast.Direction]Direction{
+	ast.Forward:       Directed,
+	ast.Undirected:    Undirected,
+	ast.Bidirectional: Bidirectional,
+	ast.Backward:      Directed, // with endpoints swapped
+}
+
+// scope holds what attr values can refer to: params of the template being
+// expanded, if any, and variables.
+type scope struct {
+	params   map[string]ast.AttrVal
+	lets     map[string]string
+	resolver Resolver
+}
+
+// lookup gives the value of a variable, as declared with @let, or failing that
+// as given by the resolver.
+func (sc *scope) lookup(name string) (string, error) {
+	if val, ok := sc.lets[name]; ok {
+		return val, nil
+	}
+	if sc.resolver != nil {
+		if val, ok := sc.resolver(name); ok {
+			return val, nil
+		}
+	}
+	return "", fmt.Errorf("%w '${%s}'", ErrUnresolved, name)
+}
+
+// templateArgs pairs up a template's params with the args given where it's
+// used. Args can themselves refer to params of an enclosing template.
+func templateArgs(tmpl *ast.Template, use *ast.TemplateUse, outer *scope) (map[string]ast.AttrVal, error) {
+	args := make(map[string]ast.AttrVal, len(use.Args))
+	for _, arg := range use.Args {
+		if arg.Unset {
+			return nil, fmt.Errorf("%w: template args can't be unset (at %s)", ErrTemplate, arg.Pos)
+		}
+		if !slices.Contains(tmpl.Params, arg.Key) {
+			return nil, fmt.Errorf("%w: template '%s' has no param '%s' (at %s)", ErrTemplate, tmpl.Name, arg.Key, arg.Pos)
+		}
+		val, err := outer.bind(ast.AttrVal{Value: arg.Value, Kind: arg.Kind, List: arg.List})
+		if err != nil {
+			return nil, fmt.Errorf("%w (at %s)", err, arg.Pos)
+		}
+		args[arg.Key] = val
+	}
+	for _, param := range tmpl.Params {
+		if _, ok := args[param]; !ok {
+			return nil, fmt.Errorf(
+				"%w: no arg given for param '%s' of template '%s' (at %s)",
+				ErrTemplate,
+				param,
+				tmpl.Name,
+				use.Pos,
+			)
+		}
+	}
+	return args, nil
+}
+
+// bind resolves what an attr value refers to: it substitutes the args for any
+// template params, and interpolates variables into strings.
+func (sc *scope) bind(val ast.AttrVal) (ast.AttrVal, error) {
+	switch val.Kind {
+	case ast.ParamValue:
+		arg, ok := sc.params[val.Value]
+		if !ok {
+			return val, fmt.Errorf("%w: unknown param '$%s'", ErrTemplate, val.Value)
+		}
+		return arg, nil
+	case ast.InterpolatedValue:
+		s, err := ast.Interpolate(val.Value, sc.lookup)
+		if err != nil {
+			return val, err
+		}
+		return ast.AttrVal{Value: s, Kind: ast.StringValue}, nil
+	case ast.ListValue:
+		items := make([]ast.AttrVal, 0, len(val.List))
+		for _, item := range val.List {
+			item, err := sc.bind(item)
+			if err != nil {
+				return val, err
+			}
+			if item.Kind == ast.ListValue {
+				return val, fmt.Errorf("%w: list values can't be nested", ErrTemplate)
+			}
+			items = append(items, item)
+		}
+		val.List = items
+	}
+	return val, nil
+}
+
+func updateAttrs(obj *attrSet, astAttrs ast.Attrs, sc *scope) error {
 	for _, astAttr := range astAttrs {
-		if err := obj.SetAttr(astAttr.Key, astAttr.Value); err != nil {
+		if astAttr.Unset {
+			obj.DeleteAttr(astAttr.Key)
+			obj.markUnset(astAttr.Key)
+			continue
+		}
+		val, err := sc.bind(ast.AttrVal{Value: astAttr.Value, Kind: astAttr.Kind, List: astAttr.List})
+		if err != nil {
+			return fmt.Errorf("%w (at %s)", err, astAttr.Pos)
+		}
+		if val.Kind == ast.ListValue {
+			items := make([]scalar, 0, len(val.List))
+			for _, item := range val.List {
+				items = append(items, scalar{value: item.Value, kind: astValueKinds[item.Kind]})
+			}
+			err = obj.setList(astAttr.Key, items)
+		} else {
+			err = obj.setAttr(astAttr.Key, val.Value, astValueKinds[val.Kind])
+		}
+		if err != nil {
 			if errors.Is(err, ErrTypeInAttrs) {
 				return fmt.Errorf("%w (at %s)", err, astAttr.Pos)
 			}
@@ -423,6 +1493,9 @@ func updateAttrs(obj *common, astAttrs ast.Attrs) error {
 	return nil
 }
 
+// lexicalTopoSort orders nodes by rank, i.e. their depth along the longest
+// path of directed edges leading to them. Non-directed edges and loops don't
+// imply any ordering, so are ignored.
 func lexicalTopoSort(nodes []*Node) error {
 	if len(nodes) == 0 {
 		return nil
@@ -442,6 +1515,9 @@ func lexicalTopoSort(nodes []*Node) error {
 		path[n] = true
 		ranks[n] = max(ranks[n], currRank)
 		for _, e := range n.edgesFrom {
+			if !impliesOrder(e) {
+				continue
+			}
 			if err := walkDf(s, e.to, currRank+1, path); err != nil {
 				return err
 			}
@@ -452,7 +1528,7 @@ func lexicalTopoSort(nodes []*Node) error {
 	seenApex := false
 	for _, n := range nodes {
 		// Only walk from apex nodes
-		if len(n.edgesTo) > 0 {
+		if slices.ContainsFunc(n.edgesTo, impliesOrder) {
 			continue
 		}
 		seenApex = true
@@ -468,3 +1544,7 @@ func lexicalTopoSort(nodes []*Node) error {
 	})
 	return nil
 }
+
+func impliesOrder(e *Edge) bool {
+	return e.dir == Directed && e.from != e.to
+}
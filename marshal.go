@@ -5,104 +5,595 @@ import (
 	"fmt"
 	"slices"
 	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/orls/lilgraph/internal/ast"
 )
 
 func marshalText(g *Lilgraph) ([]byte, error) {
 	// TODO: take various options for formatting, e.g.:
-	// const indent = "    "
 	// const wrapAt = 100
 
-	items := inPrintOrder(g)
-	var out strings.Builder
+	out := textWriter{version: minVersion(g, g.legacyEscapes)}
+	// Legacy escapes are version 1's rules; later versions can only be
+	// written with the current ones.
+	out.legacyEscapes = g.legacyEscapes && out.version == 1
+	out.anonNodes, out.anonChains = anonLayout(g)
+	// Content that version 1 supports means the same without a pragma, so
+	// leave it out; that way, older parsers can still read it. The exceptions
+	// are backslashes in strings, which only mean the same with version 1's
+	// escaping, and ids that have since become keywords.
+	if out.version > 1 || (out.legacyEscapes && hasBackslashes(g)) || hasKeywordIds(g) {
+		fmt.Fprintf(&out, "lilgraph %d\n", out.version)
+	}
+	if writeTypeAndAttrList(&out, "", g.attrs, nil, "@graph ") {
+		out.WriteString("\n")
+	}
+	for _, d := range g.defaults {
+		if d.forEdges {
+			out.WriteString("@edge_defaults ")
+		} else {
+			out.WriteString("@defaults ")
+		}
+		out.WriteString(d.typ)
+		out.WriteString(" ")
+		writeTypeAndAttrList(&out, "", d.attrs, nil, "")
+		out.WriteString("\n")
+	}
+	writeScope(&out, g, nil, 0)
+	return []byte(out.String()), nil
+}
+
+const indent = "    "
 
-	for _, item := range items {
+// textWriter accumulates marshalled text, along with the settings for how to
+// write it.
+type textWriter struct {
+	strings.Builder
+
+	legacyEscapes bool
+
+	// version is the language version being written; see minVersion.
+	version int
+
+	// The anonymous nodes that can be written as such, and the chains of
+	// edges to write them in; see anonLayout.
+	anonNodes  map[*Node]bool
+	anonChains map[*Edge]*anonChain
+
+	// prefix is the indentation of the item currently being written.
+	prefix string
+}
+
+// writeScope renders everything that lives directly in the given scope: the
+// top-level of the doc when scope is nil, or the body of a group block.
+func writeScope(out *textWriter, g *Lilgraph, scope *Group, depth int) {
+	prefix := strings.Repeat(indent, depth)
+	for _, item := range inPrintOrder(out, g, scope) {
+		out.prefix = prefix
+		out.WriteString(prefix)
 		switch v := item.item.(type) {
 		case *Node:
-			out.WriteString(v.id)
-			writeTypeAndAttrList(&out, v.typ, v.attrs, " ")
+			if !item.bare {
+				writeDoc(out, v.doc, out.prefix)
+			}
+			if out.anonNodes[v] {
+				writeAnonNode(out, v)
+				break
+			}
+			out.WriteString(out.formatNodeId(v))
+			if !item.bare {
+				writeTypeAndAttrList(out, strings.Join(v.types, ", "), v.attrs, v.unset, " ")
+			}
+		case *anonChain:
+			writeChain(out, v)
 		case *Edge:
-			out.WriteString(v.from.id)
-			out.WriteString(" -")
-			didAttrs := writeTypeAndAttrList(&out, v.typ, v.attrs, "")
-			if didAttrs {
-				out.WriteString("-")
-			}
-			out.WriteString("> ")
-			out.WriteString(v.to.id)
+			if !item.bare {
+				writeDoc(out, v.doc, out.prefix)
+			}
+			writeEdge(out, v, !item.bare)
+		case *Group:
+			out.WriteString("subgraph ")
+			out.WriteString(out.formatId(v.id))
+			writeTypeAndAttrList(out, v.typ, v.attrs, nil, " ")
+			out.WriteString(" {")
+			if len(v.nodes) > 0 || len(v.edges) > 0 || len(v.groups) > 0 {
+				out.WriteString("\n")
+				writeScope(out, g, v, depth+1)
+				out.WriteString(prefix)
+			}
+			out.WriteString("}")
 		default:
 			panic(fmt.Sprintf("unexpected item type %T in toPlaintext", v))
 		}
 		out.WriteString("\n")
 	}
-	return []byte(out.String()), nil
+}
+
+// writeDoc writes a doc comment, as `///` lines, leaving the next line
+// indented by prefix, ready for the item it documents.
+func writeDoc(out *textWriter, doc, prefix string) {
+	if doc == "" {
+		return
+	}
+	for _, line := range strings.Split(doc, "\n") {
+		out.WriteString(strings.TrimRight("/// "+line, " "))
+		out.WriteString("\n" + prefix)
+	}
+}
+
+func writeEdge(out *textWriter, e *Edge, withAttrs bool) {
+	out.WriteString(out.formatEdgeEnd(e.from, e.fromPort))
+	out.WriteString(" ")
+	writeEdgeStep(out, e, withAttrs, false)
+	out.WriteString(" ")
+	out.WriteString(out.formatEdgeEnd(e.to, e.toPort))
+}
+
+// writeEdgeStep writes the arrow of an edge, with its type and attrs. For use
+// in chains, directed edges can be written backwards, e.g. `<-[type]-`.
+func writeEdgeStep(out *textWriter, e *Edge, withAttrs bool, backwards bool) {
+	backwards = backwards && e.dir == Directed
+	if e.dir == Bidirectional || backwards {
+		out.WriteString("<")
+	}
+	out.WriteString("-")
+	typ := e.typ
+	if e.key != "" {
+		typ += "#" + e.key
+	}
+	var attrs []attr
+	var unset []string
+	if withAttrs {
+		attrs, unset = e.attrs, e.unset
+	}
+	didAttrs := writeTypeAndAttrList(out, typ, attrs, unset, "")
+	if didAttrs || e.dir == Undirected {
+		out.WriteString("-")
+	}
+	if e.dir != Undirected && !backwards {
+		out.WriteString(">")
+	}
+}
+
+func writeAnonNode(out *textWriter, n *Node) {
+	out.WriteString("_")
+	writeTypeAndAttrList(out, strings.Join(n.types, ", "), n.attrs, n.unset, "")
+}
+
+// writeChain writes a chain of edges, with any anonymous nodes in it declared
+// inline. Doc comments within the chain go on their own lines, before the step
+// or node they document.
+func writeChain(out *textWriter, c *anonChain) {
+	// A doc before the chain documents its first step; it can only be the
+	// first node's if the step's own doc goes directly before it. See
+	// anonLayout.
+	firstNodeDoc := c.anonEnd(out, 0) && c.ends[0][0].doc != ""
+	if firstNodeDoc {
+		writeDoc(out, c.ends[0][0].doc, out.prefix)
+	} else {
+		writeDoc(out, c.steps[0][0].doc, out.prefix)
+	}
+	// Lines after the first are continuations, so indented further.
+	cont := out.prefix + indent
+	for i, end := range c.ends {
+		step := c.steps[max(i-1, 0)]
+		if i > 0 {
+			e := step[0]
+			if e.doc != "" && (i > 1 || firstNodeDoc) {
+				out.WriteString("\n" + cont)
+				writeDoc(out, e.doc, cont)
+			} else {
+				out.WriteString(" ")
+			}
+			writeEdgeStep(out, e, true, !slices.Contains(c.ends[i-1], e.from))
+			if c.anonEnd(out, i) && end[0].doc != "" {
+				out.WriteString("\n" + cont)
+				writeDoc(out, end[0].doc, cont)
+			} else {
+				out.WriteString(" ")
+			}
+		}
+		if c.anonEnd(out, i) {
+			writeAnonNode(out, end[0])
+			continue
+		}
+		// Only the chain's ends can be nodes that have ids; those are attached
+		// to a single edge each, in the step next to them.
+		if len(end) > 1 {
+			out.WriteString("{")
+		}
+		for j, n := range end {
+			if j > 0 {
+				out.WriteString(", ")
+			}
+			if e := step[j]; e.from == n {
+				out.WriteString(out.formatEdgeEnd(n, e.fromPort))
+			} else {
+				out.WriteString(out.formatEdgeEnd(n, e.toPort))
+			}
+		}
+		if len(end) > 1 {
+			out.WriteString("}")
+		}
+	}
 }
 
 type toplevelitem struct {
-	item   any
-	offset int
+	item  any
+	order int
+
+	// For nodes & edges that are members of several groups, their full
+	// declaration is written only in the first of them; in any others, we
+	// write a bare form that only re-establishes membership.
+	bare bool
 }
 
-// Order the graph content by original parse positions, where possible. Put
-// newly-added items at the end -- nodes first, then edges.
-func inPrintOrder(g *Lilgraph) []toplevelitem {
-	items := make([]toplevelitem, 0, len(g.nodes)+len(g.edges))
+// Order the content of a scope by original declaration order, where possible.
+// Put newly-added items at the end -- nodes first, then edges, then groups.
+func inPrintOrder(out *textWriter, g *Lilgraph, scope *Group) []toplevelitem {
+	var nodes []*Node
+	var edges []*Edge
+	var groups []*Group
+	if scope == nil {
+		for _, n := range g.nodes {
+			if len(n.groups) == 0 {
+				nodes = append(nodes, n)
+			}
+		}
+		for _, e := range g.edges {
+			if len(e.groups) == 0 {
+				edges = append(edges, e)
+			}
+		}
+		for _, gr := range g.groups {
+			if gr.parent == nil {
+				groups = append(groups, gr)
+			}
+		}
+	} else {
+		nodes, edges, groups = scope.nodes, scope.edges, scope.groups
+	}
 
-	for _, n := range g.nodes {
-		if len(n.attrs) == 0 && n.typ == "" && n.declPos == nil && (len(n.edgesFrom) > 0 || len(n.edgesTo) > 0) {
-			// Doesn't need an explicit node def (because no type and no attrs);
+	items := make([]toplevelitem, 0, len(nodes)+len(edges)+len(groups))
+
+	for _, n := range nodes {
+		if out.anonNodes[n] && len(n.edgesFrom)+len(n.edgesTo) > 0 {
+			// Declared inline in its chain instead.
+			continue
+		}
+		bare := len(n.groups) > 0 && n.groups[0] != scope
+		if (bare || (len(explicitAttrs(n.attrs)) == 0 && len(n.unset) == 0 && len(n.types) == 0 && n.doc == "" && n.declPos == nil)) && inAnyEdge(n, edges) {
+			// Doesn't need an explicit node def (because no type and no attrs,
+			// or those are written elsewhere);
 			// Didn't appear in original source (because no declPos);
-			// Is referred to in at least one edge;
+			// Is referred to in at least one edge in this scope;
 			// = Don't bother rendering it at all, there's no value to having a
 			// declaration in the file.
 			continue
 		}
-		offset := -1
+		order := -1
 		if n.declPos != nil {
-			offset = n.declPos.Offset
+			order = n.declOrder
 		}
-		items = append(items, toplevelitem{item: n, offset: offset})
+		items = append(items, toplevelitem{item: n, order: order, bare: bare})
 	}
-	for _, e := range g.edges {
-		offset := -2
+	seenChains := map[*anonChain]bool{}
+	for _, e := range edges {
+		if c := out.anonChains[e]; c != nil {
+			if !seenChains[c] {
+				seenChains[c] = true
+				items = append(items, toplevelitem{item: c, order: c.order()})
+			}
+			continue
+		}
+		order := -2
 		if e.pos != nil {
-			offset = e.pos.Offset
+			order = e.declOrder
+		}
+		bare := len(e.groups) > 0 && e.groups[0] != scope
+		items = append(items, toplevelitem{item: e, order: order, bare: bare})
+	}
+	for _, gr := range groups {
+		order := -3
+		if gr.declPos != nil {
+			order = gr.declOrder
 		}
-		items = append(items, toplevelitem{item: e, offset: offset})
+		items = append(items, toplevelitem{item: gr, order: order})
 	}
 
 	slices.SortStableFunc(items, func(a, b toplevelitem) int {
-		if a.offset < 0 {
-			if b.offset < 0 {
+		if a.order < 0 {
+			if b.order < 0 {
 				// Both had no AST position. Sort such that -1 (nodes) come
-				// before -2 (edges).
-				return cmp.Compare(b.offset, a.offset)
+				// before -2 (edges) before -3 (groups).
+				return cmp.Compare(b.order, a.order)
 			}
 			// a had no AST position, b did. Put a after b
 			return 1
 		}
-		if b.offset < 0 {
+		if b.order < 0 {
 			// b had no AST position, a did. Put b after a
 			return -1
 		}
-		return cmp.Compare(a.offset, b.offset)
+		return cmp.Compare(a.order, b.order)
 	})
 	return items
 }
 
+// anonChain is a run of edges through anonymous nodes, written as a single
+// chain so that those nodes can be declared inline as `_`; e.g.
+// `src -> _[step; cmd="make"] -> dist`. Either end of it can be a set of nodes,
+// for an anonymous node's fan-out or fan-in; e.g. `hub -> _[x] -> {a, b}`.
+type anonChain struct {
+	// ends[i] and ends[i+1] are joined by the edges in steps[i], either way
+	// round. Where an end is a set, the step has one edge per node in it, in
+	// the same order.
+	ends  [][]*Node
+	steps [][]*Edge
+}
+
+// order places the chain as per its earliest-declared edge.
+func (c *anonChain) order() int {
+	order := -2
+	for _, step := range c.steps {
+		for _, e := range step {
+			if e.pos != nil && (order < 0 || e.declOrder < order) {
+				order = e.declOrder
+			}
+		}
+	}
+	return order
+}
+
+// anonEnd is whether the chain's i'th end is an anonymous node that's declared
+// inline.
+func (c *anonChain) anonEnd(out *textWriter, i int) bool {
+	return len(c.ends[i]) == 1 && out.anonNodes[c.ends[i][0]]
+}
+
+func (c *anonChain) contains(e *Edge) bool {
+	return slices.ContainsFunc(c.steps, func(step []*Edge) bool { return slices.Contains(step, e) })
+}
+
+// anonLayout works out which anonymous nodes can be written as such, and the
+// chains of edges to write them in. As each `_` is a new node, that's only
+// possible if all of a node's edges can go in one chain, with it appearing
+// once; i.e. if they make at most two steps (see anonSteps), that aren't
+// attached to a port on it and are in the same group as it, and it's not in a
+// cycle of anonymous nodes. Nor can it be in a namespace, as nothing's written
+// within namespace blocks. Any others are written by their generated ids.
+func anonLayout(g *Lilgraph) (map[*Node]bool, map[*Edge]*anonChain) {
+	anonNodes := map[*Node]bool{}
+	for _, n := range g.nodes {
+		if n.anonymous && inlineable(n) {
+			anonNodes[n] = true
+		}
+	}
+	for {
+		chains := map[*Edge]*anonChain{}
+		var unwritable *Node
+		for _, n := range g.nodes {
+			if !anonNodes[n] {
+				continue
+			}
+			for _, e := range nodeEdges(n) {
+				if chains[e] != nil {
+					continue
+				}
+				c := buildChain(e, anonNodes)
+				for _, step := range c.steps {
+					for _, ce := range step {
+						chains[ce] = c
+					}
+				}
+				for i, end := range c.ends {
+					if len(end) == 1 && anonNodes[end[0]] && slices.IndexFunc(c.ends, func(other []*Node) bool {
+						return len(other) == 1 && other[0] == end[0]
+					}) != i {
+						unwritable = end[0]
+					}
+				}
+				// A doc before the chain would document its first step, not
+				// its first node; unless the step has its own doc.
+				if end := c.ends[0]; len(end) == 1 && anonNodes[end[0]] && end[0].doc != "" && c.steps[0][0].doc == "" {
+					unwritable = end[0]
+				}
+			}
+		}
+		if unwritable == nil {
+			return anonNodes, chains
+		}
+		// Break the cycle, or find a place for the doc, by writing the node by
+		// id instead; and try again.
+		delete(anonNodes, unwritable)
+	}
+}
+
+func inlineable(n *Node) bool {
+	if len(n.groups) > 1 || len(anonSteps(n)) > 2 || n.Namespace() != "" {
+		return false
+	}
+	for _, e := range nodeEdges(n) {
+		if e.from == e.to || !slices.Equal(e.groups, n.groups) {
+			return false
+		}
+		if (e.from == n && e.fromPort != "") || (e.to == n && e.toPort != "") {
+			return false
+		}
+	}
+	return true
+}
+
+// anonSteps splits an anonymous node's edges into the steps of a chain that
+// they'd be written as. With more than two edges, ones that are otherwise the
+// same and go out to (or in from) nodes with ids can share a step, to a set of
+// those nodes.
+func anonSteps(n *Node) [][]*Edge {
+	edges := nodeEdges(n)
+	var steps [][]*Edge
+next:
+	for _, e := range edges {
+		if len(edges) > 2 {
+			for i, step := range steps {
+				if sameStep(step[0], e, n) {
+					steps[i] = append(step, e)
+					continue next
+				}
+			}
+		}
+		steps = append(steps, []*Edge{e})
+	}
+	return steps
+}
+
+// sameStep is whether two of an anonymous node's edges can be written as one
+// step of a chain, with their other ends in a set.
+func sameStep(a, b *Edge, n *Node) bool {
+	if otherEnd(a, n).anonymous || otherEnd(b, n).anonymous || (a.from == n) != (b.from == n) {
+		return false
+	}
+	return a.typ == b.typ && a.key == b.key && a.dir == b.dir && a.doc == b.doc &&
+		slices.EqualFunc(a.attrs, b.attrs, sameAttr) && slices.Equal(a.unset, b.unset)
+}
+
+// sameAttr compares attrs by their literal forms; a list's is its whole value.
+func sameAttr(a, b attr) bool {
+	return a.key == b.key && a.scalar == b.scalar && a.inherited == b.inherited
+}
+
+func nodeEdges(n *Node) []*Edge {
+	return slices.Concat(n.edgesFrom, n.edgesTo)
+}
+
+// buildChain extends an edge into a chain, in both directions, through any
+// anonymous nodes that are to be written inline.
+func buildChain(e *Edge, anonNodes map[*Node]bool) *anonChain {
+	c := &anonChain{}
+	if hub, step := setStep(e, anonNodes); hub == nil {
+		c.ends = [][]*Node{{e.from}, {e.to}}
+		c.steps = [][]*Edge{{e}}
+	} else if e.from == hub {
+		c.ends = [][]*Node{{hub}, otherEnds(step, hub)}
+		c.steps = [][]*Edge{step}
+	} else {
+		c.ends = [][]*Node{otherEnds(step, hub), {hub}}
+		c.steps = [][]*Edge{step}
+	}
+	for {
+		last := c.ends[len(c.ends)-1]
+		next := nextInChain(last, c, anonNodes)
+		if next == nil {
+			break
+		}
+		c.ends = append(c.ends, otherEnds(next, last[0]))
+		c.steps = append(c.steps, next)
+	}
+	for {
+		first := c.ends[0]
+		next := nextInChain(first, c, anonNodes)
+		if next == nil {
+			break
+		}
+		c.ends = slices.Insert(c.ends, 0, otherEnds(next, first[0]))
+		c.steps = slices.Insert(c.steps, 0, next)
+	}
+	// Which way round the chain was originally written isn't known; but going
+	// by declaration order is a good guess.
+	first, last := c.steps[0][0], c.steps[len(c.steps)-1][0]
+	if first.pos != nil && last.pos != nil && first.declOrder > last.declOrder {
+		slices.Reverse(c.ends)
+		slices.Reverse(c.steps)
+	}
+	return c
+}
+
+// setStep gives the step that an edge shares with others, to a set of nodes,
+// if any; along with the anonymous node at the other side of it.
+func setStep(e *Edge, anonNodes map[*Node]bool) (*Node, []*Edge) {
+	for _, n := range []*Node{e.from, e.to} {
+		if !anonNodes[n] {
+			continue
+		}
+		for _, step := range anonSteps(n) {
+			if len(step) > 1 && slices.Contains(step, e) {
+				return n, step
+			}
+		}
+	}
+	return nil, nil
+}
+
+// nextInChain gives the step that continues a chain on from one of its ends,
+// if that's an anonymous node with another step.
+func nextInChain(end []*Node, c *anonChain, anonNodes map[*Node]bool) []*Edge {
+	if len(end) > 1 || !anonNodes[end[0]] {
+		return nil
+	}
+	for _, step := range anonSteps(end[0]) {
+		if !c.contains(step[0]) {
+			return step
+		}
+	}
+	return nil
+}
+
+func otherEnd(e *Edge, n *Node) *Node {
+	if e.from == n {
+		return e.to
+	}
+	return e.from
+}
+
+func otherEnds(step []*Edge, n *Node) []*Node {
+	ends := make([]*Node, len(step))
+	for i, e := range step {
+		ends[i] = otherEnd(e, n)
+	}
+	return ends
+}
+
+func inAnyEdge(n *Node, edges []*Edge) bool {
+	for _, e := range edges {
+		if e.from == n || e.to == n {
+			return true
+		}
+	}
+	return false
+}
+
+// explicitAttrs filters out any attrs that were inherited from type-level
+// defaults; writing the defaults decl is enough to re-create them.
+func explicitAttrs(attrs []attr) []attr {
+	if !slices.ContainsFunc(attrs, func(a attr) bool { return a.inherited }) {
+		return attrs
+	}
+	return slices.DeleteFunc(slices.Clone(attrs), func(a attr) bool { return a.inherited })
+}
+
+// writeTypeAndAttrList writes a type and attr list, e.g. `[human; age=19]`.
+// Keys in unset are written as e.g. `!age`, so that defaults aren't inherited
+// for them.
+//
 // TODO: break at line length if needed, + indenting
-func writeTypeAndAttrList(out *strings.Builder, typ string, attrs []attr, prefix string) bool {
-	if typ == "" && len(attrs) == 0 {
+func writeTypeAndAttrList(out *textWriter, typ string, attrs []attr, unset []string, prefix string) bool {
+	attrs = explicitAttrs(attrs)
+	if typ == "" && len(attrs) == 0 && len(unset) == 0 {
 		return false
 	}
 	out.WriteString(prefix + "[" + typ)
-	if typ != "" && len(attrs) > 0 {
+	if typ != "" && len(attrs)+len(unset) > 0 {
 		out.WriteString("; ")
 	}
-	kvs := make([]string, 0, len(attrs))
+	kvs := make([]string, 0, len(unset)+len(attrs))
+	for _, key := range unset {
+		kvs = append(kvs, "!"+key)
+	}
 
 	// Add any remaining attrs that weren't in the original AST
 	for _, attr := range attrs {
-		kvs = append(kvs, fmt.Sprintf("%s=%s", attr.key, quoteify(attr.value)))
+		kvs = append(kvs, fmt.Sprintf("%s=%s", attr.key, out.formatValue(attr)))
 	}
 
 	// TODO: see if running sum of lens would exceed line len, if so, linebreaks + indent
@@ -112,10 +603,179 @@ func writeTypeAndAttrList(out *strings.Builder, typ string, attrs []attr, prefix
 	return true
 }
 
-func quoteify(val string) string {
-	if !strings.Contains(val, `"`) && !strings.Contains(val, "\n") {
-		return val
+// formatId writes a node, group or port id, only quoting it if need be.
+func (out *textWriter) formatId(id string) string {
+	if needsQuotes(id) {
+		return quoteify(id, out.legacyEscapes)
+	}
+	return id
+}
+
+// formatNodeId writes a node's id such that it's read back in the same
+// namespace; e.g. an id that has dots but no namespace is quoted, and one
+// whose local part needs quoting is written like `payments."eu-west-1"`.
+// Keywords only need quoting from version 2 on.
+func (out *textWriter) formatNodeId(n *Node) string {
+	local := n.LocalId()
+	if idRegexp.MatchString(local) && (n.ns != "" || out.version == 1 || !slices.Contains(keywords, local)) {
+		return n.id
 	}
-	val = strings.ReplaceAll(val, `"`, `\"`)
-	return `"` + val + `"`
+	if n.ns == "" {
+		return quoteify(n.id, out.legacyEscapes)
+	}
+	return n.ns + "." + quoteify(local, out.legacyEscapes)
+}
+
+func (out *textWriter) formatEdgeEnd(n *Node, port string) string {
+	if port == "" {
+		return out.formatNodeId(n)
+	}
+	return out.formatNodeId(n) + ":" + out.formatId(port)
+}
+
+// formatValue writes an attr value as the same kind of literal it was
+// originally declared as.
+func (out *textWriter) formatValue(a attr) string {
+	if a.kind == KindList {
+		return out.formatList(a.list)
+	}
+	return out.formatScalar(a.scalar)
+}
+
+func (out *textWriter) formatScalar(s scalar) string {
+	if s.kind != KindString {
+		return s.value
+	}
+	if raw, ok := out.rawString(s.value); ok {
+		return raw
+	}
+	// Quoted values are interpolated, so a literal `${` has to be escaped.
+	return quoteify(strings.ReplaceAll(s.value, "${", "$${"), out.legacyEscapes)
+}
+
+func (out *textWriter) formatList(items []scalar) string {
+	strs := make([]string, 0, len(items))
+	for _, item := range items {
+		strs = append(strs, out.formatScalar(item))
+	}
+	return "[" + strings.Join(strs, ", ") + "]"
+}
+
+// rawString writes a multi-line value as a raw string, with its lines indented
+// one level deeper than the current item. Not every value can be written this
+// way -- e.g. ones containing backticks, or whose lines all have some leading
+// whitespace that would get stripped -- so this reports whether it could.
+// Version 1 has no raw strings, but can still write the value quoted; so a
+// graph isn't written as a later version just for the sake of this.
+func (out *textWriter) rawString(val string) (string, bool) {
+	if out.version < 2 || !strings.Contains(val, "\n") || strings.ContainsFunc(val, notRawSafe) {
+		return "", false
+	}
+	var b strings.Builder
+	b.WriteString("`\n")
+	for _, line := range strings.Split(val, "\n") {
+		if line != "" {
+			b.WriteString(out.prefix + indent + line)
+		}
+		b.WriteString("\n")
+	}
+	b.WriteString(out.prefix + "`")
+	raw := b.String()
+	if ast.StripIndent(raw[1:len(raw)-1]) != val {
+		return "", false
+	}
+	return raw, true
+}
+
+func notRawSafe(r rune) bool {
+	return r == '`' || needsEscape(r)
+}
+
+// minVersion gives the oldest language version that can express everything in
+// the graph. With legacy escapes, quoted strings mean the same as in version 1,
+// so backslashes in them don't need a later version.
+func minVersion(g *Lilgraph, legacyEscapes bool) int {
+	if len(explicitAttrs(g.attrs)) > 0 || len(g.defaults) > 0 || len(g.groups) > 0 {
+		return 2
+	}
+	for _, n := range g.nodes {
+		if len(n.types) > 1 || n.anonymous || !v1Id(n.id) || !v1Attrs(n.attrs, legacyEscapes) {
+			return 2
+		}
+	}
+	for _, e := range g.edges {
+		if e.dir != Directed || e.fromPort != "" || e.toPort != "" || e.key != "" || !v1Attrs(e.attrs, legacyEscapes) {
+			return 2
+		}
+	}
+	return 1
+}
+
+// hasBackslashes reports whether any of the graph's string attr values contain
+// a backslash.
+func hasBackslashes(g *Lilgraph) bool {
+	hasOne := func(attrs []attr) bool {
+		return slices.ContainsFunc(explicitAttrs(attrs), func(a attr) bool {
+			return a.kind == KindString && strings.Contains(a.value, `\`)
+		})
+	}
+	for _, n := range g.nodes {
+		if hasOne(n.attrs) {
+			return true
+		}
+	}
+	for _, e := range g.edges {
+		if hasOne(e.attrs) {
+			return true
+		}
+	}
+	return false
+}
+
+// v1Id reports whether an id can be written in version 1, which has neither
+// quoted nor namespaced ids. Keywords are fine; they were plain ids then.
+func v1Id(id string) bool {
+	return idRegexp.MatchString(id)
+}
+
+// hasKeywordIds reports whether any of the graph's node ids are keywords.
+func hasKeywordIds(g *Lilgraph) bool {
+	return slices.ContainsFunc(g.nodes, func(n *Node) bool { return slices.Contains(keywords, n.id) })
+}
+
+// v1Attrs reports whether attrs can be written in version 1, which has no list
+// values or dotted keys, and where quoted strings have no escapes or
+// interpolation.
+func v1Attrs(attrs []attr, legacyEscapes bool) bool {
+	for _, a := range explicitAttrs(attrs) {
+		if a.kind == KindList || strings.Contains(a.key, ".") {
+			return false
+		}
+		if a.kind == KindString && !v1String(a.value, legacyEscapes) {
+			return false
+		}
+	}
+	return true
+}
+
+func v1String(s string, legacyEscapes bool) bool {
+	if !legacyEscapes && strings.Contains(s, `\`) {
+		return false
+	}
+	// Legacy escaping can't write a backslash that ends the value or comes
+	// before a double quote; see ast.Escape.
+	if strings.HasSuffix(s, `\`) || strings.Contains(s, `\"`) {
+		return false
+	}
+	return !strings.Contains(s, "${") && !strings.ContainsFunc(s, needsEscape)
+}
+
+// needsEscape reports whether a rune can only be written in a quoted string as
+// an escape sequence.
+func needsEscape(r rune) bool {
+	return r == utf8.RuneError || (unicode.IsControl(r) && r != '\n' && r != '\t')
+}
+
+func quoteify(val string, legacyEscapes bool) string {
+	return `"` + ast.Escape(val, legacyEscapes) + `"`
 }
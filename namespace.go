@@ -0,0 +1,83 @@
+package lilgraph
+
+import (
+	"fmt"
+	"iter"
+	"slices"
+	"strings"
+)
+
+// splitNamespace splits a bare, dotted id at its last dot, into its namespace
+// and its local part.
+func splitNamespace(id string) (ns, local string) {
+	i := strings.LastIndexByte(id, '.')
+	if i < 0 {
+		return "", id
+	}
+	return id[:i], id[i+1:]
+}
+
+// explicitNamespace gives the namespace a bare, dotted id is written in.
+func explicitNamespace(id string) string {
+	ns, _ := splitNamespace(id)
+	return ns
+}
+
+// describeNamespace says which namespace a node is in, for errors.
+func describeNamespace(ns string) string {
+	if ns == "" {
+		return "the id of a node in no namespace"
+	}
+	return fmt.Sprintf("the id of a node in namespace %q", ns)
+}
+
+// Namespace gives the namespace the node's in; e.g. "payments" for
+// "payments.api". It's empty if the node isn't namespaced, including when its
+// id was quoted, as in "api.example.com".
+func (n *Node) Namespace() string {
+	return n.ns
+}
+
+// LocalId gives the node's id without its namespace; e.g. "api" for
+// "payments.api".
+func (n *Node) LocalId() string {
+	if n.ns == "" {
+		return n.id
+	}
+	return n.id[len(n.ns)+1:]
+}
+
+// InNamespace reports whether the node is within ns, at any depth; e.g. node
+// "payments.eu.api" is within both "payments" and "payments.eu".
+func (n *Node) InNamespace(ns string) bool {
+	return ns != "" && (n.ns == ns || strings.HasPrefix(n.ns, ns+"."))
+}
+
+// Namespaces returns all the namespaces that nodes in the graph are in, sorted.
+// Enclosing namespaces are included too; e.g. given a node "payments.eu.api",
+// both "payments" and "payments.eu" are listed.
+func (g *Lilgraph) Namespaces() iter.Seq[string] {
+	var namespaces []string
+	for _, n := range g.nodes {
+		for ns := n.Namespace(); ns != ""; ns, _ = splitNamespace(ns) {
+			if slices.Contains(namespaces, ns) {
+				break
+			}
+			namespaces = append(namespaces, ns)
+		}
+	}
+	slices.Sort(namespaces)
+	return slices.Values(namespaces)
+}
+
+// NodesInNamespace returns the nodes within ns, at any depth, in the same
+// order as Nodes.
+func (g *Lilgraph) NodesInNamespace(ns string) iter.Seq[*Node] {
+	return func(yield func(*Node) bool) {
+		for _, n := range g.nodes {
+			if n.InNamespace(ns) && !yield(n) {
+				return
+			}
+		}
+	}
+}
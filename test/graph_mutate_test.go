@@ -1,7 +1,6 @@
 package test
 
 import (
-	"errors"
 	"slices"
 	"testing"
 
@@ -70,7 +69,7 @@ func TestGraphMutation(t *testing.T) {
 		return
 	}
 
-	// Update one node with a type value; check that it can't be updated again.
+	// Update one node with a type value; check that further types add to it.
 	if !t.Run("typed-node", func(t *testing.T) {
 
 		a, _, err := g.AddNode("A", "type1")
@@ -81,11 +80,14 @@ func TestGraphMutation(t *testing.T) {
 			t.Fatalf("expected type of node A to be 'type1' now, but got '%s'", a.Type())
 		}
 		_, _, err = g.AddNode("A", "type2")
-		if !errors.Is(err, lilgraph.ErrTypeChange) {
-			t.Fatalf("expected upserting a different type value to node A to fail with ErrTypeChange, but got err=%v", err)
+		if err != nil {
+			t.Fatalf("expected upserting a different type value to node A to succeed, but got err=%v", err)
 		}
-		if a.Type() != "type1" {
-			t.Fatalf("expected type of node A to still be 'type1', but got '%s'", a.Type())
+		if a.Type() != "type1" || !a.HasType("type2") {
+			t.Fatalf("expected node A to have types 'type1' and 'type2', but got %v", slices.Collect(a.Types()))
+		}
+		if !a.RemoveType("type2") || a.HasType("type2") {
+			t.Fatalf("expected removing type 'type2' from node A to succeed")
 		}
 		_, _, err = g.AddNode("A", "")
 		if err != nil {
@@ -6,7 +6,9 @@ import (
 	"fmt"
 	"io"
 	"io/fs"
+	"math"
 	"slices"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -88,6 +90,28 @@ func TestParserHappyPaths(t *testing.T) {
 		"happy/simple-edges.lilgraph":             "happy/simple-edges.expected-ast.json",
 		"dubious/simple-edges-multiline.lilgraph": "happy/simple-edges.expected-ast.json",
 		"happy/edge-attrs.lilgraph":               "happy/edge-attrs.expected-ast.json",
+		"happy/groups.lilgraph":                   "happy/groups.expected-ast.json",
+		"happy/edge-directions.lilgraph":          "happy/edge-directions.expected-ast.json",
+		"happy/edge-sets.lilgraph":                "happy/edge-sets.expected-ast.json",
+		"happy/attr-kinds.lilgraph":               "happy/attr-kinds.expected-ast.json",
+		"happy/attr-lists.lilgraph":               "happy/attr-lists.expected-ast.json",
+		"happy/defaults.lilgraph":                 "happy/defaults.expected-ast.json",
+		"happy/quoted-ids.lilgraph":               "happy/quoted-ids.expected-ast.json",
+		"happy/node-types.lilgraph":               "happy/node-types.expected-ast.json",
+		"happy/graph-attrs.lilgraph":              "happy/graph-attrs.expected-ast.json",
+		"happy/ports.lilgraph":                    "happy/ports.expected-ast.json",
+		"happy/edge-keys.lilgraph":                "happy/edge-keys.expected-ast.json",
+		"happy/reverse-edges.lilgraph":            "happy/reverse-edges.expected-ast.json",
+		"happy/inline-nodes.lilgraph":             "happy/inline-nodes.expected-ast.json",
+		"happy/deletions.lilgraph":                "happy/deletions.expected-ast.json",
+		"happy/namespaces.lilgraph":               "happy/namespaces.expected-ast.json",
+		"happy/templates.lilgraph":                "happy/templates.expected-ast.json",
+		"happy/interpolation.lilgraph":            "happy/interpolation.expected-ast.json",
+		"happy/nested-attrs.lilgraph":             "happy/nested-attrs.expected-ast.json",
+		"happy/anon-nodes.lilgraph":               "happy/anon-nodes.expected-ast.json",
+		"happy/doc-comments.lilgraph":             "happy/doc-comments.expected-ast.json",
+		"happy/escapes.lilgraph":                  "happy/escapes.expected-ast.json",
+		"happy/raw-strings.lilgraph":              "happy/raw-strings.expected-ast.json",
 	}
 	for inputPath, expectAstJsonPath := range cases {
 		t.Run(inputPath, func(t *testing.T) {
@@ -100,8 +124,9 @@ func TestParserHappyPaths(t *testing.T) {
 				t.Fatalf("failed unmarshaling test expectation AST from '%s': %v", expectAstJsonPath, err)
 			}
 			lex := lexer.NewLexer(input)
+			lex.Context = ast.NewContext("", ast.Settings{})
 			p := parser.NewParser()
-			parseResult, err := p.Parse(lex)
+			parseResult, err := p.Parse(ast.NewScanner(lex, input))
 			if err != nil {
 				t.Fatalf("expected parsing '%s' to succeed, but got err: %v", inputPath, err)
 			}
@@ -168,7 +193,7 @@ It also has some leading/trailing whitespace:
     <- 4 spaces ->    
 	<- 1 tab ->	
 It can contain "double-quotes" (if escaped) and 'single quotes' and backticks ` + "``" + `
-and literal backslashes (¯\_(ツ)_/¯) (incl doubled: \\)
+and backslashes, both literal (¯\_(ツ)_/¯) and escaped (\)
 and unicode もしもし
 and emoji 🥳`
 	if v, ok := dNode.GetAttr("multiline"); ok {
@@ -187,6 +212,414 @@ and emoji 🥳`
 	}
 }
 
+func TestAttrKinds(t *testing.T) {
+	inputPath := "happy/attr-kinds.lilgraph"
+	input := readFsFile(t, testCases, inputPath)
+	g, err := lilgraph.Parse(input)
+	if err != nil {
+		t.Fatalf("expected attr-kinds example to succeed, but got err=%v", err)
+	}
+	n := g.Find("svc")
+
+	expectKinds := map[string]lilgraph.ValueKind{
+		"port":     lilgraph.KindNumber,
+		"port_str": lilgraph.KindString,
+		"ratio":    lilgraph.KindNumber,
+		"enabled":  lilgraph.KindBool,
+		"disabled": lilgraph.KindString,
+		"mode":     lilgraph.KindIdent,
+	}
+	for key, expectKind := range expectKinds {
+		if kind, ok := n.AttrKind(key); !ok || kind != expectKind {
+			t.Errorf("expected attr '%s' to have kind %s, but got %s", key, expectKind, kind)
+		}
+	}
+
+	if v, ok := n.GetNumber("port"); !ok || v != 8080 {
+		t.Errorf("expected GetNumber for 'port' to give 8080, but got %v (ok=%t)", v, ok)
+	}
+	if v, ok := n.GetNumber("ratio"); !ok || v != -0.5 {
+		t.Errorf("expected GetNumber for 'ratio' to give -0.5, but got %v (ok=%t)", v, ok)
+	}
+	if _, ok := n.GetNumber("port_str"); ok {
+		t.Errorf("expected GetNumber for string attr 'port_str' to fail")
+	}
+	if v, ok := n.GetBool("enabled"); !ok || !v {
+		t.Errorf("expected GetBool for 'enabled' to give true, but got %v (ok=%t)", v, ok)
+	}
+	if _, ok := n.GetBool("disabled"); ok {
+		t.Errorf("expected GetBool for string attr 'disabled' to fail")
+	}
+
+	if err := n.SetAttrKind("mode", "not an ident", lilgraph.KindIdent); !errors.Is(err, lilgraph.ErrBadValue) {
+		t.Errorf("expected setting an invalid ident value to fail with ErrBadValue, but got err=%v", err)
+	}
+	if err := n.SetAttrKind("port", "80a", lilgraph.KindNumber); !errors.Is(err, lilgraph.ErrBadValue) {
+		t.Errorf("expected setting an invalid number value to fail with ErrBadValue, but got err=%v", err)
+	}
+	if err := n.SetAttrKind("mode", "true", lilgraph.KindIdent); !errors.Is(err, lilgraph.ErrBadValue) {
+		t.Errorf("expected setting a bool as an ident value to fail with ErrBadValue, but got err=%v", err)
+	}
+	for _, v := range []float64{math.NaN(), math.Inf(1), math.Inf(-1)} {
+		if err := n.SetNumber("port", v); !errors.Is(err, lilgraph.ErrBadValue) {
+			t.Errorf("expected setting number %v to fail with ErrBadValue, but got err=%v", v, err)
+		}
+	}
+	if err := n.SetNumber("port", 443); err != nil {
+		t.Fatalf("expected SetNumber to succeed, but got err=%v", err)
+	}
+	if err := n.SetAttr("mode", "slow and steady"); err != nil {
+		t.Fatalf("expected SetAttr to succeed, but got err=%v", err)
+	}
+	actual, err := g.MarshalText()
+	if err != nil {
+		t.Fatalf("expected marshalling to succeed, but got err=%v", err)
+	}
+	expect := `svc [port=443, port_str="8080", ratio=-.5, enabled=true, disabled="false", mode="slow and steady"]` + "\n"
+	if diff := cmp.Diff(expect, string(actual)); diff != "" {
+		t.Fatalf("marshalled updated attrs did not match expectation:\n%s", diff)
+	}
+}
+
+func TestAttrLists(t *testing.T) {
+	inputPath := "happy/attr-lists.lilgraph"
+	input := readFsFile(t, testCases, inputPath)
+	g, err := lilgraph.Parse(input)
+	if err != nil {
+		t.Fatalf("expected attr-lists example to succeed, but got err=%v", err)
+	}
+	n := g.Find("svc")
+
+	tags, ok := n.GetList("tags")
+	if !ok {
+		t.Fatalf("expected node 'svc' to have list attr 'tags'")
+	}
+	if diff := cmp.Diff([]string{"infra", "critical", "on call", "a, b"}, tags); diff != "" {
+		t.Fatalf("list attr 'tags' did not match expectation:\n%s", diff)
+	}
+	if none, ok := n.GetList("none"); !ok || len(none) != 0 {
+		t.Fatalf("expected node 'svc' to have empty list attr 'none'")
+	}
+	if v, ok := n.GetAttr("ports"); !ok || v != "[80, 443]" {
+		t.Fatalf("expected plain GetAttr of a list to give its literal form, but got '%s'", v)
+	}
+
+	if err := n.SetList("tags", []string{"x", "y z"}); err != nil {
+		t.Fatalf("expected SetList to succeed, but got err=%v", err)
+	}
+	if kind, _ := n.AttrKind("tags"); kind != lilgraph.KindList {
+		t.Fatalf("expected 'tags' to still be a list after SetList, but got %s", kind)
+	}
+	n.ReplaceAttrs(map[string]string{"tags": `["x", "y z"]`, "ports": "none"})
+	if _, ok := n.GetList("ports"); ok {
+		t.Fatalf("expected replacing list attr 'ports' with a string to make it a string")
+	}
+	if _, ok := n.GetList("tags"); !ok {
+		t.Fatalf("expected ReplaceAttrs with an unchanged list value to leave 'tags' as a list")
+	}
+}
+
+func TestNestedAttrs(t *testing.T) {
+	input := []byte(`
+		@defaults service [deploy=manual, owner.team=platform]
+		api [service; deploy.region=eu, deploy.replicas=3, deploy.db.host="pg-1", deploy.db.tags=[a, b]]
+	`)
+	g, err := lilgraph.Parse(input)
+	if err != nil {
+		t.Fatalf("expected nested attrs to succeed, but got err=%v", err)
+	}
+	api := g.Find("api")
+	expectDeploy := map[string]any{
+		"region":   "eu",
+		"replicas": 3.0,
+		"db":       map[string]any{"host": "pg-1", "tags": []string{"a", "b"}},
+	}
+	if diff := cmp.Diff(expectDeploy, api.Attrs("deploy")); diff != "" {
+		t.Errorf("attrs under 'deploy' differed from expectation:\n%s", diff)
+	}
+	if host, _ := api.GetAttr("deploy.db.host"); host != "pg-1" {
+		t.Errorf("expected nested attrs to be gettable by full key, but got %q", host)
+	}
+	// Defaults don't apply where they'd conflict with an explicit attr.
+	expectTree := map[string]any{"deploy": expectDeploy, "owner": map[string]any{"team": "platform"}}
+	if diff := cmp.Diff(expectTree, api.AttrTree()); diff != "" {
+		t.Errorf("attr tree differed from expectation:\n%s", diff)
+	}
+
+	// Setting an attr replaces any it'd conflict with, either way round.
+	if err := api.SetAttr("deploy.db", "shared"); err != nil {
+		t.Fatalf("expected SetAttr to succeed, but got err=%v", err)
+	}
+	expectDeploy["db"] = "shared"
+	if diff := cmp.Diff(expectDeploy, api.Attrs("deploy")); diff != "" {
+		t.Errorf("expected setting 'deploy.db' to replace everything under it:\n%s", diff)
+	}
+	api.DeleteAttr("deploy")
+	if deploy := api.Attrs("deploy"); deploy != nil {
+		t.Errorf("expected deleting 'deploy' to delete everything under it, but got %v", deploy)
+	}
+
+	if err := api.SetAttr("deploy..region", "eu"); !errors.Is(err, lilgraph.ErrBadKey) {
+		t.Errorf("expected malformed key to fail with ErrBadKey, but got err=%v", err)
+	}
+	_, err = lilgraph.Parse([]byte("lilgraph 1\na [deploy.region=eu]"))
+	if !errors.Is(err, lilgraph.ErrVersion) {
+		t.Errorf("expected dotted key in version 1 to fail with ErrVersion, but got err=%v", err)
+	}
+}
+
+func TestInclude(t *testing.T) {
+	g, err := lilgraph.ParseFS(testCases, "include/main.lilgraph")
+	if err != nil {
+		t.Fatalf("expected include example to succeed, but got err=%v", err)
+	}
+	if db := g.Find("db"); db == nil || db.Type() != "database" || !g.FindGroup("data").HasNode(db) {
+		t.Fatalf("expected node 'db' from nested include to be declared inside group 'data'")
+	}
+	if g.Find("monitoring") == nil {
+		t.Fatalf("expected node 'monitoring', from an include within an include, to exist")
+	}
+
+	// Included content is flattened in place.
+	expect := readFsFile(t, testCases, "include/main.expect-marshalled.lilgraph")
+	actual, err := g.MarshalText()
+	if err != nil {
+		t.Fatalf("expected marshalling to succeed, but got err=%v", err)
+	}
+	if diff := cmp.Diff(string(expect), string(actual)); diff != "" {
+		t.Fatalf("plaintext rendering of included content differed from expectation:\n%s", diff)
+	}
+
+	// `include` is only a keyword at the start of a statement; it's fine as
+	// an attr key.
+	g, err = lilgraph.Parse([]byte("a [include=1]"))
+	if err != nil {
+		t.Fatalf("expected 'include' as an attr key to succeed, but got err=%v", err)
+	}
+	if err := g.Find("a").SetAttr("include", "2"); err != nil {
+		t.Fatalf("expected setting attr 'include' to succeed, but got err=%v", err)
+	}
+	actual, err = g.MarshalText()
+	if err != nil {
+		t.Fatalf("expected marshalling to succeed, but got err=%v", err)
+	}
+	if diff := cmp.Diff("a [include=\"2\"]\n", string(actual)); diff != "" {
+		t.Errorf("plaintext rendering differed from expectation:\n%s", diff)
+	}
+}
+
+func TestIncludeErrors(t *testing.T) {
+	_, err := lilgraph.ParseFS(testCases, "include/cycle-a.lilgraph")
+	if !errors.Is(err, lilgraph.ErrIncludeCycle) {
+		t.Errorf("expected include cycle to fail with ErrIncludeCycle, but got err=%v", err)
+	}
+
+	_, err = lilgraph.ParseFS(testCases, "include/missing.lilgraph")
+	if !errors.Is(err, lilgraph.ErrInclude) || !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("expected missing include to fail with ErrInclude and fs.ErrNotExist, but got err=%v", err)
+	}
+
+	// Errors in included files should report positions in those files.
+	_, err = lilgraph.ParseFS(testCases, "include/bad-outer.lilgraph")
+	if !errors.Is(err, lilgraph.ErrTypeChange) {
+		t.Fatalf("expected type change in included file to fail with ErrTypeChange, but got err=%v", err)
+	}
+	if !strings.Contains(err.Error(), "include/bad-inner.lilgraph:3:1") {
+		t.Errorf("expected error to refer to position in included file, but got err=%v", err)
+	}
+
+	// Without a file to resolve relative to, includes can't work.
+	_, err = lilgraph.Parse([]byte(`include "foo.lilgraph"`))
+	if !errors.Is(err, lilgraph.ErrInclude) {
+		t.Errorf("expected include via Parse to fail with ErrInclude, but got err=%v", err)
+	}
+}
+
+func TestDeletions(t *testing.T) {
+	// Overlays can take away things from the graph they include.
+	g, err := lilgraph.ParseFS(testCases, "include/overlay.lilgraph")
+	if err != nil {
+		t.Fatalf("expected overlay example to succeed, but got err=%v", err)
+	}
+	if g.Find("debug_proxy") != nil {
+		t.Errorf("expected node 'debug_proxy' to have been deleted")
+	}
+	api, web := g.Find("api"), g.Find("web")
+	if _, ok := g.FindEdgeSpec(web, api, lilgraph.EdgeSpec{Type: "calls", Key: "v1"}); ok {
+		t.Errorf("expected edge web -[calls#v1]-> api to have been deleted")
+	}
+	if n := len(slices.Collect(g.FindEdges(web, api))); n != 2 {
+		t.Errorf("expected 2 remaining edges from 'web' to 'api', but got %d", n)
+	}
+	if _, ok := api.GetAttr("debug"); ok {
+		t.Errorf("expected attr 'debug' of 'api' to have been unset")
+	}
+	expect := readFsFile(t, testCases, "include/overlay.expect-marshalled.lilgraph")
+	actual, err := g.MarshalText()
+	if err != nil {
+		t.Fatalf("expected marshalling to succeed, but got err=%v", err)
+	}
+	if diff := cmp.Diff(string(expect), string(actual)); diff != "" {
+		t.Errorf("plaintext rendering of overlay differed from expectation:\n%s", diff)
+	}
+
+	// Deleted nodes can be re-declared afresh.
+	g, err = lilgraph.Parse([]byte("a [x] -> b\n!a\na -> c"))
+	if err != nil {
+		t.Fatalf("expected re-declaring a deleted node to succeed, but got err=%v", err)
+	}
+	if a := g.Find("a"); a.HasType("x") || len(slices.Collect(g.FindEdges(a, g.Find("b")))) != 0 {
+		t.Errorf("expected re-declared node 'a' to have no type, nor edge to 'b'")
+	}
+
+	badCases := map[string]string{
+		"missing node":   "a\n!b",
+		"missing edge":   "a -> b\n!a -[t]-> b",
+		"edge direction": "a -> b\n!b -> a",
+		"inline decl":    "a -> b\n!a [x] -> b",
+		"edge attrs":     "a -[t; w=1]-> b\n!a -[t; w=1]-> b",
+	}
+	for name, input := range badCases {
+		_, err := lilgraph.Parse([]byte(input))
+		if !errors.Is(err, lilgraph.ErrDelete) || !strings.Contains(err.Error(), "line=2") {
+			t.Errorf("expected %s case to fail with ErrDelete on line 2, but got err=%v", name, err)
+		}
+	}
+}
+
+func TestDefaults(t *testing.T) {
+	inputPath := "happy/defaults.lilgraph"
+	input := readFsFile(t, testCases, inputPath)
+	g, err := lilgraph.Parse(input)
+	if err != nil {
+		t.Fatalf("expected defaults example to succeed, but got err=%v", err)
+	}
+
+	// Defaults apply regardless of whether the node was declared before them.
+	expectNodeAttrs := map[string]map[string]string{
+		"luke": {"homeplanet": "tatooine", "species": "homo_sapiens"},
+		"leia": {"homeplanet": "coruscant", "species": "homo_sapiens"},
+		"yoda": nil,
+	}
+	for id, expectAttrs := range expectNodeAttrs {
+		if diff := cmp.Diff(expectAttrs, g.Find(id).AttrsMap()); diff != "" {
+			t.Errorf("node '%s' attrs did not match expectation:\n%s", id, diff)
+		}
+	}
+	luke := g.Find("luke")
+	if luke.AttrInherited("homeplanet") || !luke.AttrInherited("species") {
+		t.Errorf("expected luke's 'species' attr only to be inherited")
+	}
+
+	obiWanEdge, _ := g.FindEdge(g.Find("obi_wan"), luke, "trained")
+	if v, ok := obiWanEdge.GetBool("formal"); !ok || !v || !obiWanEdge.AttrInherited("formal") {
+		t.Errorf("expected obi_wan->luke edge to inherit formal=true")
+	}
+	yodaEdge, _ := g.FindEdge(g.Find("yoda"), luke, "trained")
+	if v, ok := yodaEdge.GetBool("formal"); !ok || v || yodaEdge.AttrInherited("formal") {
+		t.Errorf("expected yoda->luke edge to keep explicit formal=false")
+	}
+
+	// Explicitly setting an inherited attr makes it no longer inherited.
+	if err := g.Find("leia").SetAttr("species", "force_sensitive_human"); err != nil {
+		t.Fatalf("expected SetAttr to succeed, but got err=%v", err)
+	}
+	if g.Find("leia").AttrInherited("species") {
+		t.Errorf("expected explicitly-set attr not to be inherited")
+	}
+
+	if diff := cmp.Diff(map[string]string{"formal": "true"}, g.EdgeDefaults("trained")); diff != "" {
+		t.Errorf("edge defaults for 'trained' did not match expectation:\n%s", diff)
+	}
+	if g.NodeDefaults("trained") != nil {
+		t.Errorf("expected no node defaults for type 'trained'")
+	}
+
+	// Unsetting an attr keeps it from being inherited, wherever the defaults
+	// are declared; the unset is written back, so that stays true.
+	input = []byte("a [h; !s]\n@defaults h [s=1, t=2]\nb [h]\nb [!t]\nc [!s]\nd [h; !s]\nd [s=3]")
+	g, err = lilgraph.Parse(input)
+	if err != nil {
+		t.Fatalf("expected unsets with defaults to succeed, but got err=%v", err)
+	}
+	expectAttrs := map[string]map[string]string{
+		"a": {"t": "2"},
+		"b": {"s": "1"},
+		"c": nil,
+		"d": {"s": "3", "t": "2"},
+	}
+	for id, expect := range expectAttrs {
+		if diff := cmp.Diff(expect, g.Find(id).AttrsMap()); diff != "" {
+			t.Errorf("attrs of '%s' differed from expectation:\n%s", id, diff)
+		}
+	}
+	text, err := g.MarshalText()
+	if err != nil {
+		t.Fatalf("expected marshalling to succeed, but got err=%v", err)
+	}
+	expectText := "lilgraph 2\n@defaults h [s=1, t=2]\na [h; !s]\nb [h; !t]\nc\nd [h; s=3]\n"
+	if diff := cmp.Diff(expectText, string(text)); diff != "" {
+		t.Errorf("plaintext rendering differed from expectation:\n%s", diff)
+	}
+
+	// Deleting an inherited attr is recorded the same way.
+	g, err = lilgraph.Parse([]byte("@defaults t [x=1, y=2]\na [t]\nb [t]"))
+	if err != nil {
+		t.Fatalf("expected defaults to succeed, but got err=%v", err)
+	}
+	g.Find("a").DeleteAttr("x")
+	g.Find("b").ReplaceAttrs(map[string]string{"x": "1"})
+	text, err = g.MarshalText()
+	if err != nil {
+		t.Fatalf("expected marshalling to succeed, but got err=%v", err)
+	}
+	g, err = lilgraph.Parse(text)
+	if err != nil {
+		t.Fatalf("expected re-parsing to succeed, but got err=%v\n%s", err, text)
+	}
+	if diff := cmp.Diff(map[string]string{"y": "2"}, g.Find("a").AttrsMap()); diff != "" {
+		t.Errorf("attrs of 'a' differed after deleting an inherited one and re-parsing:\n%s\n%s", diff, text)
+	}
+	if diff := cmp.Diff(map[string]string{"x": "1"}, g.Find("b").AttrsMap()); diff != "" {
+		t.Errorf("attrs of 'b' differed after replacing inherited ones and re-parsing:\n%s\n%s", diff, text)
+	}
+}
+
+func TestGraphAttrs(t *testing.T) {
+	inputPath := "happy/graph-attrs.lilgraph"
+	input := readFsFile(t, testCases, inputPath)
+	g, err := lilgraph.Parse(input)
+	if err != nil {
+		t.Fatalf("expected graph-attrs case to succeed, but got err=%v", err)
+	}
+	expectAttrs := map[string]string{"title": "Rebellion", "owner": "mon_mothma", "rankdir": "LR", "version": "2"}
+	if diff := cmp.Diff(expectAttrs, g.AttrsMap()); diff != "" {
+		t.Errorf("graph attrs differed from expectation:\n%s", diff)
+	}
+	if v, ok := g.GetNumber("version"); !ok || v != 2 {
+		t.Errorf("expected graph attr 'version' to be the number 2")
+	}
+	if n := len(slices.Collect(g.Nodes())); n != 2 {
+		t.Errorf("expected graph attrs not to add any nodes, but got %d nodes", n)
+	}
+
+	g2 := lilgraph.NewGraph()
+	if err := g2.SetAttr("title", "New Hope"); err != nil {
+		t.Fatalf("expected setting graph attr to succeed, but got err=%v", err)
+	}
+	if err := g2.SetAttr("type", "x"); !errors.Is(err, lilgraph.ErrTypeInAttrs) {
+		t.Errorf("expected setting graph attr 'type' to fail with ErrTypeInAttrs, but got err=%v", err)
+	}
+	g2.AddNode("luke", "")
+	actual, err := g2.MarshalText()
+	if err != nil {
+		t.Fatalf("expected marshalling to succeed, but got err=%v", err)
+	}
+	if diff := cmp.Diff("lilgraph 2\n@graph [title=\"New Hope\"]\nluke\n", string(actual)); diff != "" {
+		t.Errorf("plaintext rendering differed from expectation:\n%s", diff)
+	}
+}
+
 // Checks for a bug where a line-comment that ended in EOF (note: not cases ending in newline
 // *then* EOF) caused a parse err.
 func TestCommentAtEOF(t *testing.T) {
@@ -217,12 +650,14 @@ func TestCommentAtEOF(t *testing.T) {
 }
 
 func TestBadIds(t *testing.T) {
+	// Ids that aren't valid bare ids can still be used in quoted form, so few
+	// are actually invalid.
 	cases := []string{
-		" this is a bad id",
-		"0thisisabadid",
-		",thisisabadid",
-		"-thisisabadid",
-		"これは悪いIDだ",
+		"",
+		"this\nis a bad id",
+		"this\tis a bad id",
+		"this is a bad id\x00",
+		"this is a bad id\xff",
 	}
 	g := lilgraph.NewGraph()
 	for _, badId := range cases {
@@ -239,21 +674,1047 @@ func TestBadIds(t *testing.T) {
 	}
 }
 
-func TestLoopsPrevented(t *testing.T) {
-	inputPath := "bad/self-loop.lilgraph"
+func TestQuotedIds(t *testing.T) {
+	inputPath := "happy/quoted-ids.lilgraph"
 	input := readFsFile(t, testCases, inputPath)
-	_, err := lilgraph.Parse(input)
-	if !errors.Is(err, lilgraph.ErrLoop) {
-		t.Fatalf("expected self-loop case to fail with ErrLoop,  got err=%v", err)
+	g, err := lilgraph.Parse(input)
+	if err != nil {
+		t.Fatalf("expected quoted-ids example to succeed, but got err=%v", err)
+	}
+	for _, id := range []string{"api-gateway", "eu-west-1", "Zoë", "plain", "include", "これはIDだ"} {
+		if g.Find(id) == nil {
+			t.Errorf("expected node '%s' to exist", id)
+		}
+	}
+	if g.FindGroup("eu-west-1") == nil {
+		t.Errorf("expected group 'eu-west-1' to exist")
+	}
+
+	// Quoted ids that can't be used are rejected, saying where they were.
+	badIds := map[string]string{
+		"tab":           "x\n\"a\tb\" -> c",
+		"control char":  "x\ny -> \"a\\u0001b\"",
+		"empty":         "x\n\"\"",
+		"empty port":    "x\ny:\"\" -> c",
+		"empty in decl": "x\ny -> \"\" [k=v]",
+	}
+	for name, input := range badIds {
+		_, err := lilgraph.Parse([]byte(input))
+		if !errors.Is(err, lilgraph.ErrInvalidId) || !strings.Contains(err.Error(), "line=2") {
+			t.Errorf("expected %s id to fail with ErrInvalidId on line 2, but got err=%v", name, err)
+		}
+	}
+
+	// Formerly-bad ids are fine for nodes added programmatically, too; they
+	// just need to be written quoted.
+	g2 := lilgraph.NewGraph()
+	for _, id := range []string{" spaced out ", "0numeric", ",comma", "-dash", `"quoted"`} {
+		if _, _, err := g2.AddNode(id, ""); err != nil {
+			t.Fatalf("expected adding node with id '%s' to succeed, but got err=%v", id, err)
+		}
+	}
+	actual, err := g2.MarshalText()
+	if err != nil {
+		t.Fatalf("expected marshalling to succeed, but got err=%v", err)
+	}
+	g3, err := lilgraph.Parse(actual)
+	if err != nil {
+		t.Fatalf("expected re-parsing marshalled ids to succeed, but got err=%v", err)
+	}
+	for n := range g2.Nodes() {
+		if g3.Find(n.Id()) == nil {
+			t.Errorf("expected node '%s' to survive marshalling and re-parsing", n.Id())
+		}
+	}
+}
+
+func TestEscapes(t *testing.T) {
+	// Values needing escapes survive marshalling and re-parsing, incl. ones
+	// that couldn't be written at all under the legacy rules.
+	g := lilgraph.NewGraph()
+	n, _, _ := g.AddNode("A", "")
+	values := map[string]string{
+		"winpath":   `C:\Users\luke\`,
+		"quotes":    `"quoted"\`,
+		"control":   "bell\a\x00\r\n",
+		"backslash": `\`,
+	}
+	for k, v := range values {
+		n.SetAttr(k, v)
+	}
+	text, err := g.MarshalText()
+	if err != nil {
+		t.Fatalf("expected marshalling to succeed, but got err=%v", err)
+	}
+	g2, err := lilgraph.Parse(text)
+	if err != nil {
+		t.Fatalf("expected re-parsing marshalled escapes to succeed, but got err=%v\n%s", err, text)
+	}
+	if diff := cmp.Diff(values, g2.Find("A").AttrsMap()); diff != "" {
+		t.Errorf("attrs differed after marshalling and re-parsing:\n%s", diff)
+	}
+
+	// The old behaviour, where only \" is an escape, is available as an option.
+	legacySrc := []byte(`A [path="C:\\temp\n", quote="say \"hi\""]`)
+	g3, err := lilgraph.Parse(legacySrc, lilgraph.WithLegacyEscapes())
+	if err != nil {
+		t.Fatalf("expected legacy parse to succeed, but got err=%v", err)
+	}
+	expectLegacy := map[string]string{"path": `C:\\temp\n`, "quote": `say "hi"`}
+	if diff := cmp.Diff(expectLegacy, g3.Find("A").AttrsMap()); diff != "" {
+		t.Errorf("legacy attrs differed from expectation:\n%s", diff)
+	}
+	legacyText, err := g3.MarshalText()
+	if err != nil {
+		t.Fatalf("expected legacy marshalling to succeed, but got err=%v", err)
+	}
+	// Backslashes only mean the same under version 1's escaping, so it has to
+	// be declared; then the default rules give the same values.
+	if diff := cmp.Diff("lilgraph 1\n"+string(legacySrc)+"\n", string(legacyText)); diff != "" {
+		t.Errorf("legacy graph should marshal using legacy escapes:\n%s", diff)
+	}
+	// Either way, the declared version decides the rules, so re-parsing with
+	// or without the option gives the same values.
+	expectReparsed := func(text []byte, as string) {
+		t.Helper()
+		for _, opts := range [][]lilgraph.ParseOption{nil, {lilgraph.WithLegacyEscapes()}} {
+			g4, err := lilgraph.Parse(text, opts...)
+			if err != nil {
+				t.Fatalf("expected re-parsing legacy marshalled text to succeed, but got err=%v", err)
+			}
+			if diff := cmp.Diff(expectLegacy, g4.Find("A").AttrsMap()); diff != "" {
+				t.Errorf("legacy attrs differed after marshalling as %s and re-parsing with %d options:\n%s", as, len(opts), diff)
+			}
+		}
+	}
+	expectReparsed(legacyText, "version 1")
+	// Content that needs a later version is written with the current rules.
+	g3.Find("A").AddType("jedi")
+	g3.Find("A").AddType("pilot")
+	legacyText, err = g3.MarshalText()
+	if err != nil {
+		t.Fatalf("expected legacy marshalling to succeed, but got err=%v", err)
+	}
+	expectReparsed(legacyText, "version 2")
+
+	// Values that legacy escaping can't write need the current rules, too.
+	g5, err := lilgraph.Parse([]byte("A"), lilgraph.WithLegacyEscapes())
+	if err != nil {
+		t.Fatalf("expected legacy parse to succeed, but got err=%v", err)
+	}
+	expectLegacy = map[string]string{"path": `C:\`, "quote": `say \"hi\"`}
+	for k, v := range expectLegacy {
+		g5.Find("A").SetAttr(k, v)
+	}
+	legacyText, err = g5.MarshalText()
+	if err != nil {
+		t.Fatalf("expected legacy marshalling to succeed, but got err=%v", err)
+	}
+	if !strings.HasPrefix(string(legacyText), "lilgraph 2\n") {
+		t.Errorf("expected values legacy escaping can't write to need version 2, but got:\n%s", legacyText)
+	}
+	expectReparsed(legacyText, "version 2")
+
+	inputPath := "bad/unicode-escape.lilgraph"
+	_, err = lilgraph.Parse(readFsFile(t, testCases, inputPath))
+	if !errors.Is(err, lilgraph.ErrParseFail) {
+		t.Fatalf("expected bad escape case to fail with ErrParseFail, got err=%v", err)
+	}
+}
+
+func TestRawStrings(t *testing.T) {
+	// Multi-line values are marshalled as raw strings where possible, falling
+	// back to quoted ones; either way, they must survive re-parsing.
+	values := map[string]string{
+		"plain":          "one\ntwo",
+		"nested_indent":  "one\n    two\n\tthree",
+		"common_indent":  "  one\n  two",
+		"blank_edges":    "\none\ntwo\n\n",
+		"backticks":      "one\n`two`",
+		"blank_lines":    "one\n   \ntwo",
+		"carriage_ret":   "one\r\ntwo",
+		"single_line":    "  `one`  ",
+		"trailing_space": "one  \ntwo\t",
+	}
+	g := lilgraph.NewGraph()
+	gr, _, _ := g.AddGroup(nil, "outer", "")
+	n, _, _ := g.AddNode("A", "")
+	gr.AddNode(n)
+	for k, v := range values {
+		n.SetAttr(k, v)
+	}
+	text, err := g.MarshalText()
+	if err != nil {
+		t.Fatalf("expected marshalling to succeed, but got err=%v", err)
+	}
+	if !strings.Contains(string(text), "plain=`\n        one\n        two\n    `") {
+		t.Errorf("expected multi-line value to be marshalled as an indented raw string, got:\n%s", text)
+	}
+	g2, err := lilgraph.Parse(text)
+	if err != nil {
+		t.Fatalf("expected re-parsing marshalled raw strings to succeed, but got err=%v\n%s", err, text)
+	}
+	if diff := cmp.Diff(values, g2.Find("A").AttrsMap()); diff != "" {
+		t.Errorf("attrs differed after marshalling and re-parsing:\n%s\n%s", diff, text)
+	}
+
+	// Raw strings need version 2, but version 1 can already write multi-line
+	// values quoted; so a graph that needs nothing newer stays at version 1.
+	g3 := lilgraph.NewGraph()
+	n, _, _ = g3.AddNode("A", "")
+	n.SetAttr("runbook", "drain\nrestart")
+	text, err = g3.MarshalText()
+	if err != nil {
+		t.Fatalf("expected marshalling to succeed, but got err=%v", err)
+	}
+	if diff := cmp.Diff("A [runbook=\"drain\nrestart\"]\n", string(text)); diff != "" {
+		t.Errorf("multi-line value in an otherwise version 1 graph was written differently from expectation:\n%s", diff)
+	}
+	g4, err := lilgraph.Parse([]byte("lilgraph 1\n" + string(text)))
+	if err != nil {
+		t.Fatalf("expected the marshalled value to parse as version 1, but got err=%v", err)
+	}
+	if v, _ := g4.Find("A").GetAttr("runbook"); v != "drain\nrestart" {
+		t.Errorf("expected multi-line value to survive re-parsing as version 1, but got %q", v)
+	}
+}
+
+func TestLoopsPrevented(t *testing.T) {
+	cases := []string{
+		"bad/self-loop.lilgraph",
+		"bad/edge-set-loop.lilgraph",
+	}
+	for _, inputPath := range cases {
+		input := readFsFile(t, testCases, inputPath)
+		_, err := lilgraph.Parse(input)
+		if !errors.Is(err, lilgraph.ErrLoop) {
+			t.Fatalf("expected self-loop case '%s' to fail with ErrLoop,  got err=%v", inputPath, err)
+		}
 	}
 }
 
-func TestNoNodeTypeChange(t *testing.T) {
-	inputPath := "bad/node-type-change.lilgraph"
+func TestLoopsAllowed(t *testing.T) {
+	inputPath := "happy/self-loops.lilgraph"
 	input := readFsFile(t, testCases, inputPath)
-	_, err := lilgraph.Parse(input)
-	if !errors.Is(err, lilgraph.ErrTypeChange) {
-		t.Fatalf("expected type-change case to fail with ErrTypeChange,  got err=%v", err)
+	g, err := lilgraph.Parse(input, lilgraph.WithLoops())
+	if err != nil {
+		t.Fatalf("expected self-loops case to succeed with loops allowed, but got err=%v", err)
+	}
+	running := g.Find("running")
+	if _, ok := g.FindEdge(running, running, "retry"); !ok {
+		t.Errorf("expected 'retry' loop on 'running'")
+	}
+	found := slices.Collect(g.FindEdges(running, running))
+	if len(found) != 2 {
+		t.Errorf("expected FindEdges to yield each of the 2 loops on 'running' once, got %d", len(found))
+	}
+
+	// Loops don't imply any ordering, so aren't cycles for sorting purposes.
+	if err := g.SortTopo(); err != nil {
+		t.Fatalf("expected sorting graph with loops to succeed, but got err=%v", err)
+	}
+	var order []string
+	for n := range g.Nodes() {
+		order = append(order, n.Id())
+	}
+	expectOrder := []string{"pending", "running", "done", "failed", "archived"}
+	if diff := cmp.Diff(expectOrder, order); diff != "" {
+		t.Errorf("topo order differed from expectation:\n%s", diff)
+	}
+
+	expect := readFsFile(t, testCases, "happy/self-loops.expect-marshalled.lilgraph")
+	actual, err := g.MarshalText()
+	if err != nil {
+		t.Fatalf("expected marshalling to succeed, but got err=%v", err)
+	}
+	if diff := cmp.Diff(string(expect), string(actual)); diff != "" {
+		t.Errorf("plaintext rendering differed from expectation:\n%s", diff)
+	}
+
+	// Loops can also be allowed on a graph built programmatically.
+	g2 := lilgraph.NewGraph()
+	a, _, _ := g2.AddNode("a", "")
+	if _, _, err := g2.AddEdge(a, a, ""); !errors.Is(err, lilgraph.ErrLoop) {
+		t.Fatalf("expected loops to be disallowed by default, but got err=%v", err)
+	}
+	g2.SetAllowLoops(true)
+	if _, _, err := g2.AddEdge(a, a, ""); err != nil {
+		t.Fatalf("expected loop to be allowed, but got err=%v", err)
+	}
+	if !g2.DeleteNode(a) || len(slices.Collect(g2.Edges())) != 0 {
+		t.Errorf("expected deleting node to delete its loop")
+	}
+}
+
+func TestNodeTypes(t *testing.T) {
+	inputPath := "happy/node-types.lilgraph"
+	input := readFsFile(t, testCases, inputPath)
+	g, err := lilgraph.Parse(input)
+	if err != nil {
+		t.Fatalf("expected node-types case to succeed, but got err=%v", err)
+	}
+	// Types from duplicate declarations are merged, in declaration order.
+	luke := g.Find("luke")
+	if diff := cmp.Diff([]string{"human", "jedi", "pilot"}, slices.Collect(luke.Types())); diff != "" {
+		t.Errorf("types of 'luke' differed from expectation:\n%s", diff)
+	}
+	if luke.Type() != "human" || !luke.HasType("pilot") || luke.HasType("sith") {
+		t.Errorf("expected 'luke' to have first type 'human', and to have type 'pilot' but not 'sith'")
+	}
+	if diff := cmp.Diff([]string{"sometype", "othertype"}, slices.Collect(g.Find("A").Types())); diff != "" {
+		t.Errorf("types of 'A' differed from expectation:\n%s", diff)
+	}
+	// Defaults apply for each type; for conflicting ones, earlier types win.
+	expectAttrs := map[string]string{"homeplanet": "tatooine", "force_sensitive": "true", "rank": "lieutenant"}
+	if diff := cmp.Diff(expectAttrs, luke.AttrsMap()); diff != "" {
+		t.Errorf("attrs of 'luke' differed from expectation:\n%s", diff)
+	}
+}
+
+func TestNoGroupTypeChange(t *testing.T) {
+	inputPath := "bad/group-type-change.lilgraph"
+	input := readFsFile(t, testCases, inputPath)
+	_, err := lilgraph.Parse(input)
+	if !errors.Is(err, lilgraph.ErrTypeChange) {
+		t.Fatalf("expected group type-change case to fail with ErrTypeChange,  got err=%v", err)
+	}
+}
+
+func TestNoGroupParentChange(t *testing.T) {
+	inputPath := "bad/group-parent-change.lilgraph"
+	input := readFsFile(t, testCases, inputPath)
+	_, err := lilgraph.Parse(input)
+	if !errors.Is(err, lilgraph.ErrParentChange) {
+		t.Fatalf("expected group parent-change case to fail with ErrParentChange, got err=%v", err)
+	}
+	if !strings.Contains(err.Error(), "line=4, column=10") {
+		t.Errorf("expected error to refer to the re-declaration, but got err=%v", err)
+	}
+}
+
+func TestGroups(t *testing.T) {
+	inputPath := "happy/groups.lilgraph"
+	input := readFsFile(t, testCases, inputPath)
+	g, err := lilgraph.Parse(input)
+	if err != nil {
+		t.Fatalf("expected groups example to succeed, but got err=%v", err)
+	}
+
+	groupIds := []string{}
+	for gr := range g.Groups() {
+		groupIds = append(groupIds, gr.Id())
+	}
+	if diff := cmp.Diff([]string{"empty", "rebels", "rogue_squadron", "smugglers"}, groupIds); diff != "" {
+		t.Fatalf("unexpected set of groups:\n%s", diff)
+	}
+
+	rebels := g.FindGroup("rebels")
+	if rebels.Type() != "team" {
+		t.Fatalf("expected group 'rebels' to have type 'team', but got '%s'", rebels.Type())
+	}
+	if diff := cmp.Diff(map[string]string{"owner": "mon_mothma", "founded": "yavin"}, rebels.AttrsMap()); diff != "" {
+		t.Fatalf("group 'rebels' attrs did not match expectation:\n%s", diff)
+	}
+	memberIds := []string{}
+	for n := range rebels.Nodes() {
+		memberIds = append(memberIds, n.Id())
+	}
+	if diff := cmp.Diff([]string{"leia", "luke", "rebel_alliance", "han"}, memberIds); diff != "" {
+		t.Fatalf("unexpected direct members of group 'rebels':\n%s", diff)
+	}
+
+	rogues := g.FindGroup("rogue_squadron")
+	if rogues.Parent() != rebels || !rogues.IsWithin(rebels) {
+		t.Fatalf("expected group 'rogue_squadron' to be nested in 'rebels'")
+	}
+	wedge := g.Find("wedge")
+	if rebels.HasNode(wedge) || !rebels.Contains(wedge) {
+		t.Fatalf("expected 'wedge' to be an indirect member of 'rebels' only")
+	}
+	if g.FindGroup("smugglers").Contains(wedge) {
+		t.Fatalf("expected 'wedge' not to be a member of 'smugglers'")
+	}
+
+	han := g.Find("han")
+	hanGroups := slices.Collect(han.Groups())
+	if len(hanGroups) != 2 || hanGroups[0] != rebels || hanGroups[1] != g.FindGroup("smugglers") {
+		t.Fatalf("expected 'han' to be a member of 'rebels' and 'smugglers'")
+	}
+	if len(slices.Collect(g.Find("vader").Groups())) != 0 {
+		t.Fatalf("expected 'vader' not to be a member of any group")
+	}
+	for e := range g.FindEdges(g.Find("vader"), g.Find("luke")) {
+		if len(slices.Collect(e.Groups())) != 0 {
+			t.Fatalf("expected edge vader->luke not to be a member of any group")
+		}
+	}
+
+	// Deleting a node drops it, and its edges, from the groups it was in.
+	if !g.DeleteNode(han) {
+		t.Fatalf("expected deleting node 'han' to succeed")
+	}
+	if rebels.HasNode(han) || len(slices.Collect(g.FindGroup("smugglers").Edges())) != 0 {
+		t.Fatalf("expected deleted node 'han' and its edges to be removed from groups")
+	}
+
+	// `subgraph` is only a keyword at the start of a statement; it's fine as
+	// an attr key.
+	g, err = lilgraph.Parse([]byte("a [subgraph=1]"))
+	if err != nil {
+		t.Fatalf("expected 'subgraph' as an attr key to succeed, but got err=%v", err)
+	}
+	if err := g.Find("a").SetAttr("subgraph", "2"); err != nil {
+		t.Fatalf("expected setting attr 'subgraph' to succeed, but got err=%v", err)
+	}
+	actual, err := g.MarshalText()
+	if err != nil {
+		t.Fatalf("expected marshalling to succeed, but got err=%v", err)
+	}
+	if diff := cmp.Diff("a [subgraph=\"2\"]\n", string(actual)); diff != "" {
+		t.Errorf("plaintext rendering differed from expectation:\n%s", diff)
+	}
+}
+
+func TestPorts(t *testing.T) {
+	inputPath := "happy/ports.lilgraph"
+	input := readFsFile(t, testCases, inputPath)
+	g, err := lilgraph.Parse(input)
+	if err != nil {
+		t.Fatalf("expected ports case to succeed, but got err=%v", err)
+	}
+	api, web, lb := g.Find("api"), g.Find("web"), g.Find("lb")
+	// Ports count towards an edge's identity.
+	if n := len(slices.Collect(g.FindEdges(api, web))); n != 3 {
+		t.Errorf("expected 3 distinct edges from 'api' to 'web', but got %d", n)
+	}
+	e, ok := g.FindEdgeSpec(api, web, lilgraph.EdgeSpec{FromPort: "http", ToPort: "in"})
+	if !ok || e.FromPort() != "http" || e.ToPort() != "in" {
+		t.Errorf("expected to find edge api:http -> web:in")
+	}
+	if _, ok := g.FindEdge(api, web, ""); !ok {
+		t.Errorf("expected to find port-less edge api -> web")
+	}
+	// ...including for non-directed edges given the other way round.
+	spec := lilgraph.EdgeSpec{Dir: lilgraph.Undirected, FromPort: "http", ToPort: "eu-west-1"}
+	if e, ok := g.FindEdgeSpec(api, lb, spec); !ok || e.From() != lb || e.FromPort() != "eu-west-1" {
+		t.Errorf("expected to find undirected edge lb:eu-west-1 -- api:http, from either end")
+	}
+
+	if _, _, err := g.AddEdgeSpec(api, web, lilgraph.EdgeSpec{ToPort: "\n"}); !errors.Is(err, lilgraph.ErrInvalidId) {
+		t.Errorf("expected adding edge with a bad port to fail with ErrInvalidId, but got err=%v", err)
+	}
+}
+
+func TestEdgeKeys(t *testing.T) {
+	inputPath := "happy/edge-keys.lilgraph"
+	input := readFsFile(t, testCases, inputPath)
+	g, err := lilgraph.Parse(input)
+	if err != nil {
+		t.Fatalf("expected edge-keys case to succeed, but got err=%v", err)
+	}
+	a, b := g.Find("a"), g.Find("b")
+	if n := len(slices.Collect(g.FindEdges(a, b))); n != 5 {
+		t.Errorf("expected 5 distinct edges from 'a' to 'b', but got %d", n)
+	}
+	e, ok := g.FindEdgeSpec(a, b, lilgraph.EdgeSpec{Type: "link", Key: "primary"})
+	if !ok || e.Key() != "primary" {
+		t.Fatalf("expected to find edge 'link#primary' from 'a' to 'b'")
+	}
+	// Edges with the same key are merged, as for unkeyed ones.
+	expectAttrs := map[string]string{"speed": "10", "latency": "2"}
+	if diff := cmp.Diff(expectAttrs, e.AttrsMap()); diff != "" {
+		t.Errorf("attrs of merged keyed edge differed from expectation:\n%s", diff)
+	}
+	if e, ok := g.FindEdge(a, b, "link"); !ok || e.Key() != "" {
+		t.Errorf("expected FindEdge to find the unkeyed 'link' edge")
+	}
+
+	if _, _, err := g.AddEdgeSpec(a, b, lilgraph.EdgeSpec{Key: "not ok"}); !errors.Is(err, lilgraph.ErrInvalidId) {
+		t.Errorf("expected adding edge with a bad key to fail with ErrInvalidId, but got err=%v", err)
+	}
+
+	// Keys are only recognised within an edge's brackets; elsewhere, a `#`
+	// directly after an id still starts a comment.
+	cases := map[string]string{
+		"a -> b# note\n":                       "a -> b\n",
+		"c#x\n":                                "c\n",
+		"a [x=foo#c\n]":                        "a [x=foo]\n",
+		"lilgraph 1\na -> b#c\n":               "a -> b\n",
+		"a -[link#k; note=\"x\"]-> b # note\n": "lilgraph 2\na -[link#k; note=\"x\"]-> b\n",
+	}
+	for src, expect := range cases {
+		g, err := lilgraph.Parse([]byte(src))
+		if err != nil {
+			t.Errorf("expected parsing %q to succeed, but got err=%v", src, err)
+			continue
+		}
+		actual, err := g.MarshalText()
+		if err != nil {
+			t.Fatalf("expected marshalling to succeed, but got err=%v", err)
+		}
+		if diff := cmp.Diff(expect, string(actual)); diff != "" {
+			t.Errorf("plaintext rendering of %q differed from expectation:\n%s", src, diff)
+		}
+	}
+	// Positions after a key are still right.
+	_, err = lilgraph.Parse([]byte("a -[link#k; x=]-> b"))
+	if err == nil || !strings.Contains(err.Error(), "1:15") {
+		t.Errorf("expected error after an edge key to be at 1:15, but got err=%v", err)
+	}
+}
+
+func TestInlineNodes(t *testing.T) {
+	inputPath := "happy/inline-nodes.lilgraph"
+	input := readFsFile(t, testCases, inputPath)
+	g, err := lilgraph.Parse(input)
+	if err != nil {
+		t.Fatalf("expected inline-nodes case to succeed, but got err=%v", err)
+	}
+	luke, jedi := g.Find("luke"), g.Find("jedi")
+	// Inline decls merge just like standalone ones.
+	if diff := cmp.Diff([]string{"human", "pilot"}, slices.Collect(luke.Types())); diff != "" {
+		t.Errorf("types of 'luke' differed from expectation:\n%s", diff)
+	}
+	if founded, _ := jedi.GetNumber("founded"); !jedi.HasType("org") || founded != -25000 {
+		t.Errorf("expected 'jedi' to have type 'org' and founded=-25000, but got %v %v", jedi.Type(), jedi.AttrsMap())
+	}
+	if _, ok := g.FindEdge(luke, jedi, "member"); !ok {
+		t.Errorf("expected to find edge luke -[member]-> jedi")
+	}
+	if g.Find("han").Type() != "" {
+		t.Errorf("expected bare 'han' in a set alongside an inline decl to have no type")
+	}
+
+	_, err = lilgraph.Parse([]byte("a -> b\nb -> c [type=x]"))
+	if !errors.Is(err, lilgraph.ErrTypeInAttrs) || !strings.Contains(err.Error(), "line=2, column=9") {
+		t.Errorf("expected explicit type in inline decl to fail with ErrTypeInAttrs at 2:9, but got err=%v", err)
+	}
+}
+
+func TestAnonNodes(t *testing.T) {
+	inputPath := "happy/anon-nodes.lilgraph"
+	input := readFsFile(t, testCases, inputPath)
+	g, err := lilgraph.Parse(input)
+	if err != nil {
+		t.Fatalf("expected anon-nodes case to succeed, but got err=%v", err)
+	}
+	// Ids are generated in order of declaration, so are the same every time.
+	var ids []string
+	for n := range g.Nodes() {
+		if n.Anonymous() {
+			ids = append(ids, n.Id())
+		}
+	}
+	if diff := cmp.Diff([]string{"_1", "_2", "_3", "_4"}, ids); diff != "" {
+		t.Errorf("generated ids differed from expectation:\n%s", diff)
+	}
+	if cmd, _ := g.Find("_2").GetAttr("cmd"); cmd != "test" {
+		t.Errorf("expected node '_2' to be the 2nd anonymous one, with cmd=test, but got %q", cmd)
+	}
+	if _, ok := g.FindEdge(g.Find("_1"), g.Find("_2"), ""); !ok {
+		t.Errorf("expected to find edge _1 -> _2")
+	}
+
+	// Generated ids skip any that are taken, even by nodes declared later.
+	g, err = lilgraph.Parse([]byte("hub -> _[x] -> {a, b}\n_ -> \"_\"\n_1 -> c"))
+	if err != nil {
+		t.Fatalf("expected anonymous nodes to succeed, but got err=%v", err)
+	}
+	if g.Find("_").Anonymous() || g.Find("_1").Anonymous() || !g.Find("_2").Anonymous() || !g.Find("_3").Anonymous() {
+		t.Errorf("expected quoted '_' and explicit '_1' to be normal nodes, and '_2' & '_3' to be anonymous")
+	}
+	if _, ok := g.FindEdge(g.Find("_3"), g.Find("_"), ""); !ok || len(slices.Collect(g.Find("_1").EdgesFrom())) != 1 {
+		t.Errorf("expected explicit '_1' to be distinct from the anonymous nodes")
+	}
+	actual, err := g.MarshalText()
+	if err != nil {
+		t.Fatalf("expected marshalling to succeed, but got err=%v", err)
+	}
+	expect := "lilgraph 2\nhub -> _[x] -> {a, b}\n_ -> \"_\"\n_1 -> c\n"
+	if diff := cmp.Diff(expect, string(actual)); diff != "" {
+		t.Errorf("plaintext rendering differed from expectation:\n%s", diff)
+	}
+
+	// Anonymous nodes' fan-out and fan-in are written with sets, so they stay
+	// anonymous. Any that can't be, e.g. with edges to other anonymous nodes,
+	// are written by id instead.
+	for _, c := range []struct {
+		in, expect string
+		byId       bool
+	}{
+		{"hub -> _[x] -> {a, b}", "lilgraph 2\nhub -> _[x] -> {a, b}\n", false},
+		{"{a, b} -[t]-> _[x] -> {c, d:in}", "lilgraph 2\n{a, b} -[t]-> _[x] -> {c, d:in}\n", false},
+		{"a -> _ <- {b, c}", "lilgraph 2\n{a, b, c} -> _\n", false},
+		{"a -> _[x] -> {_[y], c, d}", "lilgraph 2\n_1 [x]\na -> _1\n_1 -> _[y]\n_1 -> c\n_1 -> d\n", true},
+	} {
+		g, err := lilgraph.Parse([]byte(c.in))
+		if err != nil {
+			t.Fatalf("expected %q to succeed, but got err=%v", c.in, err)
+		}
+		actual, err := g.MarshalText()
+		if err != nil {
+			t.Fatalf("expected marshalling %q to succeed, but got err=%v", c.in, err)
+		}
+		if diff := cmp.Diff(c.expect, string(actual)); diff != "" {
+			t.Errorf("plaintext rendering of %q differed from expectation:\n%s", c.in, diff)
+		}
+		reparsed, err := lilgraph.Parse(actual)
+		if err != nil {
+			t.Fatalf("expected re-parsing %q to succeed, but got err=%v", actual, err)
+		}
+		if n, m := len(slices.Collect(g.Edges())), len(slices.Collect(reparsed.Edges())); n != m {
+			t.Errorf("expected %d edges after re-parsing %q, but got %d", n, actual, m)
+		}
+		if !c.byId && !reparsed.Find("_1").Anonymous() {
+			t.Errorf("expected '_1' to still be anonymous after re-parsing %q", actual)
+		}
+	}
+
+	// Anonymous nodes within namespaces are written by id, to keep their
+	// namespace.
+	g, err = lilgraph.Parse([]byte("@namespace p { _[x] -> y }"))
+	if err != nil {
+		t.Fatalf("expected anonymous node in a namespace to succeed, but got err=%v", err)
+	}
+	actual, err = g.MarshalText()
+	if err != nil {
+		t.Fatalf("expected marshalling to succeed, but got err=%v", err)
+	}
+	if diff := cmp.Diff("lilgraph 2\np._1 [x]\np._1 -> p.y\n", string(actual)); diff != "" {
+		t.Errorf("plaintext rendering differed from expectation:\n%s", diff)
+	}
+	g, err = lilgraph.Parse(actual)
+	if err != nil {
+		t.Fatalf("expected re-parsing to succeed, but got err=%v", err)
+	}
+	if n := g.Find("p._1"); n == nil || n.Namespace() != "p" {
+		t.Errorf("expected node 'p._1' to keep namespace 'p' after a round-trip")
+	}
+
+	// `_` is only a keyword where a node id can be; elsewhere, it's a plain
+	// word, as it was originally.
+	input = []byte("a [_; _=1, x=_]\na -[_]-> b\n")
+	g, err = lilgraph.Parse(input)
+	if err != nil {
+		t.Fatalf("expected '_' as a type, attr key and value to succeed, but got err=%v", err)
+	}
+	if a := g.Find("a"); !a.HasType("_") || a.AttrsMap()["_"] != "1" || a.AttrsMap()["x"] != "_" {
+		t.Errorf("expected node 'a' to have type '_' and attrs _=1, x=_")
+	}
+	if _, ok := g.FindEdge(g.Find("a"), g.Find("b"), "_"); !ok {
+		t.Errorf("expected to find edge a -[_]-> b")
+	}
+	actual, err = g.MarshalText()
+	if err != nil {
+		t.Fatalf("expected marshalling to succeed, but got err=%v", err)
+	}
+	if diff := cmp.Diff(string(input), string(actual)); diff != "" {
+		t.Errorf("plaintext rendering differed from expectation:\n%s", diff)
+	}
+
+	// In version 1, `_` was a plain id.
+	g, err = lilgraph.Parse([]byte("lilgraph 1\na -> _"))
+	if err != nil {
+		t.Fatalf("expected '_' in version 1 to succeed, but got err=%v", err)
+	}
+	if n := g.Find("_"); n == nil || n.Anonymous() {
+		t.Errorf("expected '_' in version 1 to be a normal node")
+	}
+}
+
+func TestDocComments(t *testing.T) {
+	inputPath := "happy/doc-comments.lilgraph"
+	input := readFsFile(t, testCases, inputPath)
+	g, err := lilgraph.Parse(input)
+	if err != nil {
+		t.Fatalf("expected doc-comments case to succeed, but got err=%v", err)
+	}
+	if doc := g.Find("api").Doc(); doc != "The public API.\nServes everything." {
+		t.Errorf("expected consecutive /// lines to make up node 'api's doc, but got %q", doc)
+	}
+	if doc := g.Find("db").Doc(); doc != "The primary database." {
+		t.Errorf("expected /** */ block to be node 'db's doc, but got %q", doc)
+	}
+	if doc := g.Find("cache").Doc(); doc != "" {
+		t.Errorf("expected doc detached by a blank line not to be node 'cache's doc, but got %q", doc)
+	}
+	if doc := g.Find("queue").Doc(); doc != "" {
+		t.Errorf("expected //// comment not to be node 'queue's doc, but got %q", doc)
+	}
+	e, ok := g.FindEdge(g.Find("cache"), g.Find("db"), "miss")
+	if !ok || e.Doc() != "Misses fall through." {
+		t.Errorf("expected doc before a chain step's arrow to be that edge's doc")
+	}
+
+	// Other comments in between detach a doc; `/**/` & `/***` aren't docs.
+	cases := map[string]string{
+		"/// a doc\n// but not now\nx":      "",
+		"/// a doc\n# nor now\nx":           "",
+		"/// a doc\n/* or now */ x":         "",
+		"/**/ x":                            "",
+		"/*** banner ***/\nx":               "",
+		"/** one line */ x":                 "one line",
+		"/// with\n///\n/// a gap\nx":       "with\n\na gap",
+		"lilgraph 1\n/// just a comment\nx": "",
+	}
+	for src, expect := range cases {
+		g, err := lilgraph.Parse([]byte(src))
+		if err != nil {
+			t.Fatalf("expected parsing %q to succeed, but got err=%v", src, err)
+		}
+		if doc := g.Find("x").Doc(); doc != expect {
+			t.Errorf("expected %q to give node 'x' doc %q, but got %q", src, expect, doc)
+		}
+	}
+
+	// An anonymous node at the start of a chain can only have a doc if the
+	// first step does too; otherwise it's written by id.
+	g, err = lilgraph.Parse([]byte("_[x] -> a"))
+	if err != nil {
+		t.Fatalf("expected anonymous node to succeed, but got err=%v", err)
+	}
+	g.Find("_1").SetDoc("Documented later.")
+	actual, err := g.MarshalText()
+	if err != nil {
+		t.Fatalf("expected marshalling to succeed, but got err=%v", err)
+	}
+	expect := "lilgraph 2\n/// Documented later.\n_1 [x]\n_1 -> a\n"
+	if diff := cmp.Diff(expect, string(actual)); diff != "" {
+		t.Errorf("plaintext rendering differed from expectation:\n%s", diff)
+	}
+	e, _ = g.FindEdge(g.Find("_1"), g.Find("a"), "")
+	e.SetDoc("Also documented.")
+	actual, err = g.MarshalText()
+	if err != nil {
+		t.Fatalf("expected marshalling to succeed, but got err=%v", err)
+	}
+	expect = "lilgraph 2\n/// Documented later.\n_[x]\n    /// Also documented.\n    -> a\n"
+	if diff := cmp.Diff(expect, string(actual)); diff != "" {
+		t.Errorf("plaintext rendering differed from expectation:\n%s", diff)
+	}
+	g, err = lilgraph.Parse(actual)
+	if err != nil {
+		t.Fatalf("expected re-parsing marshalled docs to succeed, but got err=%v", err)
+	}
+	e, _ = g.FindEdge(g.Find("_1"), g.Find("a"), "")
+	if g.Find("_1").Doc() != "Documented later." || e.Doc() != "Also documented." {
+		t.Errorf("expected docs to survive a round-trip, but got %q and %q", g.Find("_1").Doc(), e.Doc())
+	}
+}
+
+func TestNamespaces(t *testing.T) {
+	inputPath := "happy/namespaces.lilgraph"
+	input := readFsFile(t, testCases, inputPath)
+	g, err := lilgraph.Parse(input)
+	if err != nil {
+		t.Fatalf("expected namespaces case to succeed, but got err=%v", err)
+	}
+	api := g.Find("payments.api")
+	if api == nil || !api.HasType("service") {
+		t.Fatalf("expected node 'payments.api', declared within its namespace, to have type 'service'")
+	}
+	if api.Namespace() != "payments" || api.LocalId() != "api" {
+		t.Errorf("expected 'payments.api' to have namespace 'payments' and local id 'api', but got %q and %q", api.Namespace(), api.LocalId())
+	}
+	if g.Find("payments.db") != nil {
+		t.Errorf("expected 'payments.db' to have been deleted from within its namespace")
+	}
+	if _, ok := g.FindEdge(g.Find("payments.eu.api"), api, "replica_of"); !ok {
+		t.Errorf("expected already-dotted ids to be left as-is within a namespace")
+	}
+
+	expectNamespaces := []string{"ledger", "payments", "payments.eu"}
+	if diff := cmp.Diff(expectNamespaces, slices.Collect(g.Namespaces())); diff != "" {
+		t.Errorf("namespaces differed from expectation:\n%s", diff)
+	}
+	var inPayments []string
+	for n := range g.NodesInNamespace("payments") {
+		inPayments = append(inPayments, n.Id())
+	}
+	if diff := cmp.Diff([]string{"payments.api", "payments.eu.api"}, inPayments); diff != "" {
+		t.Errorf("nodes in namespace 'payments' differed from expectation:\n%s", diff)
+	}
+	if g.Find("frontend").Namespace() != "" {
+		t.Errorf("expected un-dotted id 'frontend' to have no namespace")
+	}
+
+	// Dots in quoted ids don't namespace them, but quoted ids still get
+	// prefixed within a namespace block; either way, they're written back
+	// such that they read the same.
+	input = []byte(`"api.example.com" -> "x.y"` + "\n" + `@namespace p { "api.example.com" -> "eu-west" }`)
+	g, err = lilgraph.Parse(input)
+	if err != nil {
+		t.Fatalf("expected quoted dotted ids to parse, but got err=%v", err)
+	}
+	quoted := g.Find("api.example.com")
+	if quoted == nil || quoted.Namespace() != "" || quoted.LocalId() != "api.example.com" {
+		t.Errorf("expected quoted id 'api.example.com' to have no namespace, but got %+v", quoted)
+	}
+	prefixed := g.Find("p.api.example.com")
+	if prefixed == nil || prefixed.Namespace() != "p" || prefixed.LocalId() != "api.example.com" {
+		t.Errorf("expected quoted id within namespace 'p' to be prefixed, but got %+v", prefixed)
+	}
+	expectText := "lilgraph 2\n" +
+		`"api.example.com" -> "x.y"` + "\n" +
+		`p."api.example.com" -> p."eu-west"` + "\n"
+	text, err := g.MarshalText()
+	if err != nil {
+		t.Fatalf("failed marshalling quoted dotted ids: %v", err)
+	}
+	if diff := cmp.Diff(expectText, string(text)); diff != "" {
+		t.Errorf("quoted dotted ids were written differently from expectation:\n%s", diff)
+	}
+	reparsed, err := lilgraph.Parse(text)
+	if err != nil {
+		t.Fatalf("failed re-parsing quoted dotted ids: %v", err)
+	}
+	if diff := cmp.Diff(slices.Collect(g.Namespaces()), slices.Collect(reparsed.Namespaces())); diff != "" {
+		t.Errorf("namespaces differed after a round trip:\n%s", diff)
+	}
+
+	// Ids can't be both quoted and namespaced; nor can namespaces be named
+	// with quoted ids.
+	for _, input := range []string{"\"a.b\"\nx -> a.b", "x\n@namespace \"eu-west\" { a }"} {
+		_, err := lilgraph.Parse([]byte(input))
+		if !errors.Is(err, lilgraph.ErrInvalidId) || !strings.Contains(err.Error(), "line=2") {
+			t.Errorf("expected %q to fail with ErrInvalidId on line 2, but got err=%v", input, err)
+		}
+	}
+	// Errors about ids in a set of edge ends point at the id, not the set.
+	_, err = lilgraph.Parse([]byte("\"a.b\"\nx -> {c, a.b}"))
+	if !errors.Is(err, lilgraph.ErrInvalidId) || !strings.Contains(err.Error(), "line=2, column=10") {
+		t.Errorf("expected id in a set to fail with ErrInvalidId at 2:10, but got err=%v", err)
+	}
+}
+
+func TestTemplates(t *testing.T) {
+	inputPath := "happy/templates.lilgraph"
+	input := readFsFile(t, testCases, inputPath)
+	g, err := lilgraph.Parse(input)
+	if err != nil {
+		t.Fatalf("expected templates case to succeed, but got err=%v", err)
+	}
+	db := g.Find("payments.db")
+	if db == nil || !db.HasType("database") {
+		t.Fatalf("expected nested template use to declare 'payments.db' within the namespace")
+	}
+	if engine, _ := db.GetAttr("engine"); engine != "postgres" {
+		t.Errorf("expected arg to be passed through to nested template, but got engine=%q", engine)
+	}
+	tags, _ := g.Find("api").GetList("tags")
+	if diff := cmp.Diff([]string{"web", "mysql"}, tags); diff != "" {
+		t.Errorf("tags of 'api' differed from expectation:\n%s", diff)
+	}
+
+	// Group ids are namespaced too, so each expansion gets its own groups.
+	input = []byte("@template t() { subgraph g { a } }\n@use x = t()\n@use y = t()")
+	g, err = lilgraph.Parse(input)
+	if err != nil {
+		t.Fatalf("expected template with a group to succeed, but got err=%v", err)
+	}
+	for _, id := range []string{"x.g", "y.g"} {
+		if gr := g.FindGroup(id); gr == nil || gr.Parent() != nil {
+			t.Errorf("expected each expansion to declare its own top-level group %q", id)
+		}
+	}
+	if g.FindGroup("g") != nil {
+		t.Errorf("expected no un-namespaced group 'g'")
+	}
+
+	// Errors within an expansion give positions in the template's body, and
+	// of where it was used.
+	input = readFsFile(t, testCases, "bad/template-type-in-attrs.lilgraph")
+	_, err = lilgraph.Parse(input)
+	if !errors.Is(err, lilgraph.ErrTypeInAttrs) {
+		t.Fatalf("expected type attr in template to fail with ErrTypeInAttrs, but got err=%v", err)
+	}
+	for _, pos := range []string{"line=2, column=26", "line=5, column=1"} {
+		if !strings.Contains(err.Error(), pos) {
+			t.Errorf("expected error to refer to position %q, but got err=%v", pos, err)
+		}
+	}
+
+	badCases := map[string]string{
+		"undeclared":    "@use nope()",
+		"missing arg":   "@template t(a, b) { x [k=$a] }\n@use t(a=1)",
+		"unknown arg":   "@template t(a) { x [k=$a] }\n@use t(a=1, b=2)",
+		"unknown param": "@template t(a) { x [k=$b] }\n@use t(a=1)",
+		"outside":       "x [k=$a]",
+		"recursive":     "@template t() { @use t() }\n@use t()",
+		"redeclared":    "@template t() { x }\n@template t() { y }",
+		"nested list":   "@template t(a) { x [k=[$a]] }\n@use t(a=[1])",
+	}
+	for name, input := range badCases {
+		_, err := lilgraph.Parse([]byte(input))
+		if !errors.Is(err, lilgraph.ErrTemplate) {
+			t.Errorf("expected %s case to fail with ErrTemplate, but got err=%v", name, err)
+		}
+	}
+}
+
+func TestInterpolation(t *testing.T) {
+	env := map[string]string{"env": "prod", "region": "ignored"}
+	resolver := func(name string) (string, bool) {
+		val, ok := env[name]
+		return val, ok
+	}
+	input := []byte("@let region = \"eu-west-1\"\nlogs [bucket=\"${env}-${region}\"]")
+	g, err := lilgraph.Parse(input, lilgraph.WithResolver(resolver))
+	if err != nil {
+		t.Fatalf("expected interpolation with resolver to succeed, but got err=%v", err)
+	}
+	// @let decls take precedence over the resolver.
+	if bucket, _ := g.Find("logs").GetAttr("bucket"); bucket != "prod-eu-west-1" {
+		t.Errorf("expected bucket=prod-eu-west-1, but got %q", bucket)
+	}
+
+	_, err = lilgraph.Parse(input)
+	if !errors.Is(err, lilgraph.ErrUnresolved) || !strings.Contains(err.Error(), "line=2, column=7") {
+		t.Errorf("expected unresolved reference to fail with ErrUnresolved at 2:7, but got err=%v", err)
+	}
+	_, err = lilgraph.Parse([]byte(`a [b="${not valid}"]`))
+	if !errors.Is(err, lilgraph.ErrParseFail) {
+		t.Errorf("expected malformed reference to fail with ErrParseFail, but got err=%v", err)
+	}
+
+	// Variables declared in a template's body are local to each use of it.
+	input = []byte("@let v = \"outer\"\n@template t(p) { @let v = $p\n x [k=\"${v}\"] }\n@use a = t(p=\"inner\")\ny [k=\"${v}\"]")
+	g, err = lilgraph.Parse(input)
+	if err != nil {
+		t.Fatalf("expected variable in a template to succeed, but got err=%v", err)
+	}
+	if k, _ := g.Find("a.x").GetAttr("k"); k != "inner" {
+		t.Errorf("expected k=inner within the template, but got %q", k)
+	}
+	if k, _ := g.Find("y").GetAttr("k"); k != "outer" {
+		t.Errorf("expected k=outer after the template's use, but got %q", k)
+	}
+	_, err = lilgraph.Parse([]byte("@template t() { @let v = \"inner\" }\n@use t()\ny [k=\"${v}\"]"))
+	if !errors.Is(err, lilgraph.ErrUnresolved) {
+		t.Errorf("expected variable from a template not to be visible after its use, but got err=%v", err)
+	}
+	// ... and, as they're interpolated into strings, can't be lists.
+	_, err = lilgraph.Parse([]byte("@template t(p) {\n  @let v = $p\n}\n@use t(p=[q, r])"))
+	if !errors.Is(err, lilgraph.ErrBadValue) || !strings.Contains(err.Error(), "line=2, column=8") {
+		t.Errorf("expected list-valued variable to fail with ErrBadValue at 2:8, but got err=%v", err)
+	}
+}
+
+func TestVersions(t *testing.T) {
+	// Version 1 has only the original escapes, and no interpolation.
+	input := []byte("lilgraph 1\nlilgraph -> a\na [path=\"C:\\temp\", cost=\"${x}\"]\n")
+	g, err := lilgraph.Parse(input)
+	if err != nil {
+		t.Fatalf("expected version 1 doc to parse, but got err=%v", err)
+	}
+	if g.Find("lilgraph") == nil {
+		t.Errorf("expected a version pragma not to prevent using 'lilgraph' as a node id")
+	}
+	expectAttrs := map[string]string{"path": `C:\temp`, "cost": "${x}"}
+	if diff := cmp.Diff(expectAttrs, g.Find("a").AttrsMap()); diff != "" {
+		t.Errorf("version 1 attrs differed from expectation:\n%s", diff)
+	}
+	// ... which means that, to keep the same values, these need version 2.
+	actual, err := g.MarshalText()
+	if err != nil {
+		t.Fatalf("expected marshalling to succeed, but got err=%v", err)
+	}
+	expect := "lilgraph 2\nlilgraph -> a\na [path=\"C:\\\\temp\", cost=\"$${x}\"]\n"
+	if diff := cmp.Diff(expect, string(actual)); diff != "" {
+		t.Errorf("plaintext rendering differed from expectation:\n%s", diff)
+	}
+
+	// Words that have since become keywords were plain ids in version 1, and
+	// a # after an id was always a comment; so input from back then parses
+	// the same.
+	input = []byte("lilgraph 1\ninclude -> subgraph# the edge\n_ [include=1, subgraph=2, _=3]\nsubgraph [include]#x\n")
+	g, err = lilgraph.Parse(input)
+	if err != nil {
+		t.Fatalf("expected version 1 doc using later keywords to parse, but got err=%v", err)
+	}
+	if _, ok := g.FindEdge(g.Find("include"), g.Find("subgraph"), ""); !ok {
+		t.Errorf("expected an edge from node 'include' to node 'subgraph'")
+	}
+	expectAttrs = map[string]string{"include": "1", "subgraph": "2", "_": "3"}
+	if n := g.Find("_"); n == nil || n.Anonymous() {
+		t.Errorf("expected node '_' not to be anonymous")
+	} else if diff := cmp.Diff(expectAttrs, n.AttrsMap()); diff != "" {
+		t.Errorf("attrs of node '_' differed from expectation:\n%s", diff)
+	}
+	if !g.Find("subgraph").HasType("include") {
+		t.Errorf("expected node 'subgraph' to have type 'include'")
+	}
+	// ... and such ids don't need a later version to be written; just the
+	// pragma, so they're not read as keywords.
+	actual, err = g.MarshalText()
+	if err != nil {
+		t.Fatalf("expected marshalling to succeed, but got err=%v", err)
+	}
+	expect = "lilgraph 1\ninclude -> subgraph\n_ [include=1, subgraph=2, _=3]\nsubgraph [include]\n"
+	if diff := cmp.Diff(expect, string(actual)); diff != "" {
+		t.Errorf("plaintext rendering differed from expectation:\n%s", diff)
+	}
+
+	_, err = lilgraph.Parse([]byte("lilgraph 1\na -> b\nb -- c"))
+	if !errors.Is(err, lilgraph.ErrVersion) || !strings.Contains(err.Error(), "line=3, column=3") {
+		t.Errorf("expected non-directed edge in version 1 to fail with ErrVersion at 3:3, but got err=%v", err)
+	}
+	_, err = lilgraph.Parse([]byte("lilgraph 3\na"))
+	if !errors.Is(err, lilgraph.ErrParseFail) {
+		t.Errorf("expected unsupported version to fail with ErrParseFail, but got err=%v", err)
+	}
+
+	// The pragma can be followed by a line comment, which isn't a doc comment
+	// for what follows; anything else after it is an error.
+	for _, input := range []string{"lilgraph 1 // note\na -- b", "lilgraph 1 # note\na -- b", "lilgraph 1 /// note\na -- b"} {
+		_, err = lilgraph.Parse([]byte(input))
+		if !errors.Is(err, lilgraph.ErrVersion) {
+			t.Errorf("expected %q to be taken as version 1, and fail with ErrVersion, but got err=%v", input, err)
+		}
+	}
+	g, err = lilgraph.Parse([]byte("lilgraph 2 /// note\na"))
+	if err != nil || g.Find("a").Doc() != "" {
+		t.Errorf("expected a comment after the pragma not to document the next line, but got err=%v", err)
+	}
+	for _, input := range []string{"lilgraph 2 x\na", "lilgraph 2 /* note */\na"} {
+		_, err = lilgraph.Parse([]byte(input))
+		if !errors.Is(err, lilgraph.ErrParseFail) || !strings.Contains(err.Error(), "malformed version pragma") {
+			t.Errorf("expected %q to fail as a malformed version pragma, but got err=%v", input, err)
+		}
+	}
+}
+
+func TestEdgeDirections(t *testing.T) {
+	inputPath := "happy/edge-directions.lilgraph"
+	input := readFsFile(t, testCases, inputPath)
+	g, err := lilgraph.Parse(input)
+	if err != nil {
+		t.Fatalf("expected edge-directions example to succeed, but got err=%v", err)
+	}
+	a, b, c := g.Find("a"), g.Find("b"), g.Find("c")
+
+	if n := len(slices.Collect(g.Edges())); n != 6 {
+		t.Fatalf("expected duplicate non-directed edges to be merged, leaving 6 edges, but got %d", n)
+	}
+	if e, ok := g.FindEdgeSpec(b, a, lilgraph.EdgeSpec{Dir: lilgraph.Undirected}); !ok || e.From() != a {
+		t.Fatalf("expected to find undirected a--b edge, as first declared, when searching from b to a")
+	}
+	if _, ok := g.FindEdge(b, a, ""); ok {
+		t.Fatalf("expected not to find a directed b->a edge")
+	}
+
+	// From a to b: the directed edge, and the undirected one.
+	if n := len(slices.Collect(g.FindEdges(a, b))); n != 2 {
+		t.Fatalf("expected 2 edges to be followable from a to b, but got %d", n)
+	}
+	// From b to a: only the undirected one.
+	if n := len(slices.Collect(g.FindEdges(b, a))); n != 1 {
+		t.Fatalf("expected 1 edge to be followable from b to a, but got %d", n)
+	}
+	for e := range g.FindEdges(c, a) {
+		if e.Direction() != lilgraph.Bidirectional {
+			t.Fatalf("expected only the bidirectional edge to be followable from c to a, but got a %s edge", e.Direction())
+		}
 	}
 }
 
@@ -261,6 +1722,7 @@ func TestNoExplicitTypeAttrs(t *testing.T) {
 	cases := []string{
 		"bad/explicit-type-in-attrs.node.lilgraph",
 		"bad/explicit-type-in-attrs.edge.lilgraph",
+		"bad/explicit-type-in-attrs.inline-node.lilgraph",
 	}
 	for _, inputPath := range cases {
 		input := readFsFile(t, testCases, inputPath)
@@ -298,7 +1760,8 @@ func TestCycleDetection(t *testing.T) {
 
 func TestTopoOrder(t *testing.T) {
 	cases := map[string]string{
-		"happy/multirank.lilgraph": "happy/multirank.expected-order.json",
+		"happy/multirank.lilgraph":       "happy/multirank.expected-order.json",
+		"happy/edge-directions.lilgraph": "happy/edge-directions.expected-order.json",
 	}
 	for inputPath, expectationPath := range cases {
 		t.Run(inputPath, func(t *testing.T) {
@@ -335,10 +1798,28 @@ func TestTopoOrder(t *testing.T) {
 
 func TestMarshalCanonicalFormat(t *testing.T) {
 	cases := map[string]string{
-		"happy/simple-nodes.lilgraph": "happy/simple-nodes.expect-marshalled.lilgraph",
-		"happy/simple-edges.lilgraph": "happy/simple-edges.expect-marshalled.lilgraph",
-		"happy/node-attrs.lilgraph":   "happy/node-attrs.expect-marshalled.lilgraph",
-		"happy/edge-attrs.lilgraph":   "happy/edge-attrs.expect-marshalled.lilgraph",
+		"happy/simple-nodes.lilgraph":    "happy/simple-nodes.expect-marshalled.lilgraph",
+		"happy/simple-edges.lilgraph":    "happy/simple-edges.expect-marshalled.lilgraph",
+		"happy/node-attrs.lilgraph":      "happy/node-attrs.expect-marshalled.lilgraph",
+		"happy/edge-attrs.lilgraph":      "happy/edge-attrs.expect-marshalled.lilgraph",
+		"happy/groups.lilgraph":          "happy/groups.expect-marshalled.lilgraph",
+		"happy/edge-directions.lilgraph": "happy/edge-directions.expect-marshalled.lilgraph",
+		"happy/edge-sets.lilgraph":       "happy/edge-sets.expect-marshalled.lilgraph",
+		"happy/escapes.lilgraph":         "happy/escapes.expect-marshalled.lilgraph",
+		"happy/raw-strings.lilgraph":     "happy/raw-strings.expect-marshalled.lilgraph",
+		"happy/node-types.lilgraph":      "happy/node-types.expect-marshalled.lilgraph",
+		"happy/graph-attrs.lilgraph":     "happy/graph-attrs.expect-marshalled.lilgraph",
+		"happy/ports.lilgraph":           "happy/ports.expect-marshalled.lilgraph",
+		"happy/edge-keys.lilgraph":       "happy/edge-keys.expect-marshalled.lilgraph",
+		"happy/reverse-edges.lilgraph":   "happy/reverse-edges.expect-marshalled.lilgraph",
+		"happy/inline-nodes.lilgraph":    "happy/inline-nodes.expect-marshalled.lilgraph",
+		"happy/deletions.lilgraph":       "happy/deletions.expect-marshalled.lilgraph",
+		"happy/namespaces.lilgraph":      "happy/namespaces.expect-marshalled.lilgraph",
+		"happy/templates.lilgraph":       "happy/templates.expect-marshalled.lilgraph",
+		"happy/interpolation.lilgraph":   "happy/interpolation.expect-marshalled.lilgraph",
+		"happy/nested-attrs.lilgraph":    "happy/nested-attrs.expect-marshalled.lilgraph",
+		"happy/anon-nodes.lilgraph":      "happy/anon-nodes.expect-marshalled.lilgraph",
+		"happy/doc-comments.lilgraph":    "happy/doc-comments.expect-marshalled.lilgraph",
 	}
 
 	for inputPath, expectationPath := range cases {